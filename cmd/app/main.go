@@ -13,32 +13,54 @@ import (
 	"github.com/go-redis/redis/v8"
 )
 
+// @title Restaurant Management System API
+// @version 1.0
+// @description API сервера для системы управления рестораном
+// @termsOfService http://swagger.io/terms/
+
+// @contact.name API Support
+// @contact.email support@example.com
+
+// @license.name Apache 2.0
+// @license.url http://www.apache.org/licenses/LICENSE-2.0.html
+
+// @host localhost:8080
+// @BasePath /api/v1
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
 func main() {
 	iikoConfig := config.NewDefaultConfig()
 
+	cfg, err := config.LoadConfig(config.Path())
+	if err != nil {
+		log.Fatalf("Ошибка при загрузке конфигурации: %v", err)
+	}
+
 	redisOptions := &redis.Options{
-		Addr:     "localhost:6379",
-		DB:       0,
-		Password: "",
+		Addr:     cfg.Redis.Addr,
+		DB:       cfg.Redis.DB,
+		Password: cfg.Redis.Password,
 	}
 
 	redisClient := redis.NewClient(redisOptions)
+	defer redisClient.Close()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := redisClient.Ping(ctx).Result()
+	_, err = redisClient.Ping(ctx).Result()
 	if err != nil {
 		log.Printf("Предупреждение: Не удалось подключиться к Redis: %v", err)
-		log.Printf("Приложение будет запущено без поддержки Redis и IIKO")
+		log.Printf("Приложение будет запущено без поддержки IIKO, вход и обновление токенов будут недоступны")
 	} else {
 		iikoService := iiko.NewIikoService(iikoConfig.APILogin, redisClient)
 		waiterService := iiko.NewIikoWaiterService(iikoConfig.WaiterAPIURL, iikoConfig.WaiterAPIKey)
 		log.Printf("Инициализированы сервисы IIKO: %v, %v", iikoService != nil, waiterService != nil)
-		defer redisClient.Close()
 	}
 
-	application, err := app.New()
+	application, err := app.New(cfg, redisClient)
 	if err != nil {
 		log.Fatalf("Ошибка при инициализации приложения: %v", err)
 	}
@@ -3,13 +3,18 @@ package app
 import (
 	"context"
 	"log"
-	"os"
-	"path/filepath"
+	"time"
 
+	"github.com/go-redis/redis/v8"
+
+	"restaurant-management/internal/auth"
 	"restaurant-management/internal/config"
 	"restaurant-management/internal/delivery/http"
+	"restaurant-management/internal/payment"
 	"restaurant-management/internal/repository"
 	"restaurant-management/internal/repository/postgres"
+	redisrepo "restaurant-management/internal/repository/redis"
+	"restaurant-management/internal/sms"
 	"restaurant-management/internal/usecase"
 	"restaurant-management/pkg/database"
 )
@@ -20,17 +25,11 @@ type App struct {
 	server  *http.Server
 	useCase *usecase.UseCase
 	repos   *repository.Repository
+	stop    chan struct{}
 }
 
-func New() (*App, error) {
-	app := &App{}
-
-	configPath := getConfigPath()
-	cfg, err := config.LoadConfig(configPath)
-	if err != nil {
-		return nil, err
-	}
-	app.config = cfg
+func New(cfg *config.Config, redisClient *redis.Client) (*App, error) {
+	app := &App{config: cfg, stop: make(chan struct{})}
 
 	db, err := initDB(cfg)
 	if err != nil {
@@ -38,9 +37,9 @@ func New() (*App, error) {
 	}
 	app.db = db
 
-	app.repos = initRepositories(db)
+	app.repos = initRepositories(cfg, db, redisClient)
 
-	app.useCase = initUseCases(app.repos)
+	app.useCase = initUseCases(cfg, app.repos)
 
 	app.server = http.NewServer(cfg, app.useCase)
 
@@ -48,22 +47,62 @@ func New() (*App, error) {
 }
 
 func (a *App) Run() error {
+	go a.purgeAuditLog()
+	go a.expireDeposits()
+
 	log.Printf("Сервер запущен на порту %s", a.config.Server.Port)
 	return a.server.Start()
 }
 
 func (a *App) Stop() {
+	close(a.stop)
+
 	if a.db != nil {
 		a.db.Close()
 	}
 }
 
-func getConfigPath() string {
-	configPath := os.Getenv("CONFIG_PATH")
-	if configPath == "" {
-		configPath = filepath.Join("configs", "config.yaml")
+// purgeAuditLog раз в сутки удаляет записи аудита старше срока хранения.
+func (a *App) purgeAuditLog() {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := a.useCase.Audit.PurgeExpired(context.Background())
+		if err != nil {
+			log.Printf("Ошибка при очистке журнала аудита: %v", err)
+		} else if deleted > 0 {
+			log.Printf("Удалено устаревших записей аудита: %d", deleted)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// expireDeposits раз в минуту снимает бронирования под события, депозит
+// которых не оплачен в срок.
+func (a *App) expireDeposits() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		expired, err := a.useCase.RestaurantEventTable.ExpireDeposits(context.Background())
+		if err != nil {
+			log.Printf("Ошибка при снятии просроченных депозитов: %v", err)
+		} else if expired > 0 {
+			log.Printf("Снято бронирований с просроченным депозитом: %d", expired)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-a.stop:
+			return
+		}
 	}
-	return configPath
 }
 
 func initDB(cfg *config.Config) (*database.PostgreSQL, error) {
@@ -75,26 +114,88 @@ func initDB(cfg *config.Config) (*database.PostgreSQL, error) {
 	return db, nil
 }
 
-func initRepositories(db *database.PostgreSQL) *repository.Repository {
+func initRepositories(cfg *config.Config, db *database.PostgreSQL, redisClient *redis.Client) *repository.Repository {
 	return &repository.Repository{
-		User:       postgres.NewUserRepository(db.Pool),
-		City:       postgres.NewCityRepository(db.Pool),
-		Restaurant: postgres.NewRestaurantRepository(db.Pool),
-		Section:    postgres.NewSectionRepository(db.Pool),
-		Table:      postgres.NewTableRepository(db.Pool),
-		MenuType:   postgres.NewMenuTypeRepository(db.Pool),
-		Menu:       postgres.NewMenuRepository(db.Pool),
+		User:                 postgres.NewUserRepository(db.Pool),
+		City:                 postgres.NewCityRepository(db.Pool),
+		Restaurant:           postgres.NewRestaurantRepository(db.Pool),
+		Section:              postgres.NewSectionRepository(db.Pool),
+		Table:                postgres.NewTableRepository(db.Pool),
+		TableCombination:     postgres.NewTableCombinationRepository(db.Pool),
+		MenuType:             postgres.NewMenuTypeRepository(db.Pool),
+		EventType:            postgres.NewEventTypeRepository(db.Pool),
+		Menu:                 postgres.NewMenuRepository(db.Pool),
+		RestaurantEvent:      postgres.NewRestaurantEventRepository(db.Pool),
+		RestaurantEventTable: postgres.NewRestaurantEventTableRepository(db.Pool),
+		EventDeposit:         postgres.NewEventDepositRepository(db.Pool),
+		Reservation:          postgres.NewReservationRepository(db.Pool, cfg.Reservation.Buffer),
+		Occupancy:            postgres.NewOccupancyRepository(db.Pool),
+		Waitlist:             postgres.NewWaitlistRepository(db.Pool, cfg.Reservation.Buffer),
+		OpeningHours:         postgres.NewOpeningHoursRepository(db.Pool),
+		SectionBlackout:      postgres.NewSectionBlackoutRepository(db.Pool),
+		OTP:                  postgres.NewOTPRepository(db.Pool),
+		Staff:                postgres.NewStaffRepository(db.Pool),
+		Session:              redisrepo.NewSessionRepository(redisClient),
+		APIKey:               postgres.NewAPIKeyRepository(db.Pool),
+		Audit:                postgres.NewAuditRepository(db.Pool),
+		CalendarFeed:         postgres.NewCalendarFeedRepository(db.Pool),
+		NoShowPolicy:         postgres.NewNoShowPolicyRepository(db.Pool),
+		EventPackage:         postgres.NewEventPackageRepository(db.Pool),
+		EventPricing:         postgres.NewEventPricingRepository(db.Pool),
+		EventInquiry:         postgres.NewEventInquiryRepository(db.Pool),
 	}
 }
 
-func initUseCases(repos *repository.Repository) *usecase.UseCase {
+func initUseCases(cfg *config.Config, repos *repository.Repository) *usecase.UseCase {
+	access := usecase.NewAccessControl(repos.Staff)
+	audit := usecase.NewAuditUseCase(repos.Audit, access, cfg.Audit.Retention)
+	userUC := usecase.NewUserUseCase(repos.User, repos.Session, repos.Staff, repos.Reservation, repos.Waitlist,
+		repos.EventDeposit, access, audit)
+	tokenManager := auth.NewTokenManager(cfg.Auth.JWTSecret, cfg.Auth.AccessTokenTTL)
+	smsSender := sms.NewLogSender()
+	payments := payment.NewFakeProvider(cfg.Payment.WebhookSecret, cfg.Payment.CheckoutURL)
+	scheduleUC := usecase.NewScheduleUseCase(repos.OpeningHours, repos.SectionBlackout, repos.Restaurant,
+		repos.Section, access, audit)
+	bookingUC := usecase.NewRestaurantEventTableUseCase(repos.RestaurantEventTable, repos.EventDeposit,
+		repos.RestaurantEvent, repos.Table, repos.TableCombination, repos.Section, payments, scheduleUC,
+		access, audit, cfg.Payment)
+	reservationUC := usecase.NewReservationUseCase(repos.Reservation, repos.Table, repos.TableCombination,
+		repos.Section, repos.User, repos.Occupancy, repos.NoShowPolicy, repos.EventDeposit, payments, scheduleUC,
+		access, audit, cfg.Reservation, cfg.Payment)
+	waitlistUC := usecase.NewWaitlistUseCase(repos.Waitlist, repos.Restaurant, reservationUC, userUC, smsSender,
+		access, audit)
+	restaurantUC := usecase.NewRestaurantUseCase(repos.Restaurant, repos.City, repos.Staff, scheduleUC,
+		access, audit)
+	calendarUC := usecase.NewCalendarUseCase(repos.CalendarFeed, repos.Restaurant, repos.Reservation,
+		repos.RestaurantEventTable, repos.EventDeposit, repos.RestaurantEvent, repos.Table, repos.TableCombination,
+		reservationUC, scheduleUC, access, audit)
+	packageUC := usecase.NewEventPackageUseCase(repos.EventPackage, repos.EventPricing, repos.RestaurantEvent,
+		repos.Restaurant, scheduleUC, access, audit)
+	inquiryUC := usecase.NewEventInquiryUseCase(repos.EventInquiry, repos.RestaurantEvent, repos.EventType,
+		repos.Restaurant, repos.Table, repos.Section, repos.Staff, repos.EventDeposit, bookingUC, scheduleUC, access,
+		audit)
+
 	return &usecase.UseCase{
-		User:       usecase.NewUserUseCase(repos.User),
-		City:       usecase.NewCityUseCase(repos.City),
-		Restaurant: usecase.NewRestaurantUseCase(repos.Restaurant, repos.City),
-		Section:    usecase.NewSectionUseCase(repos.Section, repos.Restaurant),
-		Table:      usecase.NewTableUseCase(repos.Table, repos.Section),
-		MenuType:   usecase.NewMenuTypeUseCase(repos.MenuType),
-		Menu:       usecase.NewMenuUseCase(repos.Menu, repos.Restaurant),
+		User:                 userUC,
+		City:                 usecase.NewCityUseCase(repos.City, access, audit),
+		Restaurant:           restaurantUC,
+		Section:              usecase.NewSectionUseCase(repos.Section, repos.Restaurant, access, audit),
+		Table:                usecase.NewTableUseCase(repos.Table, repos.Section, access, audit),
+		TableCombination:     usecase.NewTableCombinationUseCase(repos.TableCombination, repos.Table, repos.Section, access, audit),
+		MenuType:             usecase.NewMenuTypeUseCase(repos.MenuType, access, audit),
+		EventType:            usecase.NewEventTypeUseCase(repos.EventType, access, audit),
+		Menu:                 usecase.NewMenuUseCase(repos.Menu, repos.Restaurant, access, audit),
+		RestaurantEvent:      usecase.NewRestaurantEventUseCase(repos.RestaurantEvent, repos.EventType, repos.Restaurant, access, audit),
+		EventPackage:         packageUC,
+		EventInquiry:         inquiryUC,
+		RestaurantEventTable: bookingUC,
+		Reservation:          reservationUC,
+		Waitlist:             waitlistUC,
+		Schedule:             scheduleUC,
+		Calendar:             calendarUC,
+		Auth:                 usecase.NewAuthUseCase(repos.OTP, repos.Session, repos.APIKey, userUC, smsSender, tokenManager, access, cfg.Auth),
+		Staff:                usecase.NewStaffUseCase(repos.Staff, repos.User, repos.Restaurant, access, audit),
+		APIKey:               usecase.NewAPIKeyUseCase(repos.APIKey, access, audit),
+		Audit:                audit,
 	}
 }
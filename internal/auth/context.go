@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+
+	"restaurant-management/internal/models"
+)
+
+type PrincipalKind string
+
+const (
+	PrincipalUser   PrincipalKind = "user"
+	PrincipalAPIKey PrincipalKind = "api_key"
+)
+
+// Principal описывает того, от чьего имени выполняется запрос: пользователя,
+// вошедшего по JWT, или машинного клиента с API-ключом.
+type Principal struct {
+	Kind         PrincipalKind   `json:"kind"`
+	UserID       int64           `json:"user_id"`
+	PhoneNumber  string          `json:"phone_number"`
+	Role         models.UserRole `json:"role"`
+	SessionID    string          `json:"session_id"`
+	Language     string          `json:"language"`
+	APIKeyID     int64           `json:"api_key_id"`
+	Scopes       []string        `json:"scopes"`
+	RestaurantID *int64          `json:"restaurant_id"`
+}
+
+func (p *Principal) IsAdmin() bool {
+	return p.Kind == PrincipalUser && p.Role == models.RoleAdmin
+}
+
+func (p *Principal) IsAPIKey() bool {
+	return p.Kind == PrincipalAPIKey
+}
+
+func (p *Principal) HasRole(roles ...models.UserRole) bool {
+	if p.Kind != PrincipalUser {
+		return false
+	}
+	for _, role := range roles {
+		if p.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// CanAccessRestaurant сообщает, не ограничен ли API-ключ другим рестораном.
+// Для пользователей всегда возвращает true: их доступ проверяет AccessControl.
+func (p *Principal) CanAccessRestaurant(restaurantID int64) bool {
+	return p.RestaurantID == nil || *p.RestaurantID == restaurantID
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,40 @@
+package auth
+
+import "strings"
+
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
+
+// ScopeResources перечисляет ресурсы, доступ к которым можно выдать API-ключу.
+// Области доступа имеют вид "<ресурс>:read" или "<ресурс>:write".
+var ScopeResources = []string{
+	"cities",
+	"restaurants",
+	"sections",
+	"tables",
+	"menus",
+	"menu-types",
+	"events",
+	"staff",
+	"reservations",
+}
+
+func Scope(resource, action string) string {
+	return resource + ":" + action
+}
+
+func ValidScope(scope string) bool {
+	resource, action, ok := strings.Cut(scope, ":")
+	if !ok || (action != ScopeRead && action != ScopeWrite) {
+		return false
+	}
+
+	for _, r := range ScopeResources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"restaurant-management/internal/models"
+)
+
+type Claims struct {
+	UserID      int64           `json:"uid"`
+	PhoneNumber string          `json:"phone"`
+	Role        models.UserRole `json:"role"`
+	Language    string          `json:"lang"`
+	jwt.StandardClaims
+}
+
+type TokenManager struct {
+	secret    []byte
+	accessTTL time.Duration
+}
+
+func NewTokenManager(secret string, accessTTL time.Duration) *TokenManager {
+	return &TokenManager{
+		secret:    []byte(secret),
+		accessTTL: accessTTL,
+	}
+}
+
+// GenerateAccessToken выпускает access-токен, привязанный к сессии sessionID.
+func (m *TokenManager) GenerateAccessToken(user *models.User, sessionID string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.accessTTL)
+
+	claims := Claims{
+		UserID:      user.ID,
+		PhoneNumber: user.PhoneNumber,
+		Role:        user.Role,
+		Language:    user.Language,
+		StandardClaims: jwt.StandardClaims{
+			Id:        sessionID,
+			Subject:   fmt.Sprintf("%d", user.ID),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("не удалось подписать токен: %w", err)
+	}
+
+	return token, expiresAt, nil
+}
+
+func (m *TokenManager) ParseAccessToken(tokenString string) (*Claims, error) {
+	var claims Claims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("неожиданный метод подписи: %v", token.Header["alg"])
+		}
+		return m.secret, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("недействительный токен: %w", err)
+	}
+
+	if !token.Valid || claims.UserID <= 0 {
+		return nil, fmt.Errorf("недействительный токен")
+	}
+
+	return &claims, nil
+}
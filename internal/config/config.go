@@ -2,6 +2,9 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -10,6 +13,11 @@ import (
 type Config struct {
 	Server          ServerConfig
 	Database        DatabaseConfig
+	Redis           RedisConfig
+	Auth            AuthConfig
+	Audit           AuditConfig
+	Reservation     ReservationConfig
+	Payment         PaymentConfig
 	APILogin        string
 	TokenCacheKey   string
 	TokenTimeout    time.Duration
@@ -34,6 +42,47 @@ type DatabaseConfig struct {
 	SSLMode  string
 }
 
+// RedisConfig задает подключение к Redis, где хранятся сессии
+// пользователей и токены IIKO.
+type RedisConfig struct {
+	Addr     string
+	Password string
+	DB       int
+}
+
+type AuthConfig struct {
+	JWTSecret         string
+	AccessTokenTTL    time.Duration
+	RefreshTokenTTL   time.Duration
+	OTPTTL            time.Duration
+	OTPResendInterval time.Duration
+	OTPMaxAttempts    int
+	OTPLength         int
+}
+
+// AuditConfig задает срок хранения журнала аудита. Нулевое значение
+// отключает удаление старых записей.
+type AuditConfig struct {
+	Retention time.Duration
+}
+
+// ReservationConfig задает сетку времени для поиска свободных столов,
+// перерыв между гостями, который нужен, чтобы убрать стол, и время посадки
+// для оценки ожидания в ресторане, где еще нет завершенных бронирований.
+type ReservationConfig struct {
+	SlotStep time.Duration
+	Buffer   time.Duration
+	TurnTime time.Duration
+}
+
+// PaymentConfig задает, сколько бронирование под событие ждет оплаты
+// депозита, секрет подписи уведомлений провайдера и адрес страницы оплаты.
+type PaymentConfig struct {
+	DepositTTL    time.Duration
+	WebhookSecret string
+	CheckoutURL   string
+}
+
 func (c *DatabaseConfig) PostgresURL() string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
 		c.User, c.Password, c.Host, c.Port, c.DBName, c.SSLMode)
@@ -51,10 +100,37 @@ func NewDefaultConfig() *Config {
 	}
 }
 
+// Path возвращает путь к конфигурационному файлу из CONFIG_PATH или путь
+// по умолчанию.
+func Path() string {
+	path := os.Getenv("CONFIG_PATH")
+	if path == "" {
+		path = filepath.Join("configs", "config.yaml")
+	}
+	return path
+}
+
 func LoadConfig(path string) (*Config, error) {
 	viper.SetConfigFile(path)
+	// Переменные окружения переопределяют файл: auth.jwt_secret задается
+	// через AUTH_JWT_SECRET.
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	viper.AutomaticEnv()
 
+	viper.SetDefault("redis.addr", "localhost:6379")
+	viper.SetDefault("auth.access_token_ttl", "15m")
+	viper.SetDefault("auth.refresh_token_ttl", "720h")
+	viper.SetDefault("auth.otp_ttl", "5m")
+	viper.SetDefault("auth.otp_resend_interval", "1m")
+	viper.SetDefault("auth.otp_max_attempts", 5)
+	viper.SetDefault("auth.otp_length", 6)
+	viper.SetDefault("audit.retention", "8760h")
+	viper.SetDefault("reservation.slot_step", "30m")
+	viper.SetDefault("reservation.buffer", "15m")
+	viper.SetDefault("reservation.turn_time", "90m")
+	viper.SetDefault("payment.deposit_ttl", "30m")
+	viper.SetDefault("payment.checkout_url", "http://localhost:8080/checkout")
+
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("ошибка чтения конфигурационного файла: %w", err)
 	}
@@ -72,6 +148,44 @@ func LoadConfig(path string) (*Config, error) {
 	config.Database.DBName = viper.GetString("database.dbname")
 	config.Database.SSLMode = viper.GetString("database.sslmode")
 
+	config.Redis.Addr = viper.GetString("redis.addr")
+	config.Redis.Password = viper.GetString("redis.password")
+	config.Redis.DB = viper.GetInt("redis.db")
+
+	config.Auth.JWTSecret = viper.GetString("auth.jwt_secret")
+	config.Auth.AccessTokenTTL = viper.GetDuration("auth.access_token_ttl")
+	config.Auth.RefreshTokenTTL = viper.GetDuration("auth.refresh_token_ttl")
+	config.Auth.OTPTTL = viper.GetDuration("auth.otp_ttl")
+	config.Auth.OTPResendInterval = viper.GetDuration("auth.otp_resend_interval")
+	config.Auth.OTPMaxAttempts = viper.GetInt("auth.otp_max_attempts")
+	config.Auth.OTPLength = viper.GetInt("auth.otp_length")
+
+	config.Audit.Retention = viper.GetDuration("audit.retention")
+
+	config.Reservation.SlotStep = viper.GetDuration("reservation.slot_step")
+	config.Reservation.Buffer = viper.GetDuration("reservation.buffer")
+	config.Reservation.TurnTime = viper.GetDuration("reservation.turn_time")
+	if config.Reservation.SlotStep <= 0 {
+		return nil, fmt.Errorf("шаг сетки бронирований должен быть положительным (reservation.slot_step)")
+	}
+	if config.Reservation.TurnTime <= 0 {
+		return nil, fmt.Errorf("время посадки должно быть положительным (reservation.turn_time)")
+	}
+
+	config.Payment.DepositTTL = viper.GetDuration("payment.deposit_ttl")
+	config.Payment.WebhookSecret = viper.GetString("payment.webhook_secret")
+	config.Payment.CheckoutURL = viper.GetString("payment.checkout_url")
+	if config.Payment.DepositTTL <= 0 {
+		return nil, fmt.Errorf("срок оплаты депозита должен быть положительным (payment.deposit_ttl)")
+	}
+	if config.Payment.WebhookSecret == "" {
+		return nil, fmt.Errorf("не задан секрет для подписи уведомлений о платежах (payment.webhook_secret)")
+	}
+
+	if config.Auth.JWTSecret == "" {
+		return nil, fmt.Errorf("не задан секрет для подписи JWT (auth.jwt_secret)")
+	}
+
 	config.APILogin = viper.GetString("iiko.api_login")
 	config.TokenCacheKey = viper.GetString("iiko.token_cache_key")
 	config.TokenTimeout = viper.GetDuration("iiko.token_timeout")
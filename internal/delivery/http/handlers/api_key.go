@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type APIKeyHandler struct {
+	apiKeyUC usecase.APIKeyUseCase
+}
+
+func NewAPIKeyHandler(apiKeyUC usecase.APIKeyUseCase) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyUC: apiKeyUC,
+	}
+}
+
+func (h *APIKeyHandler) Register(e *echo.Group) {
+	adminOnly := middleware.RequireRole(models.RoleAdmin)
+
+	apiKeys := e.Group("/api-keys", adminOnly)
+	apiKeys.POST("", h.Create)
+	apiKeys.GET("", h.List)
+	apiKeys.DELETE("/:id", h.Revoke)
+}
+
+// Create godoc
+// @Summary Создать API-ключ
+// @Description Создает ключ для машинного клиента (POS, агрегатора). Ключ возвращается в открытом виде только в этом ответе
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Param request body models.APIKeyCreateRequest true "Название, области доступа и ресторан"
+// @Success 201 {object} models.APIKeyCreated
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api-keys [post]
+func (h *APIKeyHandler) Create(c echo.Context) error {
+	var req models.APIKeyCreateRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidAPIKeyData)
+	}
+
+	created, err := h.apiKeyUC.Create(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, created)
+}
+
+// List godoc
+// @Summary Получить список API-ключей
+// @Description Возвращает все API-ключи, включая отозванные, без самих ключей
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.APIKey
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api-keys [get]
+func (h *APIKeyHandler) List(c echo.Context) error {
+	keys, err := h.apiKeyUC.List(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, keys)
+}
+
+// Revoke godoc
+// @Summary Отозвать API-ключ
+// @Description Отзывает API-ключ, после чего запросы с ним отклоняются
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ключа"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api-keys/{id} [delete]
+func (h *APIKeyHandler) Revoke(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidAPIKeyID)
+	}
+
+	if err := h.apiKeyUC.Revoke(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgAPIKeyRevoked,
+		"message": localize(c, i18n.MsgAPIKeyRevoked),
+	})
+}
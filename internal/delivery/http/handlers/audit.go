@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type AuditHandler struct {
+	auditUC usecase.AuditUseCase
+}
+
+func NewAuditHandler(auditUC usecase.AuditUseCase) *AuditHandler {
+	return &AuditHandler{
+		auditUC: auditUC,
+	}
+}
+
+func (h *AuditHandler) Register(e *echo.Group) {
+	e.GET("/audit", h.List, middleware.RequireRole(models.RoleAdmin))
+}
+
+// List godoc
+// @Summary Получить журнал аудита
+// @Description Возвращает записи о создании, изменении и удалении сущностей, новые первыми
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param actor_user_id query int false "ID пользователя, выполнившего действие"
+// @Param actor_api_key_id query int false "ID API-ключа, выполнившего действие"
+// @Param action query string false "Действие (create, update, delete, restore, anonymize)"
+// @Param entity_type query string false "Тип сущности (user, city, restaurant, ...)"
+// @Param entity_id query int false "ID сущности"
+// @Param from query string false "Начало периода (RFC3339)"
+// @Param to query string false "Конец периода (RFC3339), не включительно"
+// @Param limit query int false "Количество записей на странице" default(50)
+// @Param offset query int false "Смещение" default(0)
+// @Success 200 {array} models.AuditEntry
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /audit [get]
+func (h *AuditHandler) List(c echo.Context) error {
+	filter := models.AuditFilter{
+		Action:     models.AuditAction(c.QueryParam("action")),
+		EntityType: c.QueryParam("entity_type"),
+	}
+
+	var fields errs.Fields
+	filter.ActorUserID = queryInt64(c, "actor_user_id", &fields)
+	filter.ActorAPIKeyID = queryInt64(c, "actor_api_key_id", &fields)
+	filter.EntityID = queryInt64(c, "entity_id", &fields)
+	filter.From = queryTime(c, "from", &fields)
+	filter.To = queryTime(c, "to", &fields)
+
+	if limit := queryInt64(c, "limit", &fields); limit != nil {
+		filter.Limit = int(*limit)
+	}
+	if offset := queryInt64(c, "offset", &fields); offset != nil {
+		filter.Offset = int(*offset)
+	}
+
+	if err := fields.Err(); err != nil {
+		return err
+	}
+
+	entries, err := h.auditUC.List(c.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, entries)
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type AuthHandler struct {
+	authUC usecase.AuthUseCase
+}
+
+func NewAuthHandler(authUC usecase.AuthUseCase) *AuthHandler {
+	return &AuthHandler{
+		authUC: authUC,
+	}
+}
+
+func (h *AuthHandler) Register(e *echo.Group) {
+	authGroup := e.Group("/auth")
+	authGroup.POST("/otp/request", h.RequestOTP)
+	authGroup.POST("/otp/verify", h.VerifyOTP)
+	authGroup.POST("/refresh", h.Refresh)
+
+	authenticated := middleware.Auth(h.authUC)
+	authGroup.POST("/logout", h.Logout, authenticated)
+	authGroup.POST("/logout-all", h.LogoutAll, authenticated)
+	e.GET("/users/:id/sessions", h.ListSessions, authenticated)
+}
+
+// RequestOTP godoc
+// @Summary Запросить код подтверждения
+// @Description Отправляет одноразовый код подтверждения на указанный номер телефона
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body models.OTPRequest true "Номер телефона"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /auth/otp/request [post]
+func (h *AuthHandler) RequestOTP(c echo.Context) error {
+	var req models.OTPRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidRequest)
+	}
+
+	if err := h.authUC.RequestOTP(c.Request().Context(), req.PhoneNumber); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgOTPSent,
+		"message": localize(c, i18n.MsgOTPSent),
+	})
+}
+
+// VerifyOTP godoc
+// @Summary Подтвердить код и войти
+// @Description Проверяет код подтверждения и выдает пару access- и refresh-токенов. Если пользователя с таким номером нет, он будет создан
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body models.OTPVerifyRequest true "Номер телефона и код подтверждения"
+// @Success 200 {object} models.AuthTokens
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Router /auth/otp/verify [post]
+func (h *AuthHandler) VerifyOTP(c echo.Context) error {
+	var req models.OTPVerifyRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidRequest)
+	}
+
+	tokens, err := h.authUC.VerifyOTP(c.Request().Context(), &req, deviceInfo(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, tokens)
+}
+
+// Refresh godoc
+// @Summary Обновить токены
+// @Description Обменивает refresh-токен на новую пару токенов. Использованный refresh-токен становится недействительным, повторное его предъявление завершает сессию
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body models.RefreshRequest true "Refresh-токен"
+// @Success 200 {object} models.AuthTokens
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Router /auth/refresh [post]
+func (h *AuthHandler) Refresh(c echo.Context) error {
+	var req models.RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidRequest)
+	}
+
+	tokens, err := h.authUC.Refresh(c.Request().Context(), req.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, tokens)
+}
+
+// Logout godoc
+// @Summary Выйти из текущей сессии
+// @Description Завершает сессию, к которой привязан access-токен
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /auth/logout [post]
+func (h *AuthHandler) Logout(c echo.Context) error {
+	if err := h.authUC.Logout(c.Request().Context()); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgSessionEnded,
+		"message": localize(c, i18n.MsgSessionEnded),
+	})
+}
+
+// LogoutAll godoc
+// @Summary Выйти на всех устройствах
+// @Description Завершает все сессии текущего пользователя, включая текущую
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /auth/logout-all [post]
+func (h *AuthHandler) LogoutAll(c echo.Context) error {
+	if err := h.authUC.LogoutAll(c.Request().Context()); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgAllSessionsEnded,
+		"message": localize(c, i18n.MsgAllSessionsEnded),
+	})
+}
+
+// ListSessions godoc
+// @Summary Получить активные сессии пользователя
+// @Description Возвращает список устройств, на которых пользователь авторизован
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {array} models.Session
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /users/{id}/sessions [get]
+func (h *AuthHandler) ListSessions(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidUserID)
+	}
+
+	sessions, err := h.authUC.ListSessions(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, sessions)
+}
+
+func deviceInfo(c echo.Context) models.DeviceInfo {
+	return models.DeviceInfo{
+		UserAgent: c.Request().UserAgent(),
+		IP:        c.RealIP(),
+	}
+}
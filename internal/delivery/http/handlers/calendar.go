@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/ical"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+type CalendarHandler struct {
+	calendarUC usecase.CalendarUseCase
+}
+
+func NewCalendarHandler(calendarUC usecase.CalendarUseCase) *CalendarHandler {
+	return &CalendarHandler{
+		calendarUC: calendarUC,
+	}
+}
+
+func (h *CalendarHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
+	e.POST("/restaurants/:id/calendar/token", h.IssueFeed, manage)
+	e.DELETE("/restaurants/:id/calendar/token", h.RevokeFeed, manage)
+	e.GET("/reservations/:id/calendar.ics", h.GetReservation)
+}
+
+// RegisterFeed подключает ленту без авторизации: календари не умеют
+// передавать заголовок, доступ дает токен в адресе.
+func (h *CalendarHandler) RegisterFeed(e *echo.Group) {
+	e.GET("/restaurants/:id/calendar.ics", h.GetFeed)
+}
+
+// IssueFeed godoc
+// @Summary Выпустить ссылку на календарь
+// @Description Выпускает ссылку для подписки на бронирования ресторана в Google Calendar или Outlook. Токен
+// @Description возвращается один раз; прежняя ссылка перестает работать
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Success 201 {object} models.CalendarFeedCreated
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/calendar/token [post]
+func (h *CalendarHandler) IssueFeed(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	created, err := h.calendarUC.IssueFeed(c.Request().Context(), restaurantID)
+	if err != nil {
+		return err
+	}
+
+	created.URL = fmt.Sprintf("%s://%s/api/v1/restaurants/%d/calendar.ics?token=%s",
+		c.Scheme(), c.Request().Host, restaurantID, created.Token)
+	return c.JSON(http.StatusCreated, created)
+}
+
+// RevokeFeed godoc
+// @Summary Отозвать ссылку на календарь
+// @Description Отзывает ссылку; подписанные календари перестают получать обновления
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/calendar/token [delete]
+func (h *CalendarHandler) RevokeFeed(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	if err := h.calendarUC.RevokeFeed(c.Request().Context(), restaurantID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgCalendarFeedRevoked,
+		"message": localize(c, i18n.MsgCalendarFeedRevoked),
+	})
+}
+
+// GetFeed godoc
+// @Summary Календарь бронирований ресторана
+// @Description Лента iCalendar с бронированиями за прошедший месяц и год вперед, включая бронирования под события.
+// @Description Отмененные бронирования остаются в ленте со STATUS:CANCELLED. Язык описаний задает параметр lang
+// @Tags calendar
+// @Produce text/calendar
+// @Param id path int true "ID ресторана"
+// @Param token query string true "Токен ссылки"
+// @Param lang query string false "Язык: ru, kz или en"
+// @Success 200 {string} string
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Router /restaurants/{id}/calendar.ics [get]
+func (h *CalendarHandler) GetFeed(c echo.Context) error {
+	if lang, ok := i18n.ParseLang(c.QueryParam("lang")); ok {
+		c.SetRequest(c.Request().WithContext(i18n.WithLang(c.Request().Context(), lang)))
+	}
+
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	calendar, err := h.calendarUC.Feed(c.Request().Context(), restaurantID, c.QueryParam("token"))
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, calendarContentType, encodeCalendar(c, calendar))
+}
+
+// GetReservation godoc
+// @Summary Бронирование в формате iCalendar
+// @Description Возвращает файл .ics с бронированием для добавления в календарь гостя
+// @Tags calendar
+// @Produce text/calendar
+// @Param id path int true "ID бронирования"
+// @Success 200 {string} string
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /reservations/{id}/calendar.ics [get]
+func (h *CalendarHandler) GetReservation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationID)
+	}
+
+	calendar, err := h.calendarUC.ReservationCalendar(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf(`attachment; filename="reservation-%d.ics"`, id))
+	return c.Blob(http.StatusOK, calendarContentType, encodeCalendar(c, calendar))
+}
+
+// encodeCalendar подписывает записи на языке запроса и переводит их в
+// iCalendar.
+func encodeCalendar(c echo.Context, calendar *models.Calendar) []byte {
+	address := calendar.AddressRU
+	if middleware.Lang(c) == i18n.LangKZ && calendar.AddressKZ != "" {
+		address = calendar.AddressKZ
+	}
+
+	cal := &ical.Calendar{
+		Name:     calendar.RestaurantName,
+		Location: calendar.Location,
+		Events:   make([]ical.Event, 0, len(calendar.Entries)),
+	}
+	for _, entry := range calendar.Entries {
+		event := ical.Event{
+			UID:      entry.UID,
+			Start:    entry.Start,
+			End:      entry.End,
+			Location: address,
+			Status:   calendarStatus(entry.Status),
+			Modified: entry.UpdatedAt,
+		}
+
+		var lines []string
+		if entry.Kind == models.CalendarEventBooking {
+			event.AllDay = true
+			event.Summary = entry.EventName
+			if event.Summary == "" {
+				event.Summary = calendar.RestaurantName
+			}
+			if entry.PartySize > 0 {
+				lines = append(lines, localize(c, i18n.MsgCalendarGuests, entry.PartySize))
+			}
+			if entry.Status == models.CalendarTentative {
+				lines = append(lines, localize(c, i18n.MsgCalendarUnpaid))
+			}
+		} else {
+			event.Summary = localize(c, i18n.MsgCalendarReservation, entry.PartySize)
+		}
+
+		if len(entry.Tables) > 0 {
+			numbers := make([]string, len(entry.Tables))
+			for i, number := range entry.Tables {
+				numbers[i] = strconv.Itoa(number)
+			}
+			lines = append(lines, localize(c, i18n.MsgCalendarTables, strings.Join(numbers, ", ")))
+		}
+		if len(entry.Sections) > 0 {
+			lines = append(lines, localize(c, i18n.MsgCalendarSections, strings.Join(entry.Sections, ", ")))
+		}
+		event.Description = strings.Join(lines, "\n")
+
+		cal.Events = append(cal.Events, event)
+	}
+
+	return ical.Encode(cal)
+}
+
+func calendarStatus(status models.CalendarStatus) ical.Status {
+	switch status {
+	case models.CalendarTentative:
+		return ical.StatusTentative
+	case models.CalendarCancelled:
+		return ical.StatusCancelled
+	}
+	return ical.StatusConfirmed
+}
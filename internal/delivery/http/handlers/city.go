@@ -6,6 +6,9 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/usecase"
 )
@@ -21,11 +24,13 @@ func NewCityHandler(cityUC usecase.CityUseCase) *CityHandler {
 }
 
 func (h *CityHandler) Register(e *echo.Group) {
+	adminOnly := middleware.RequireRole(models.RoleAdmin)
+
 	cities := e.Group("/cities")
-	cities.POST("", h.Create)
+	cities.POST("", h.Create, adminOnly)
 	cities.GET("/:id", h.GetByID)
-	cities.PUT("/:id", h.Update)
-	cities.DELETE("/:id", h.Delete)
+	cities.PUT("/:id", h.Update, adminOnly)
+	cities.DELETE("/:id", h.Delete, adminOnly)
 	cities.GET("", h.List)
 }
 
@@ -39,25 +44,23 @@ func (h *CityHandler) Register(e *echo.Group) {
 // @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /cities [post]
 func (h *CityHandler) Create(c echo.Context) error {
 	var city models.City
 	if err := c.Bind(&city); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные города",
-		})
+		return errs.Validation(i18n.CodeInvalidCityData)
 	}
 
 	id, err := h.cityUC.Create(c.Request().Context(), &city)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"id":      id,
-		"message": "город успешно создан",
+		"code":    i18n.MsgCityCreated,
+		"message": localize(c, i18n.MsgCityCreated),
 	})
 }
 
@@ -72,21 +75,18 @@ func (h *CityHandler) Create(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /cities/{id} [get]
 func (h *CityHandler) GetByID(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID города",
-		})
+		return errs.Validation(i18n.CodeInvalidCityID)
 	}
 
 	city, err := h.cityUC.GetByID(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, city)
@@ -104,32 +104,28 @@ func (h *CityHandler) GetByID(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /cities/{id} [put]
 func (h *CityHandler) Update(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID города",
-		})
+		return errs.Validation(i18n.CodeInvalidCityID)
 	}
 
 	var city models.City
 	if err := c.Bind(&city); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные города",
-		})
+		return errs.Validation(i18n.CodeInvalidCityData)
 	}
 
 	city.ID = id
 	if err := h.cityUC.Update(c.Request().Context(), &city); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "город успешно обновлен",
+		"code":    i18n.MsgCityUpdated,
+		"message": localize(c, i18n.MsgCityUpdated),
 	})
 }
 
@@ -144,24 +140,22 @@ func (h *CityHandler) Update(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /cities/{id} [delete]
 func (h *CityHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID города",
-		})
+		return errs.Validation(i18n.CodeInvalidCityID)
 	}
 
 	if err := h.cityUC.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "город успешно удален",
+		"code":    i18n.MsgCityDeleted,
+		"message": localize(c, i18n.MsgCityDeleted),
 	})
 }
 
@@ -173,13 +167,12 @@ func (h *CityHandler) Delete(c echo.Context) error {
 // @Produce json
 // @Success 200 {array} models.City
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /cities [get]
 func (h *CityHandler) List(c echo.Context) error {
 	cities, err := h.cityUC.List(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, cities)
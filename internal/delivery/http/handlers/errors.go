@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+)
+
+// HTTPErrorHandler — единая точка, где ошибки обработчиков и middleware
+// превращаются в ответ: статус определяется видом доменной ошибки, тело
+// всегда имеет вид {"code", "error", "details"}, а конфликты дополняются
+// полем "conflict" с описанием мешающей записи. Внутренние ошибки клиенту
+// не раскрываются и только пишутся в лог.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	status, body := errorResponse(c, err)
+	if status >= http.StatusInternalServerError {
+		c.Logger().Errorf("Ошибка при обработке %s %s: %v", c.Request().Method, c.Path(), err)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(status)
+	} else {
+		err = c.JSON(status, body)
+	}
+	if err != nil {
+		c.Logger().Errorf("Не удалось отправить ответ с ошибкой: %v", err)
+	}
+}
+
+func errorResponse(c echo.Context, err error) (int, map[string]interface{}) {
+	lang := middleware.Lang(c)
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) && errs.KindOf(err) == errs.KindInternal {
+		code := httpErrorCode(httpErr.Code)
+		return httpErr.Code, map[string]interface{}{
+			"code":  code,
+			"error": i18n.Translate(lang, code),
+		}
+	}
+
+	code, message := i18n.Localize(err, lang)
+	body := map[string]interface{}{
+		"code":  code,
+		"error": message,
+	}
+
+	if fields := errs.FieldsOf(err); len(fields) > 0 {
+		details := make([]map[string]interface{}, 0, len(fields))
+		for _, field := range fields {
+			details = append(details, map[string]interface{}{
+				"field": field.Field,
+				"code":  field.Code,
+				"error": i18n.Translate(lang, field.Code, field.Args...),
+			})
+		}
+		body["details"] = details
+	}
+
+	if conflicting := errs.ConflictingOf(err); conflicting != nil {
+		body["conflict"] = conflicting
+	}
+
+	return kindStatus(errs.KindOf(err)), body
+}
+
+func kindStatus(kind errs.Kind) int {
+	switch kind {
+	case errs.KindValidation:
+		return http.StatusBadRequest
+	case errs.KindNotFound:
+		return http.StatusNotFound
+	case errs.KindConflict:
+		return http.StatusConflict
+	case errs.KindForbidden:
+		return http.StatusForbidden
+	case errs.KindUnauthorized:
+		return http.StatusUnauthorized
+	case errs.KindTooManyRequests:
+		return http.StatusTooManyRequests
+	case errs.KindUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// httpErrorCode подбирает код для ошибок самого Echo: неизвестный маршрут,
+// неподдерживаемый метод, некорректное тело запроса.
+func httpErrorCode(status int) i18n.Code {
+	switch status {
+	case http.StatusNotFound:
+		return i18n.CodeRouteNotFound
+	case http.StatusMethodNotAllowed:
+		return i18n.CodeMethodNotAllowed
+	case http.StatusUnauthorized:
+		return i18n.CodeUnauthorized
+	case http.StatusForbidden:
+		return i18n.CodeForbidden
+	}
+
+	if status < http.StatusInternalServerError {
+		return i18n.CodeInvalidRequest
+	}
+	return i18n.CodeInternal
+}
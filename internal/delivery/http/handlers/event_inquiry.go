@@ -0,0 +1,367 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type EventInquiryHandler struct {
+	inquiryUC usecase.EventInquiryUseCase
+}
+
+func NewEventInquiryHandler(inquiryUC usecase.EventInquiryUseCase) *EventInquiryHandler {
+	return &EventInquiryHandler{
+		inquiryUC: inquiryUC,
+	}
+}
+
+func (h *EventInquiryHandler) Register(e *echo.Group) {
+	staff := middleware.RequireRole(models.RoleAdmin, models.RoleManager, models.RoleWaiter)
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
+	e.POST("/restaurants/:id/inquiries", h.Create, staff)
+	e.GET("/restaurants/:id/inquiries", h.GetByRestaurant, staff)
+
+	inquiries := e.Group("/inquiries", staff)
+	inquiries.GET("/:id", h.GetByID)
+	inquiries.PUT("/:id", h.Update)
+	inquiries.POST("/:id/status", h.UpdateStatus)
+	inquiries.POST("/:id/assign", h.Assign, manage)
+	inquiries.POST("/:id/notes", h.AddNote)
+	inquiries.GET("/:id/activities", h.GetActivities)
+	inquiries.POST("/:id/convert", h.Convert, manage)
+}
+
+// Create godoc
+// @Summary Создать заявку на банкет
+// @Description Записывает заявку гостя: контактный телефон, желаемую дату (ГГГГ-ММ-ДД), число гостей, тип события
+// @Description и заметки. Заявка создается в статусе new; статус, менеджер и событие в теле игнорируются
+// @Tags event-inquiries
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param inquiry body models.EventInquiry true "Данные заявки"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/inquiries [post]
+func (h *EventInquiryHandler) Create(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var inquiry models.EventInquiry
+	if err := c.Bind(&inquiry); err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryData)
+	}
+	inquiry.RestaurantID = restaurantID
+
+	id, err := h.inquiryUC.Create(c.Request().Context(), &inquiry)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"id":      id,
+		"code":    i18n.MsgInquiryCreated,
+		"message": localize(c, i18n.MsgInquiryCreated),
+	})
+}
+
+// GetByRestaurant godoc
+// @Summary Получить заявки ресторана
+// @Description Возвращает заявки ресторана по возрастанию желаемой даты. Можно отфильтровать по статусу
+// @Description и назначенному менеджеру
+// @Tags event-inquiries
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param status query string false "Статус: new, contacted, quoted, deposit_paid, confirmed, lost"
+// @Param assigned_to query int false "ID назначенного менеджера"
+// @Success 200 {array} models.EventInquiry
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/inquiries [get]
+func (h *EventInquiryHandler) GetByRestaurant(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var fields errs.Fields
+	filter := models.InquiryFilter{
+		Status:     models.InquiryStatus(c.QueryParam("status")),
+		AssignedTo: queryInt64(c, "assigned_to", &fields),
+	}
+	if err := fields.Err(); err != nil {
+		return err
+	}
+
+	inquiries, err := h.inquiryUC.GetByRestaurant(c.Request().Context(), restaurantID, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, inquiries)
+}
+
+// GetByID godoc
+// @Summary Получить заявку на банкет
+// @Description Возвращает заявку по ее ID
+// @Tags event-inquiries
+// @Accept json
+// @Produce json
+// @Param id path int true "ID заявки"
+// @Success 200 {object} models.EventInquiry
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /inquiries/{id} [get]
+func (h *EventInquiryHandler) GetByID(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryID)
+	}
+
+	inquiry, err := h.inquiryUC.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, inquiry)
+}
+
+// Update godoc
+// @Summary Обновить заявку на банкет
+// @Description Заменяет контакт, желаемую дату, число гостей, тип события и заметки. Подтвержденную или
+// @Description потерянную заявку изменить нельзя
+// @Tags event-inquiries
+// @Accept json
+// @Produce json
+// @Param id path int true "ID заявки"
+// @Param inquiry body models.EventInquiry true "Обновленные данные заявки"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /inquiries/{id} [put]
+func (h *EventInquiryHandler) Update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryID)
+	}
+
+	var inquiry models.EventInquiry
+	if err := c.Bind(&inquiry); err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryData)
+	}
+	inquiry.ID = id
+
+	if err := h.inquiryUC.Update(c.Request().Context(), &inquiry); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgInquiryUpdated,
+		"message": localize(c, i18n.MsgInquiryUpdated),
+	})
+}
+
+// UpdateStatus godoc
+// @Summary Изменить статус заявки
+// @Description Двигает заявку по воронке new → contacted → quoted; этапы можно пропускать, но не возвращаться
+// @Description назад. Открытую заявку можно перевести в lost, пока ее депозит не ждет оплаты. Статусы
+// @Description deposit_paid и confirmed ставятся только переводом заявки в бронирование и оплатой депозита.
+// @Description Заметка попадает в журнал заявки
+// @Tags event-inquiries
+// @Accept json
+// @Produce json
+// @Param id path int true "ID заявки"
+// @Param status body models.InquiryStatusRequest true "Новый статус и заметка"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /inquiries/{id}/status [post]
+func (h *EventInquiryHandler) UpdateStatus(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryID)
+	}
+
+	var req models.InquiryStatusRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryData)
+	}
+
+	if err := h.inquiryUC.UpdateStatus(c.Request().Context(), id, &req); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgInquiryStatus,
+		"message": localize(c, i18n.MsgInquiryStatus),
+	})
+}
+
+// Assign godoc
+// @Summary Назначить менеджера заявки
+// @Description Назначает открытой заявке менеджера ресторана. user_id null снимает назначение
+// @Tags event-inquiries
+// @Accept json
+// @Produce json
+// @Param id path int true "ID заявки"
+// @Param assignee body models.InquiryAssignRequest true "ID менеджера"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /inquiries/{id}/assign [post]
+func (h *EventInquiryHandler) Assign(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryID)
+	}
+
+	var req models.InquiryAssignRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryData)
+	}
+
+	if err := h.inquiryUC.Assign(c.Request().Context(), id, req.UserID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgInquiryAssigned,
+		"message": localize(c, i18n.MsgInquiryAssigned),
+	})
+}
+
+// AddNote godoc
+// @Summary Добавить заметку к заявке
+// @Description Записывает заметку в журнал заявки, например итог звонка гостю
+// @Tags event-inquiries
+// @Accept json
+// @Produce json
+// @Param id path int true "ID заявки"
+// @Param note body models.InquiryNoteRequest true "Текст заметки"
+// @Success 201 {object} models.InquiryActivity
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /inquiries/{id}/notes [post]
+func (h *EventInquiryHandler) AddNote(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryID)
+	}
+
+	var req models.InquiryNoteRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryData)
+	}
+
+	activity, err := h.inquiryUC.AddNote(c.Request().Context(), id, req.Note)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, activity)
+}
+
+// GetActivities godoc
+// @Summary Получить журнал заявки
+// @Description Возвращает действия по заявке в хронологическом порядке: создание, изменения, смены статуса,
+// @Description назначения, заметки и перевод в бронирование
+// @Tags event-inquiries
+// @Accept json
+// @Produce json
+// @Param id path int true "ID заявки"
+// @Success 200 {array} models.InquiryActivity
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /inquiries/{id}/activities [get]
+func (h *EventInquiryHandler) GetActivities(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryID)
+	}
+
+	activities, err := h.inquiryUC.GetActivities(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, activities)
+}
+
+// Convert godoc
+// @Summary Перевести заявку в бронирование
+// @Description Бронирует событие ресторана на желаемую дату заявки в статусе quoted: занимает столы table_ids
+// @Description или все столы секции section_id (нужно ровно одно из двух). Без депозита заявка сразу
+// @Description подтверждается. Если событие требует депозит, ответ содержит deposit с payment_url: бронирование
+// @Description ждет оплаты, а заявку подтверждает уведомление об оплате
+// @Tags event-inquiries
+// @Accept json
+// @Produce json
+// @Param id path int true "ID заявки"
+// @Param conversion body models.InquiryConversion true "Событие и столы или секция"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /inquiries/{id}/convert [post]
+func (h *EventInquiryHandler) Convert(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryID)
+	}
+
+	var req models.InquiryConversion
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidInquiryData)
+	}
+
+	result, err := h.inquiryUC.Convert(c.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	code := i18n.MsgInquiryConverted
+	if result.Deposit != nil {
+		code = i18n.MsgInquiryAwaitingPayment
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"bookings": result.Bookings,
+		"deposit":  result.Deposit,
+		"code":     code,
+		"message":  localize(c, code),
+	})
+}
@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type EventPackageHandler struct {
+	packageUC usecase.EventPackageUseCase
+}
+
+func NewEventPackageHandler(packageUC usecase.EventPackageUseCase) *EventPackageHandler {
+	return &EventPackageHandler{
+		packageUC: packageUC,
+	}
+}
+
+func (h *EventPackageHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
+	packages := e.Group("/events/:id/packages")
+	packages.POST("", h.Create, manage)
+	packages.GET("", h.GetByEvent)
+	packages.GET("/:packageID", h.GetByID)
+	packages.PUT("/:packageID", h.Update, manage)
+	packages.DELETE("/:packageID", h.Delete, manage)
+	packages.GET("/:packageID/quote", h.Quote)
+
+	e.GET("/restaurants/:id/event-pricing", h.GetPricing)
+	e.PUT("/restaurants/:id/event-pricing", h.SetPricing, manage)
+}
+
+// Create godoc
+// @Summary Создать пакет события
+// @Description Добавляет событию пакет банкета с ценой за гостя. tier — standard (по умолчанию) или premium,
+// @Description min_guests по умолчанию 1, max_guests null — без верхней границы. Если is_active не передан,
+// @Description пакет создается активным
+// @Tags event-packages
+// @Accept json
+// @Produce json
+// @Param id path int true "ID события"
+// @Param package body models.EventPackage true "Данные пакета"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /events/{id}/packages [post]
+func (h *EventPackageHandler) Create(c echo.Context) error {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidEventID)
+	}
+
+	pkg := models.EventPackage{IsActive: true}
+	if err := c.Bind(&pkg); err != nil {
+		return errs.Validation(i18n.CodeInvalidPackageData)
+	}
+	pkg.EventID = eventID
+
+	id, err := h.packageUC.Create(c.Request().Context(), &pkg)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"id":      id,
+		"code":    i18n.MsgPackageCreated,
+		"message": localize(c, i18n.MsgPackageCreated),
+	})
+}
+
+// GetByEvent godoc
+// @Summary Получить пакеты события
+// @Description Возвращает активные пакеты события по возрастанию цены за гостя. С include_inactive=true
+// @Description менеджер ресторана получает и отключенные
+// @Tags event-packages
+// @Accept json
+// @Produce json
+// @Param id path int true "ID события"
+// @Param include_inactive query bool false "Включить отключенные пакеты (только менеджер)"
+// @Success 200 {array} models.EventPackage
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /events/{id}/packages [get]
+func (h *EventPackageHandler) GetByEvent(c echo.Context) error {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidEventID)
+	}
+
+	includeInactive := false
+	if value := c.QueryParam("include_inactive"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return errs.Invalid("include_inactive", i18n.CodeInvalidQueryParam, "include_inactive")
+		}
+		includeInactive = parsed
+	}
+
+	packages, err := h.packageUC.GetByEvent(c.Request().Context(), eventID, includeInactive)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, packages)
+}
+
+// GetByID godoc
+// @Summary Получить пакет события
+// @Description Возвращает пакет события по его ID
+// @Tags event-packages
+// @Accept json
+// @Produce json
+// @Param id path int true "ID события"
+// @Param packageID path int true "ID пакета"
+// @Success 200 {object} models.EventPackage
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /events/{id}/packages/{packageID} [get]
+func (h *EventPackageHandler) GetByID(c echo.Context) error {
+	eventID, id, err := parsePackagePath(c)
+	if err != nil {
+		return err
+	}
+
+	pkg, err := h.packageUC.GetByID(c.Request().Context(), eventID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, pkg)
+}
+
+// Update godoc
+// @Summary Обновить пакет события
+// @Description Заменяет данные пакета. Если is_active не передан, пакет остается активным
+// @Tags event-packages
+// @Accept json
+// @Produce json
+// @Param id path int true "ID события"
+// @Param packageID path int true "ID пакета"
+// @Param package body models.EventPackage true "Обновленные данные пакета"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /events/{id}/packages/{packageID} [put]
+func (h *EventPackageHandler) Update(c echo.Context) error {
+	eventID, id, err := parsePackagePath(c)
+	if err != nil {
+		return err
+	}
+
+	pkg := models.EventPackage{IsActive: true}
+	if err := c.Bind(&pkg); err != nil {
+		return errs.Validation(i18n.CodeInvalidPackageData)
+	}
+	pkg.ID = id
+	pkg.EventID = eventID
+
+	if err := h.packageUC.Update(c.Request().Context(), &pkg); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgPackageUpdated,
+		"message": localize(c, i18n.MsgPackageUpdated),
+	})
+}
+
+// Delete godoc
+// @Summary Удалить пакет события
+// @Description Удаляет пакет события
+// @Tags event-packages
+// @Accept json
+// @Produce json
+// @Param id path int true "ID события"
+// @Param packageID path int true "ID пакета"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /events/{id}/packages/{packageID} [delete]
+func (h *EventPackageHandler) Delete(c echo.Context) error {
+	eventID, id, err := parsePackagePath(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.packageUC.Delete(c.Request().Context(), eventID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgPackageDeleted,
+		"message": localize(c, i18n.MsgPackageDeleted),
+	})
+}
+
+// Quote godoc
+// @Summary Рассчитать стоимость пакета
+// @Description Считает стоимость пакета на число гостей и дату: price_per_guest × guests и надбавку ресторана,
+// @Description если дата приходится на выходной. Число гостей должно укладываться в min_guests и max_guests
+// @Description пакета. Расчет ничего не бронирует
+// @Tags event-packages
+// @Accept json
+// @Produce json
+// @Param id path int true "ID события"
+// @Param packageID path int true "ID пакета"
+// @Param guests query int true "Число гостей"
+// @Param date query string true "Дата банкета (ГГГГ-ММ-ДД)"
+// @Success 200 {object} models.EventQuote
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /events/{id}/packages/{packageID}/quote [get]
+func (h *EventPackageHandler) Quote(c echo.Context) error {
+	eventID, id, err := parsePackagePath(c)
+	if err != nil {
+		return err
+	}
+
+	var fields errs.Fields
+	guests := queryInt64(c, "guests", &fields)
+	date := queryDate(c, "date", &fields)
+	if err := fields.Err(); err != nil {
+		return err
+	}
+
+	var guestCount int
+	if guests != nil {
+		guestCount = int(*guests)
+	}
+	var day time.Time
+	if date != nil {
+		day = *date
+	}
+
+	quote, err := h.packageUC.Quote(c.Request().Context(), eventID, id, guestCount, day)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, quote)
+}
+
+// GetPricing godoc
+// @Summary Получить надбавки к банкетам
+// @Description Возвращает выходные дни ресторана (0 — воскресенье) и надбавку к стоимости пакетов в эти дни.
+// @Description Если ресторан их не задал, выходные — суббота и воскресенье без надбавки
+// @Tags event-packages
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Success 200 {object} models.EventPricing
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/event-pricing [get]
+func (h *EventPackageHandler) GetPricing(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	pricing, err := h.packageUC.GetPricing(c.Request().Context(), restaurantID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, pricing)
+}
+
+// SetPricing godoc
+// @Summary Задать надбавки к банкетам
+// @Description Заменяет выходные дни ресторана и надбавку weekend_percent (от 0 до 100) к стоимости пакетов
+// @Description в эти дни
+// @Tags event-packages
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param pricing body models.EventPricing true "Выходные дни и надбавка"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/event-pricing [put]
+func (h *EventPackageHandler) SetPricing(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var pricing models.EventPricing
+	if err := c.Bind(&pricing); err != nil {
+		return errs.Validation(i18n.CodeInvalidEventPricing)
+	}
+	pricing.RestaurantID = restaurantID
+
+	if err := h.packageUC.SetPricing(c.Request().Context(), &pricing); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgEventPricingUpdated,
+		"message": localize(c, i18n.MsgEventPricingUpdated),
+	})
+}
+
+func parsePackagePath(c echo.Context) (int64, int64, error) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return 0, 0, errs.Validation(i18n.CodeInvalidEventID)
+	}
+
+	id, err := strconv.ParseInt(c.Param("packageID"), 10, 64)
+	if err != nil {
+		return 0, 0, errs.Validation(i18n.CodeInvalidPackageID)
+	}
+
+	return eventID, id, nil
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type EventTypeHandler struct {
+	eventTypeUC usecase.EventTypeUseCase
+}
+
+func NewEventTypeHandler(eventTypeUC usecase.EventTypeUseCase) *EventTypeHandler {
+	return &EventTypeHandler{
+		eventTypeUC: eventTypeUC,
+	}
+}
+
+func (h *EventTypeHandler) Register(e *echo.Group) {
+	adminOnly := middleware.RequireRole(models.RoleAdmin)
+
+	eventTypes := e.Group("/event-types")
+	eventTypes.POST("", h.Create, adminOnly)
+	eventTypes.GET("/:id", h.GetByID)
+	eventTypes.PUT("/:id", h.Update, adminOnly)
+	eventTypes.DELETE("/:id", h.Delete, adminOnly)
+	eventTypes.GET("", h.List)
+}
+
+// Create godoc
+// @Summary Создать тип события
+// @Description Добавляет тип события в справочник. code — латиница в нижнем регистре, цифры и подчеркивание;
+// @Description после создания не меняется. Если is_active не передан, тип создается активным
+// @Tags event-types
+// @Accept json
+// @Produce json
+// @Param eventType body models.EventType true "Данные типа события"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /event-types [post]
+func (h *EventTypeHandler) Create(c echo.Context) error {
+	eventType := models.EventType{IsActive: true}
+	if err := c.Bind(&eventType); err != nil {
+		return errs.Validation(i18n.CodeInvalidEventTypeData)
+	}
+
+	id, err := h.eventTypeUC.Create(c.Request().Context(), &eventType)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"id":      id,
+		"code":    i18n.MsgEventTypeCreated,
+		"message": localize(c, i18n.MsgEventTypeCreated),
+	})
+}
+
+// GetByID godoc
+// @Summary Получить тип события по ID
+// @Description Возвращает тип события по его ID
+// @Tags event-types
+// @Accept json
+// @Produce json
+// @Param id path int true "ID типа события"
+// @Success 200 {object} models.EventType
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /event-types/{id} [get]
+func (h *EventTypeHandler) GetByID(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidEventTypeID)
+	}
+
+	eventType, err := h.eventTypeUC.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, eventType)
+}
+
+// Update godoc
+// @Summary Обновить тип события
+// @Description Меняет названия, иконку и активность типа события. code не меняется. Отключенный тип нельзя выбрать
+// @Description для нового события, существующие события его сохраняют. Если is_active не передан, тип остается активным
+// @Tags event-types
+// @Accept json
+// @Produce json
+// @Param id path int true "ID типа события"
+// @Param eventType body models.EventType true "Обновленные данные типа события"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /event-types/{id} [put]
+func (h *EventTypeHandler) Update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidEventTypeID)
+	}
+
+	eventType := models.EventType{IsActive: true}
+	if err := c.Bind(&eventType); err != nil {
+		return errs.Validation(i18n.CodeInvalidEventTypeData)
+	}
+
+	eventType.ID = id
+	if err := h.eventTypeUC.Update(c.Request().Context(), &eventType); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgEventTypeUpdated,
+		"message": localize(c, i18n.MsgEventTypeUpdated),
+	})
+}
+
+// Delete godoc
+// @Summary Удалить тип события
+// @Description Удаляет тип события, которым не отмечено ни одно событие. Используемый тип можно только отключить
+// @Tags event-types
+// @Accept json
+// @Produce json
+// @Param id path int true "ID типа события"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /event-types/{id} [delete]
+func (h *EventTypeHandler) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidEventTypeID)
+	}
+
+	if err := h.eventTypeUC.Delete(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgEventTypeDeleted,
+		"message": localize(c, i18n.MsgEventTypeDeleted),
+	})
+}
+
+// List godoc
+// @Summary Получить список типов событий
+// @Description Возвращает активные типы событий. С include_inactive=true администратор получает и отключенные
+// @Tags event-types
+// @Accept json
+// @Produce json
+// @Param include_inactive query bool false "Включить отключенные типы (только администратор)"
+// @Success 200 {array} models.EventType
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /event-types [get]
+func (h *EventTypeHandler) List(c echo.Context) error {
+	includeInactive := false
+	if value := c.QueryParam("include_inactive"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return errs.Invalid("include_inactive", i18n.CodeInvalidQueryParam, "include_inactive")
+		}
+		includeInactive = parsed
+	}
+
+	eventTypes, err := h.eventTypeUC.List(c.Request().Context(), includeInactive)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, eventTypes)
+}
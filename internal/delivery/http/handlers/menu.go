@@ -6,6 +6,9 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/usecase"
 )
@@ -21,12 +24,14 @@ func NewMenuHandler(menuUC usecase.MenuUseCase) *MenuHandler {
 }
 
 func (h *MenuHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
 	menus := e.Group("/menus")
-	menus.POST("", h.Create)
+	menus.POST("", h.Create, manage)
 	menus.GET("/:id", h.GetByID)
 	menus.GET("/restaurant/:restaurantID", h.GetByRestaurant)
-	menus.PUT("/:id", h.Update)
-	menus.DELETE("/:id", h.Delete)
+	menus.PUT("/:id", h.Update, manage)
+	menus.DELETE("/:id", h.Delete, manage)
 
 }
 
@@ -40,25 +45,23 @@ func (h *MenuHandler) Register(e *echo.Group) {
 // @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menus [post]
 func (h *MenuHandler) Create(c echo.Context) error {
 	var menu models.Menu
 	if err := c.Bind(&menu); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuData)
 	}
 
 	id, err := h.menuUC.Create(c.Request().Context(), &menu)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"id":      id,
-		"message": "меню успешно создано",
+		"code":    i18n.MsgMenuCreated,
+		"message": localize(c, i18n.MsgMenuCreated),
 	})
 }
 
@@ -73,21 +76,18 @@ func (h *MenuHandler) Create(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menus/{id} [get]
 func (h *MenuHandler) GetByID(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuID)
 	}
 
 	menu, err := h.menuUC.GetByID(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, menu)
@@ -104,21 +104,18 @@ func (h *MenuHandler) GetByID(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menus/restaurant/{restaurantID} [get]
 func (h *MenuHandler) GetByRestaurant(c echo.Context) error {
 	restaurantIDStr := c.Param("restaurantID")
 	restaurantID, err := strconv.ParseInt(restaurantIDStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
 	}
 
 	menus, err := h.menuUC.GetByRestaurant(c.Request().Context(), restaurantID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, menus)
@@ -136,32 +133,28 @@ func (h *MenuHandler) GetByRestaurant(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menus/{id} [put]
 func (h *MenuHandler) Update(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuID)
 	}
 
 	var menu models.Menu
 	if err := c.Bind(&menu); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuData)
 	}
 
 	menu.ID = id
 	if err := h.menuUC.Update(c.Request().Context(), &menu); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "меню успешно обновлено",
+		"code":    i18n.MsgMenuUpdated,
+		"message": localize(c, i18n.MsgMenuUpdated),
 	})
 }
 
@@ -176,23 +169,21 @@ func (h *MenuHandler) Update(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menus/{id} [delete]
 func (h *MenuHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuID)
 	}
 
 	if err := h.menuUC.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "меню успешно удалено",
+		"code":    i18n.MsgMenuDeleted,
+		"message": localize(c, i18n.MsgMenuDeleted),
 	})
 }
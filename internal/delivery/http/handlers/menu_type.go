@@ -6,6 +6,9 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/usecase"
 )
@@ -21,11 +24,13 @@ func NewMenuTypeHandler(menuTypeUC usecase.MenuTypeUseCase) *MenuTypeHandler {
 }
 
 func (h *MenuTypeHandler) Register(e *echo.Group) {
+	adminOnly := middleware.RequireRole(models.RoleAdmin)
+
 	menuTypes := e.Group("/menu-types")
-	menuTypes.POST("", h.Create)
+	menuTypes.POST("", h.Create, adminOnly)
 	menuTypes.GET("/:id", h.GetByID)
-	menuTypes.PUT("/:id", h.Update)
-	menuTypes.DELETE("/:id", h.Delete)
+	menuTypes.PUT("/:id", h.Update, adminOnly)
+	menuTypes.DELETE("/:id", h.Delete, adminOnly)
 	menuTypes.GET("", h.List)
 }
 
@@ -39,25 +44,23 @@ func (h *MenuTypeHandler) Register(e *echo.Group) {
 // @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menu-types [post]
 func (h *MenuTypeHandler) Create(c echo.Context) error {
 	var menuType models.MenuType
 	if err := c.Bind(&menuType); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные типа меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuTypeData)
 	}
 
 	id, err := h.menuTypeUC.Create(c.Request().Context(), &menuType)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"id":      id,
-		"message": "тип меню успешно создан",
+		"code":    i18n.MsgMenuTypeCreated,
+		"message": localize(c, i18n.MsgMenuTypeCreated),
 	})
 }
 
@@ -72,21 +75,18 @@ func (h *MenuTypeHandler) Create(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menu-types/{id} [get]
 func (h *MenuTypeHandler) GetByID(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID типа меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuTypeID)
 	}
 
 	menuType, err := h.menuTypeUC.GetByID(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, menuType)
@@ -104,32 +104,28 @@ func (h *MenuTypeHandler) GetByID(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menu-types/{id} [put]
 func (h *MenuTypeHandler) Update(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID типа меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuTypeID)
 	}
 
 	var menuType models.MenuType
 	if err := c.Bind(&menuType); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные типа меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuTypeData)
 	}
 
 	menuType.ID = id
 	if err := h.menuTypeUC.Update(c.Request().Context(), &menuType); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "тип меню успешно обновлен",
+		"code":    i18n.MsgMenuTypeUpdated,
+		"message": localize(c, i18n.MsgMenuTypeUpdated),
 	})
 }
 
@@ -144,24 +140,22 @@ func (h *MenuTypeHandler) Update(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menu-types/{id} [delete]
 func (h *MenuTypeHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID типа меню",
-		})
+		return errs.Validation(i18n.CodeInvalidMenuTypeID)
 	}
 
 	if err := h.menuTypeUC.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "тип меню успешно удален",
+		"code":    i18n.MsgMenuTypeDeleted,
+		"message": localize(c, i18n.MsgMenuTypeDeleted),
 	})
 }
 
@@ -173,13 +167,12 @@ func (h *MenuTypeHandler) Delete(c echo.Context) error {
 // @Produce json
 // @Success 200 {array} models.MenuType
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /menu-types [get]
 func (h *MenuTypeHandler) List(c echo.Context) error {
 	menuTypes, err := h.menuTypeUC.List(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, menuTypes)
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type NoShowHandler struct {
+	reservationUC usecase.ReservationUseCase
+}
+
+func NewNoShowHandler(reservationUC usecase.ReservationUseCase) *NoShowHandler {
+	return &NoShowHandler{
+		reservationUC: reservationUC,
+	}
+}
+
+func (h *NoShowHandler) Register(e *echo.Group) {
+	staff := middleware.RequireRole(models.RoleAdmin, models.RoleManager, models.RoleWaiter)
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
+	e.GET("/restaurants/:id/no-show-policy", h.GetPolicy, staff)
+	e.PUT("/restaurants/:id/no-show-policy", h.SetPolicy, manage)
+	e.POST("/reservations/:id/no-show", h.Mark, staff)
+	e.POST("/reservations/:id/no-show/forgive", h.Forgive, manage)
+}
+
+// GetPolicy godoc
+// @Summary Получить правила неявок ресторана
+// @Description Возвращает пороги неявок гостя, с которых бронирование требует депозит или закрыто для самого гостя.
+// @Description Пустой порог означает, что правило не действует
+// @Tags no-shows
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Success 200 {object} models.NoShowPolicy
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/no-show-policy [get]
+func (h *NoShowHandler) GetPolicy(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	policy, err := h.reservationUC.GetNoShowPolicy(c.Request().Context(), restaurantID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, policy)
+}
+
+// SetPolicy godoc
+// @Summary Задать правила неявок ресторана
+// @Description Заменяет правила целиком. С deposit_after неявок бронирование стола ждет оплаты депозита deposit_amount,
+// @Description с block_after неявок гость не может бронировать сам, но сотрудник ресторана может забронировать для него.
+// @Description null отключает правило
+// @Tags no-shows
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param policy body models.NoShowPolicy true "Пороги неявок и сумма депозита"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/no-show-policy [put]
+func (h *NoShowHandler) SetPolicy(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var policy models.NoShowPolicy
+	if err := c.Bind(&policy); err != nil {
+		return errs.Validation(i18n.CodeInvalidNoShowPolicy)
+	}
+	policy.RestaurantID = restaurantID
+
+	if err := h.reservationUC.SetNoShowPolicy(c.Request().Context(), &policy); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgNoShowPolicyUpdated,
+		"message": localize(c, i18n.MsgNoShowPolicyUpdated),
+	})
+}
+
+// Mark godoc
+// @Summary Отметить неявку
+// @Description Переводит подтвержденное бронирование в no_show и увеличивает счетчик неявок гостя. Доступно после
+// @Description начала бронирования
+// @Tags no-shows
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бронирования"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /reservations/{id}/no-show [post]
+func (h *NoShowHandler) Mark(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationID)
+	}
+
+	if err := h.reservationUC.UpdateStatus(c.Request().Context(), id, models.ReservationNoShow); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgNoShowMarked,
+		"message": localize(c, i18n.MsgNoShowMarked),
+	})
+}
+
+// Forgive godoc
+// @Summary Простить неявку
+// @Description Уменьшает счетчик неявок гостя. Бронирование остается в статусе no_show с отметкой no_show_forgiven_at;
+// @Description простить неявку можно один раз
+// @Tags no-shows
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бронирования"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /reservations/{id}/no-show/forgive [post]
+func (h *NoShowHandler) Forgive(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationID)
+	}
+
+	if err := h.reservationUC.ForgiveNoShow(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgNoShowForgiven,
+		"message": localize(c, i18n.MsgNoShowForgiven),
+	})
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/usecase"
+)
+
+// paymentSignatureHeader — заголовок с HMAC-SHA256 подписью тела уведомления.
+const paymentSignatureHeader = "X-Payment-Signature"
+
+type PaymentHandler struct {
+	bookingUC usecase.RestaurantEventTableUseCase
+}
+
+func NewPaymentHandler(bookingUC usecase.RestaurantEventTableUseCase) *PaymentHandler {
+	return &PaymentHandler{
+		bookingUC: bookingUC,
+	}
+}
+
+// Register подключает маршруты без авторизации: провайдер подтверждает
+// уведомление подписью, а не токеном.
+func (h *PaymentHandler) Register(e *echo.Group) {
+	e.POST("/payments/callback", h.Callback)
+}
+
+// Callback godoc
+// @Summary Уведомление о платеже
+// @Description Принимает уведомление платежного провайдера. Подпись — HMAC-SHA256 тела запроса в hex в заголовке
+// @Description X-Payment-Signature. Успешная оплата подтверждает бронирования депозита; повторное уведомление ничего не меняет
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param X-Payment-Signature header string true "Подпись тела запроса"
+// @Param callback body payment.Callback true "Платеж, статус и сумма"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Router /payments/callback [post]
+func (h *PaymentHandler) Callback(c echo.Context) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return errs.Validation(i18n.CodeRequestReadError)
+	}
+
+	err = h.bookingUC.ConfirmPayment(c.Request().Context(), body, c.Request().Header.Get(paymentSignatureHeader))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgPaymentAccepted,
+		"message": localize(c, i18n.MsgPaymentAccepted),
+	})
+}
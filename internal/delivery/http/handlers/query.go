@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+)
+
+// queryInt64 разбирает необязательный неотрицательный числовой параметр
+// запроса. Ошибка добавляется в fields, чтобы вернуть все ошибки сразу.
+func queryInt64(c echo.Context, name string, fields *errs.Fields) *int64 {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return nil
+	}
+
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || value < 0 {
+		fields.Add(name, i18n.CodeInvalidQueryParam, name)
+		return nil
+	}
+	return &value
+}
+
+// queryTime разбирает необязательный параметр запроса в формате RFC3339.
+func queryTime(c echo.Context, name string, fields *errs.Fields) *time.Time {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return nil
+	}
+
+	value, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		fields.Add(name, i18n.CodeInvalidQueryParam, name)
+		return nil
+	}
+	return &value
+}
+
+// queryDate разбирает необязательный параметр запроса с датой без времени
+// (ГГГГ-ММ-ДД). Дата возвращается полуночью UTC; к часовому поясу ресторана
+// ее приводит сценарий.
+func queryDate(c echo.Context, name string, fields *errs.Fields) *time.Time {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return nil
+	}
+
+	value, err := time.Parse(time.DateOnly, raw)
+	if err != nil {
+		fields.Add(name, i18n.CodeInvalidQueryParam, name)
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,397 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type ReservationHandler struct {
+	reservationUC usecase.ReservationUseCase
+}
+
+func NewReservationHandler(reservationUC usecase.ReservationUseCase) *ReservationHandler {
+	return &ReservationHandler{
+		reservationUC: reservationUC,
+	}
+}
+
+func (h *ReservationHandler) Register(e *echo.Group) {
+	staff := middleware.RequireRole(models.RoleAdmin, models.RoleManager, models.RoleWaiter)
+
+	reservations := e.Group("/reservations")
+	reservations.POST("", h.Create)
+	reservations.GET("/:id", h.GetByID)
+	reservations.PUT("/:id", h.Update)
+	reservations.POST("/:id/cancel", h.Cancel)
+	reservations.PUT("/:id/status", h.UpdateStatus, staff)
+
+	e.GET("/restaurants/:id/reservations", h.GetByRestaurant, staff)
+	e.GET("/restaurants/:id/table-assignment", h.PreviewAssignment)
+	e.GET("/restaurants/:id/availability", h.Availability)
+	e.GET("/users/:id/reservations", h.GetByUser)
+}
+
+// Create godoc
+// @Summary Забронировать стол
+// @Description Бронирует стол на промежуток времени. Гость бронирует для себя, сотрудник ресторана может указать user_id гостя.
+// @Description Продолжительность по умолчанию — 120 минут. Вместо table_id можно указать combination_id, тогда заняты будут все столы сочетания.
+// @Description Если не указаны ни стол, ни сочетание, а указан restaurant_id, стол или сочетание подбирается автоматически.
+// @Description Новое бронирование получает статус pending. Если по правилам неявок ресторана гостю нужен депозит,
+// @Description в ответе есть deposit со ссылкой на оплату; гость, которому бронирование закрыто, получает 403
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param reservation body models.Reservation true "Стол, время, количество гостей и пожелания"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /reservations [post]
+func (h *ReservationHandler) Create(c echo.Context) error {
+	var reservation models.Reservation
+	if err := c.Bind(&reservation); err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationData)
+	}
+
+	id, err := h.reservationUC.Create(c.Request().Context(), &reservation)
+	if err != nil {
+		return err
+	}
+
+	if reservation.Deposit != nil {
+		return c.JSON(http.StatusCreated, map[string]interface{}{
+			"id":      id,
+			"deposit": reservation.Deposit,
+			"code":    i18n.MsgReservationAwaitsPayment,
+			"message": localize(c, i18n.MsgReservationAwaitsPayment),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"id":      id,
+		"code":    i18n.MsgReservationCreated,
+		"message": localize(c, i18n.MsgReservationCreated),
+	})
+}
+
+// GetByID godoc
+// @Summary Получить бронирование по ID
+// @Description Возвращает бронирование гостю, которому оно принадлежит, или сотруднику ресторана
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бронирования"
+// @Success 200 {object} models.Reservation
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /reservations/{id} [get]
+func (h *ReservationHandler) GetByID(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationID)
+	}
+
+	reservation, err := h.reservationUC.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, reservation)
+}
+
+// Update godoc
+// @Summary Изменить бронирование
+// @Description Меняет стол или сочетание, время, продолжительность, количество гостей и пожелания ожидающего или подтвержденного бронирования
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бронирования"
+// @Param reservation body models.Reservation true "Новые данные бронирования"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /reservations/{id} [put]
+func (h *ReservationHandler) Update(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationID)
+	}
+
+	var reservation models.Reservation
+	if err := c.Bind(&reservation); err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationData)
+	}
+
+	reservation.ID = id
+	if err := h.reservationUC.Update(c.Request().Context(), &reservation); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgReservationUpdated,
+		"message": localize(c, i18n.MsgReservationUpdated),
+	})
+}
+
+// Cancel godoc
+// @Summary Отменить бронирование
+// @Description Отменяет ожидающее или подтвержденное бронирование
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бронирования"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /reservations/{id}/cancel [post]
+func (h *ReservationHandler) Cancel(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationID)
+	}
+
+	if err := h.reservationUC.Cancel(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgReservationCancelled,
+		"message": localize(c, i18n.MsgReservationCancelled),
+	})
+}
+
+// UpdateStatus godoc
+// @Summary Изменить статус бронирования
+// @Description Переводит бронирование по жизненному циклу: pending → confirmed → seated → completed,
+// @Description а также в cancelled или no_show. Доступно сотрудникам ресторана
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бронирования"
+// @Param status body models.ReservationStatusRequest true "Новый статус"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /reservations/{id}/status [put]
+func (h *ReservationHandler) UpdateStatus(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationID)
+	}
+
+	var req models.ReservationStatusRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidReservationData)
+	}
+
+	if err := h.reservationUC.UpdateStatus(c.Request().Context(), id, req.Status); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgReservationStatus,
+		"message": localize(c, i18n.MsgReservationStatus),
+	})
+}
+
+// GetByRestaurant godoc
+// @Summary Получить бронирования ресторана
+// @Description Возвращает бронирования ресторана, начинающиеся в указанном периоде, в порядке времени начала
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param from query string false "Начало периода (RFC3339)"
+// @Param to query string false "Конец периода (RFC3339), не включительно"
+// @Param status query string false "Статус бронирования"
+// @Success 200 {array} models.Reservation
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/reservations [get]
+func (h *ReservationHandler) GetByRestaurant(c echo.Context) error {
+	idStr := c.Param("id")
+	restaurantID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	filter := models.ReservationFilter{
+		Status: models.ReservationStatus(c.QueryParam("status")),
+	}
+
+	var fields errs.Fields
+	filter.From = queryTime(c, "from", &fields)
+	filter.To = queryTime(c, "to", &fields)
+	if err := fields.Err(); err != nil {
+		return err
+	}
+
+	reservations, err := h.reservationUC.GetByRestaurant(c.Request().Context(), restaurantID, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, reservations)
+}
+
+// PreviewAssignment godoc
+// @Summary Подобрать стол
+// @Description Показывает, какой свободный стол лучше всего подходит гостям, ничего не бронируя. Если ни один стол
+// @Description не вмещает гостей, предлагает свободное сочетание столов (combination_id), а без него — несколько столов одной секции (combined = true)
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param party_size query int true "Количество гостей"
+// @Param start_time query string true "Время начала (RFC3339)"
+// @Param duration_minutes query int false "Продолжительность в минутах" default(120)
+// @Param section_id query int false "ID секции"
+// @Param shape query string false "Форма стола (round, square, rectangle, booth, bar)"
+// @Param tags query string false "Обязательные теги через запятую (window, vip, smoking, kids_friendly, wheelchair)"
+// @Success 200 {object} models.TableAssignment
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/table-assignment [get]
+func (h *ReservationHandler) PreviewAssignment(c echo.Context) error {
+	idStr := c.Param("id")
+	restaurantID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	req := models.AssignmentRequest{
+		RestaurantID: restaurantID,
+		Shape:        models.TableShape(c.QueryParam("shape")),
+	}
+	if tags := c.QueryParam("tags"); tags != "" {
+		req.Tags = strings.Split(tags, ",")
+	}
+
+	var fields errs.Fields
+	if partySize := queryInt64(c, "party_size", &fields); partySize != nil {
+		req.PartySize = int(*partySize)
+	}
+	if startTime := queryTime(c, "start_time", &fields); startTime != nil {
+		req.StartTime = *startTime
+	}
+	if duration := queryInt64(c, "duration_minutes", &fields); duration != nil {
+		req.DurationMinutes = int(*duration)
+	}
+	if sectionID := queryInt64(c, "section_id", &fields); sectionID != nil {
+		req.SectionID = *sectionID
+	}
+	if err := fields.Err(); err != nil {
+		return err
+	}
+
+	assignment, err := h.reservationUC.PreviewAssignment(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, assignment)
+}
+
+// Availability godoc
+// @Summary Найти свободное время
+// @Description Возвращает время на дату с шагом сетки, когда свободен хотя бы один стол или сочетание столов, вмещающие гостей, и списки таких столов и сочетаний.
+// @Description Учитываются бронирования, события и перерыв между гостями
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param date query string true "Дата (ГГГГ-ММ-ДД)"
+// @Param party_size query int true "Количество гостей"
+// @Param duration_minutes query int false "Продолжительность в минутах" default(120)
+// @Param section query int false "ID секции"
+// @Success 200 {array} models.AvailabilitySlot
+// @Failure 400 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/availability [get]
+func (h *ReservationHandler) Availability(c echo.Context) error {
+	idStr := c.Param("id")
+	restaurantID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	req := models.AvailabilityRequest{RestaurantID: restaurantID}
+
+	var fields errs.Fields
+	if date := queryDate(c, "date", &fields); date != nil {
+		req.Date = *date
+	}
+	if partySize := queryInt64(c, "party_size", &fields); partySize != nil {
+		req.PartySize = int(*partySize)
+	}
+	if duration := queryInt64(c, "duration_minutes", &fields); duration != nil {
+		req.DurationMinutes = int(*duration)
+	}
+	if sectionID := queryInt64(c, "section", &fields); sectionID != nil {
+		req.SectionID = *sectionID
+	}
+	if err := fields.Err(); err != nil {
+		return err
+	}
+
+	slots, err := h.reservationUC.Availability(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, slots)
+}
+
+// GetByUser godoc
+// @Summary Получить бронирования пользователя
+// @Description Возвращает бронирования гостя, новые первыми. Доступно самому гостю и администратору
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {array} models.Reservation
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /users/{id}/reservations [get]
+func (h *ReservationHandler) GetByUser(c echo.Context) error {
+	idStr := c.Param("id")
+	userID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidUserID)
+	}
+
+	reservations, err := h.reservationUC.GetByUser(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, reservations)
+}
@@ -0,0 +1,12 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/i18n"
+)
+
+func localize(c echo.Context, code i18n.Code, args ...interface{}) string {
+	return i18n.Translate(middleware.Lang(c), code, args...)
+}
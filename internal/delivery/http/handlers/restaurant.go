@@ -6,6 +6,9 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/usecase"
 )
@@ -21,12 +24,14 @@ func NewRestaurantHandler(restaurantUC usecase.RestaurantUseCase) *RestaurantHan
 }
 
 func (h *RestaurantHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
 	restaurants := e.Group("/restaurants")
-	restaurants.POST("", h.Create)
+	restaurants.POST("", h.Create, manage)
 	restaurants.GET("/:id", h.GetByID)
 	restaurants.GET("/city/:cityID", h.GetByCity)
-	restaurants.PUT("/:id", h.Update)
-	restaurants.DELETE("/:id", h.Delete)
+	restaurants.PUT("/:id", h.Update, manage)
+	restaurants.DELETE("/:id", h.Delete, manage)
 	restaurants.GET("", h.List)
 }
 
@@ -40,25 +45,23 @@ func (h *RestaurantHandler) Register(e *echo.Group) {
 // @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /restaurants [post]
 func (h *RestaurantHandler) Create(c echo.Context) error {
 	var restaurant models.Restaurant
 	if err := c.Bind(&restaurant); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidRestaurantData)
 	}
 
 	id, err := h.restaurantUC.Create(c.Request().Context(), &restaurant)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"id":      id,
-		"message": "ресторан успешно создан",
+		"code":    i18n.MsgRestaurantCreated,
+		"message": localize(c, i18n.MsgRestaurantCreated),
 	})
 }
 
@@ -73,21 +76,18 @@ func (h *RestaurantHandler) Create(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /restaurants/{id} [get]
 func (h *RestaurantHandler) GetByID(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
 	}
 
 	restaurant, err := h.restaurantUC.GetByID(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, restaurant)
@@ -104,21 +104,18 @@ func (h *RestaurantHandler) GetByID(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /restaurants/city/{cityID} [get]
 func (h *RestaurantHandler) GetByCity(c echo.Context) error {
 	cityIDStr := c.Param("cityID")
 	cityID, err := strconv.ParseInt(cityIDStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID города",
-		})
+		return errs.Validation(i18n.CodeInvalidCityID)
 	}
 
 	restaurants, err := h.restaurantUC.GetByCity(c.Request().Context(), cityID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, restaurants)
@@ -136,32 +133,28 @@ func (h *RestaurantHandler) GetByCity(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /restaurants/{id} [put]
 func (h *RestaurantHandler) Update(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
 	}
 
 	var restaurant models.Restaurant
 	if err := c.Bind(&restaurant); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidRestaurantData)
 	}
 
 	restaurant.ID = id
 	if err := h.restaurantUC.Update(c.Request().Context(), &restaurant); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "ресторан успешно обновлен",
+		"code":    i18n.MsgRestaurantUpdated,
+		"message": localize(c, i18n.MsgRestaurantUpdated),
 	})
 }
 
@@ -176,24 +169,22 @@ func (h *RestaurantHandler) Update(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /restaurants/{id} [delete]
 func (h *RestaurantHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
 	}
 
 	if err := h.restaurantUC.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "ресторан успешно удален",
+		"code":    i18n.MsgRestaurantDeleted,
+		"message": localize(c, i18n.MsgRestaurantDeleted),
 	})
 }
 
@@ -206,6 +197,7 @@ func (h *RestaurantHandler) Delete(c echo.Context) error {
 // @Param active query bool false "Фильтр по активности ресторанов" default(true)
 // @Success 200 {array} models.Restaurant
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /restaurants [get]
 func (h *RestaurantHandler) List(c echo.Context) error {
 	activeStr := c.QueryParam("active")
@@ -220,9 +212,7 @@ func (h *RestaurantHandler) List(c echo.Context) error {
 
 	restaurants, err := h.restaurantUC.List(c.Request().Context(), active)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, restaurants)
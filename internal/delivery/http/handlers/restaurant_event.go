@@ -6,6 +6,9 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/usecase"
 )
@@ -21,13 +24,17 @@ func NewRestaurantEventHandler(eventUC usecase.RestaurantEventUseCase) *Restaura
 }
 
 func (h *RestaurantEventHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
 	events := e.Group("/events")
-	events.POST("", h.Create)
+	events.POST("", h.Create, manage)
 	events.GET("/:id", h.GetByID)
 	events.GET("/type/:type", h.GetByType)
-	events.PUT("/:id", h.Update)
-	events.DELETE("/:id", h.Delete)
+	events.PUT("/:id", h.Update, manage)
+	events.DELETE("/:id", h.Delete, manage)
 	events.GET("", h.List)
+
+	e.GET("/restaurants/:id/events", h.GetByRestaurant)
 }
 
 // Create godoc
@@ -40,25 +47,23 @@ func (h *RestaurantEventHandler) Register(e *echo.Group) {
 // @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /events [post]
 func (h *RestaurantEventHandler) Create(c echo.Context) error {
 	var event models.RestaurantEvent
 	if err := c.Bind(&event); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные события ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidEventData)
 	}
 
 	id, err := h.eventUC.Create(c.Request().Context(), &event)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"id":      id,
-		"message": "событие ресторана успешно создано",
+		"code":    i18n.MsgEventCreated,
+		"message": localize(c, i18n.MsgEventCreated),
 	})
 }
 
@@ -73,21 +78,18 @@ func (h *RestaurantEventHandler) Create(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /events/{id} [get]
 func (h *RestaurantEventHandler) GetByID(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID события ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidEventID)
 	}
 
 	event, err := h.eventUC.GetByID(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, event)
@@ -99,34 +101,45 @@ func (h *RestaurantEventHandler) GetByID(c echo.Context) error {
 // @Tags events
 // @Accept json
 // @Produce json
-// @Param type path string true "Тип события (wedding, birthday, corporate)"
+// @Param type path string true "Код типа события из справочника /event-types"
 // @Success 200 {array} models.RestaurantEvent
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /events/type/{type} [get]
 func (h *RestaurantEventHandler) GetByType(c echo.Context) error {
-	typeStr := c.Param("type")
-
-	var eventType models.EventType
-	switch typeStr {
-	case "wedding":
-		eventType = models.EventTypeWedding
-	case "birthday":
-		eventType = models.EventTypeBirthday
-	case "corporate":
-		eventType = models.EventTypeCorporate
-	default:
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "неизвестный тип события, используйте: wedding, birthday, corporate",
-		})
+	events, err := h.eventUC.GetByType(c.Request().Context(), c.Param("type"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, events)
+}
+
+// GetByRestaurant godoc
+// @Summary Получить события ресторана
+// @Description Возвращает события, которые проводит указанный ресторан
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Success 200 {array} models.RestaurantEvent
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/events [get]
+func (h *RestaurantEventHandler) GetByRestaurant(c echo.Context) error {
+	idStr := c.Param("id")
+	restaurantID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
 	}
 
-	events, err := h.eventUC.GetByType(c.Request().Context(), eventType)
+	events, err := h.eventUC.GetByRestaurant(c.Request().Context(), restaurantID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, events)
@@ -144,32 +157,28 @@ func (h *RestaurantEventHandler) GetByType(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /events/{id} [put]
 func (h *RestaurantEventHandler) Update(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID события ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidEventID)
 	}
 
 	var event models.RestaurantEvent
 	if err := c.Bind(&event); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные события ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidEventData)
 	}
 
 	event.ID = id
 	if err := h.eventUC.Update(c.Request().Context(), &event); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "событие ресторана успешно обновлено",
+		"code":    i18n.MsgEventUpdated,
+		"message": localize(c, i18n.MsgEventUpdated),
 	})
 }
 
@@ -184,24 +193,22 @@ func (h *RestaurantEventHandler) Update(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /events/{id} [delete]
 func (h *RestaurantEventHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID события ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidEventID)
 	}
 
 	if err := h.eventUC.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "событие ресторана успешно удалено",
+		"code":    i18n.MsgEventDeleted,
+		"message": localize(c, i18n.MsgEventDeleted),
 	})
 }
 
@@ -213,13 +220,12 @@ func (h *RestaurantEventHandler) Delete(c echo.Context) error {
 // @Produce json
 // @Success 200 {array} models.RestaurantEvent
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /events [get]
 func (h *RestaurantEventHandler) List(c echo.Context) error {
 	events, err := h.eventUC.List(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, events)
@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type RestaurantEventTableHandler struct {
+	bookingUC usecase.RestaurantEventTableUseCase
+}
+
+func NewRestaurantEventTableHandler(bookingUC usecase.RestaurantEventTableUseCase) *RestaurantEventTableHandler {
+	return &RestaurantEventTableHandler{
+		bookingUC: bookingUC,
+	}
+}
+
+func (h *RestaurantEventTableHandler) Register(e *echo.Group) {
+	staff := middleware.RequireRole(models.RoleAdmin, models.RoleManager, models.RoleWaiter)
+
+	e.POST("/events/:id/bookings", h.BookTable, staff)
+	e.GET("/events/:id/bookings", h.GetEventBookings)
+	e.DELETE("/events/:id/bookings/:tableID", h.CancelBooking, staff)
+	e.GET("/tables/:id/bookings", h.GetTableBookings)
+	e.GET("/tables/:id/availability", h.CheckAvailability)
+}
+
+// BookTable godoc
+// @Summary Забронировать стол под событие
+// @Description Бронирует стол под событие ресторана на указанную дату. Стол занимается на весь день, дата должна быть в будущем.
+// @Description Вместо table_id можно передать combination_id: тогда бронируются все столы сочетания или ни один.
+// @Description Если событие требует депозит, бронирование ждет оплаты в статусе pending_payment: в ответе есть
+// @Description deposit со ссылкой на оплату и сроком, после которого неоплаченное бронирование снимается
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "ID события ресторана"
+// @Param booking body models.EventBookingRequest true "Стол или сочетание, дата бронирования и количество гостей"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 503 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /events/{id}/bookings [post]
+func (h *RestaurantEventTableHandler) BookTable(c echo.Context) error {
+	idStr := c.Param("id")
+	eventID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidEventID)
+	}
+
+	var req models.EventBookingRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidBookingData)
+	}
+
+	deposit, err := h.bookingUC.Book(c.Request().Context(), eventID, &req)
+	if err != nil {
+		return err
+	}
+
+	if deposit != nil {
+		return c.JSON(http.StatusCreated, map[string]interface{}{
+			"deposit": deposit,
+			"code":    i18n.MsgBookingAwaitsPayment,
+			"message": localize(c, i18n.MsgBookingAwaitsPayment),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"code":    i18n.MsgTableBooked,
+		"message": localize(c, i18n.MsgTableBooked),
+	})
+}
+
+// GetEventBookings godoc
+// @Summary Получить бронирования события
+// @Description Возвращает столы, забронированные под событие ресторана
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "ID события ресторана"
+// @Success 200 {array} models.RestaurantEventTable
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /events/{id}/bookings [get]
+func (h *RestaurantEventTableHandler) GetEventBookings(c echo.Context) error {
+	idStr := c.Param("id")
+	eventID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidEventID)
+	}
+
+	bookings, err := h.bookingUC.GetEventBookings(c.Request().Context(), eventID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, bookings)
+}
+
+// CancelBooking godoc
+// @Summary Отменить бронирование стола
+// @Description Снимает бронирование стола под событие на указанную дату. Стол из сочетания снимается вместе со всеми столами сочетания
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "ID события ресторана"
+// @Param tableID path int true "ID стола"
+// @Param date query string true "Дата бронирования (RFC3339)"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /events/{id}/bookings/{tableID} [delete]
+func (h *RestaurantEventTableHandler) CancelBooking(c echo.Context) error {
+	idStr := c.Param("id")
+	eventID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidEventID)
+	}
+
+	tableIDStr := c.Param("tableID")
+	tableID, err := strconv.ParseInt(tableIDStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidTableID)
+	}
+
+	date, err := time.Parse(time.RFC3339, c.QueryParam("date"))
+	if err != nil {
+		return errs.Invalid("date", i18n.CodeInvalidBookingDate)
+	}
+
+	if err := h.bookingUC.CancelBooking(c.Request().Context(), eventID, tableID, date); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgBookingCancelled,
+		"message": localize(c, i18n.MsgBookingCancelled),
+	})
+}
+
+// GetTableBookings godoc
+// @Summary Получить бронирования стола
+// @Description Возвращает все бронирования стола под события ресторана
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "ID стола"
+// @Success 200 {array} models.RestaurantEventTable
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /tables/{id}/bookings [get]
+func (h *RestaurantEventTableHandler) GetTableBookings(c echo.Context) error {
+	idStr := c.Param("id")
+	tableID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidTableID)
+	}
+
+	bookings, err := h.bookingUC.GetTableBookings(c.Request().Context(), tableID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, bookings)
+}
+
+// CheckAvailability godoc
+// @Summary Проверить, свободен ли стол
+// @Description Сообщает, свободен ли стол от бронирований под события в указанный день
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "ID стола"
+// @Param date query string true "Дата (RFC3339)"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /tables/{id}/availability [get]
+func (h *RestaurantEventTableHandler) CheckAvailability(c echo.Context) error {
+	idStr := c.Param("id")
+	tableID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidTableID)
+	}
+
+	date, err := time.Parse(time.RFC3339, c.QueryParam("date"))
+	if err != nil {
+		return errs.Invalid("date", i18n.CodeInvalidBookingDate)
+	}
+
+	available, err := h.bookingUC.CheckAvailability(c.Request().Context(), tableID, date)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"table_id":  tableID,
+		"date":      date,
+		"available": available,
+	})
+}
@@ -0,0 +1,292 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type ScheduleHandler struct {
+	scheduleUC usecase.ScheduleUseCase
+}
+
+func NewScheduleHandler(scheduleUC usecase.ScheduleUseCase) *ScheduleHandler {
+	return &ScheduleHandler{
+		scheduleUC: scheduleUC,
+	}
+}
+
+func (h *ScheduleHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
+	e.GET("/restaurants/:id/hours", h.GetOpeningHours)
+	e.PUT("/restaurants/:id/hours", h.SetOpeningHours, manage)
+	e.GET("/restaurants/:id/special-days", h.GetSpecialDays)
+	e.PUT("/restaurants/:id/special-days/:day", h.SetSpecialDay, manage)
+	e.DELETE("/restaurants/:id/special-days/:day", h.DeleteSpecialDay, manage)
+
+	e.GET("/sections/:id/blackouts", h.GetBlackouts)
+	e.POST("/sections/:id/blackouts", h.CreateBlackout, manage)
+	e.DELETE("/sections/:id/blackouts/:blackoutID", h.DeleteBlackout, manage)
+}
+
+// GetOpeningHours godoc
+// @Summary Получить расписание ресторана
+// @Description Возвращает недельное расписание работы. Пустой список означает, что ресторан работает круглосуточно
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Success 200 {array} models.OpeningInterval
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/hours [get]
+func (h *ScheduleHandler) GetOpeningHours(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	hours, err := h.scheduleUC.GetOpeningHours(c.Request().Context(), restaurantID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, hours)
+}
+
+// SetOpeningHours godoc
+// @Summary Задать расписание ресторана
+// @Description Заменяет недельное расписание целиком. В день может быть несколько интервалов; интервал, у которого
+// @Description closes_at не позже opens_at, заканчивается на следующий день. weekday: 0 — воскресенье, 6 — суббота
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param hours body []models.OpeningInterval true "Интервалы работы"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/hours [put]
+func (h *ScheduleHandler) SetOpeningHours(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var hours []*models.OpeningInterval
+	if err := c.Bind(&hours); err != nil {
+		return errs.Validation(i18n.CodeInvalidScheduleData)
+	}
+
+	if err := h.scheduleUC.SetOpeningHours(c.Request().Context(), restaurantID, hours); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgOpeningHoursUpdated,
+		"message": localize(c, i18n.MsgOpeningHoursUpdated),
+	})
+}
+
+// GetSpecialDays godoc
+// @Summary Получить особые дни ресторана
+// @Description Возвращает праздники, закрытые мероприятия и сокращенные дни. По умолчанию — на год вперед
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param from query string false "Начало периода (ГГГГ-ММ-ДД)"
+// @Param to query string false "Конец периода (ГГГГ-ММ-ДД), включительно"
+// @Success 200 {array} models.SpecialDay
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/special-days [get]
+func (h *ScheduleHandler) GetSpecialDays(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var fields errs.Fields
+	from := queryDate(c, "from", &fields)
+	to := queryDate(c, "to", &fields)
+	if err := fields.Err(); err != nil {
+		return err
+	}
+
+	days, err := h.scheduleUC.GetSpecialDays(c.Request().Context(), restaurantID, from, to)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, days)
+}
+
+// SetSpecialDay godoc
+// @Summary Задать особый день
+// @Description Заменяет расписание на дату. Без интервалов ресторан закрыт весь день
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param day path string true "Дата (ГГГГ-ММ-ДД)"
+// @Param special_day body models.SpecialDay true "Интервалы работы и примечание"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/special-days/{day} [put]
+func (h *ScheduleHandler) SetSpecialDay(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var day models.SpecialDay
+	if err := c.Bind(&day); err != nil {
+		return errs.Validation(i18n.CodeInvalidScheduleData)
+	}
+
+	day.RestaurantID = restaurantID
+	day.Day = c.Param("day")
+
+	if err := h.scheduleUC.SetSpecialDay(c.Request().Context(), &day); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgSpecialDaySaved,
+		"message": localize(c, i18n.MsgSpecialDaySaved),
+	})
+}
+
+// DeleteSpecialDay godoc
+// @Summary Удалить особый день
+// @Description Возвращает дату к недельному расписанию
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param day path string true "Дата (ГГГГ-ММ-ДД)"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/special-days/{day} [delete]
+func (h *ScheduleHandler) DeleteSpecialDay(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	if err := h.scheduleUC.DeleteSpecialDay(c.Request().Context(), restaurantID, c.Param("day")); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgSpecialDayDeleted,
+		"message": localize(c, i18n.MsgSpecialDayDeleted),
+	})
+}
+
+// GetBlackouts godoc
+// @Summary Получить закрытия секции
+// @Description Возвращает промежутки, когда секцию нельзя бронировать
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param id path int true "ID секции"
+// @Success 200 {array} models.SectionBlackout
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /sections/{id}/blackouts [get]
+func (h *ScheduleHandler) GetBlackouts(c echo.Context) error {
+	sectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidSectionID)
+	}
+
+	blackouts, err := h.scheduleUC.GetBlackouts(c.Request().Context(), sectionID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, blackouts)
+}
+
+// CreateBlackout godoc
+// @Summary Закрыть секцию
+// @Description Закрывает секцию для бронирований на промежуток [starts_at, ends_at)
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param id path int true "ID секции"
+// @Param blackout body models.SectionBlackout true "Промежуток и причина"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /sections/{id}/blackouts [post]
+func (h *ScheduleHandler) CreateBlackout(c echo.Context) error {
+	sectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidSectionID)
+	}
+
+	var blackout models.SectionBlackout
+	if err := c.Bind(&blackout); err != nil {
+		return errs.Validation(i18n.CodeInvalidBlackoutData)
+	}
+	blackout.SectionID = sectionID
+
+	id, err := h.scheduleUC.CreateBlackout(c.Request().Context(), &blackout)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"id":      id,
+		"code":    i18n.MsgBlackoutCreated,
+		"message": localize(c, i18n.MsgBlackoutCreated),
+	})
+}
+
+// DeleteBlackout godoc
+// @Summary Удалить закрытие секции
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param id path int true "ID секции"
+// @Param blackoutID path int true "ID закрытия"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /sections/{id}/blackouts/{blackoutID} [delete]
+func (h *ScheduleHandler) DeleteBlackout(c echo.Context) error {
+	blackoutID, err := strconv.ParseInt(c.Param("blackoutID"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidBlackoutID)
+	}
+
+	if err := h.scheduleUC.DeleteBlackout(c.Request().Context(), blackoutID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgBlackoutDeleted,
+		"message": localize(c, i18n.MsgBlackoutDeleted),
+	})
+}
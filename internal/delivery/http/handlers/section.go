@@ -2,13 +2,16 @@ package handlers
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/labstack/echo/v4"
 
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/usecase"
 )
@@ -24,12 +27,14 @@ func NewSectionHandler(sectionUC usecase.SectionUseCase) *SectionHandler {
 }
 
 func (h *SectionHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
 	sections := e.Group("/sections")
-	sections.POST("", h.Create)
+	sections.POST("", h.Create, manage)
 	sections.GET("/:id", h.GetByID)
 	sections.GET("/restaurant/:restaurantID", h.GetByRestaurant)
-	sections.PUT("/:id", h.Update)
-	sections.DELETE("/:id", h.Delete)
+	sections.PUT("/:id", h.Update, manage)
+	sections.DELETE("/:id", h.Delete, manage)
 }
 
 // Create godoc
@@ -42,14 +47,13 @@ func (h *SectionHandler) Register(e *echo.Group) {
 // @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /sections [post]
 func (h *SectionHandler) Create(c echo.Context) error {
 	bodyBytes, err := io.ReadAll(c.Request().Body)
 	if err != nil {
 		c.Logger().Errorf("Не удалось прочитать тело запроса: %v", err)
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "не удалось прочитать запрос",
-		})
+		return errs.Validation(i18n.CodeRequestReadError)
 	}
 
 	c.Request().Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
@@ -59,53 +63,33 @@ func (h *SectionHandler) Create(c echo.Context) error {
 	var section models.Section
 	if err := c.Bind(&section); err != nil {
 		c.Logger().Errorf("Ошибка при привязке данных: %v", err)
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные секции: " + err.Error(),
-		})
+		return errs.Validation(i18n.CodeInvalidSectionData)
 	}
 
 	c.Logger().Infof("Секция после привязки: %+v", section)
 
 	if section.RestaurantID <= 0 {
-		errMsg := "ID ресторана должен быть положительным числом"
-		c.Logger().Error(errMsg)
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": errMsg,
-		})
+		c.Logger().Error("ID ресторана должен быть положительным числом")
+		return errs.Validation(i18n.CodeRestaurantIDReq)
 	}
 
 	defer func() {
 		if r := recover(); r != nil {
 			c.Logger().Errorf("Паника при создании секции: %v", r)
-			c.JSON(http.StatusInternalServerError, map[string]interface{}{
-				"error": "внутренняя ошибка сервера",
-			})
+			c.Error(fmt.Errorf("паника при создании секции: %v", r))
 		}
 	}()
 
 	id, err := h.sectionUC.Create(c.Request().Context(), &section)
 	if err != nil {
 		c.Logger().Errorf("Ошибка создания секции: %v", err)
-
-		// Разделяем ошибки на клиентские и серверные
-		if strings.Contains(err.Error(), "ресторан не найден") ||
-			strings.Contains(err.Error(), "название секции") ||
-			strings.Contains(err.Error(), "уже существует") {
-			return c.JSON(http.StatusBadRequest, map[string]interface{}{
-				"error": err.Error(),
-			})
-		}
-
-		// Остальные ошибки считаем серверными
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error":   "Внутренняя ошибка сервера при создании секции",
-			"details": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"id":      id,
-		"message": "секция успешно создана",
+		"code":    i18n.MsgSectionCreated,
+		"message": localize(c, i18n.MsgSectionCreated),
 	})
 }
 
@@ -120,21 +104,18 @@ func (h *SectionHandler) Create(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /sections/{id} [get]
 func (h *SectionHandler) GetByID(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID секции",
-		})
+		return errs.Validation(i18n.CodeInvalidSectionID)
 	}
 
 	section, err := h.sectionUC.GetByID(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, section)
@@ -151,21 +132,18 @@ func (h *SectionHandler) GetByID(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /sections/restaurant/{restaurantID} [get]
 func (h *SectionHandler) GetByRestaurant(c echo.Context) error {
 	restaurantIDStr := c.Param("restaurantID")
 	restaurantID, err := strconv.ParseInt(restaurantIDStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID ресторана",
-		})
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
 	}
 
 	sections, err := h.sectionUC.GetByRestaurant(c.Request().Context(), restaurantID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, sections)
@@ -183,32 +161,28 @@ func (h *SectionHandler) GetByRestaurant(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /sections/{id} [put]
 func (h *SectionHandler) Update(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID секции",
-		})
+		return errs.Validation(i18n.CodeInvalidSectionID)
 	}
 
 	var section models.Section
 	if err := c.Bind(&section); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные секции",
-		})
+		return errs.Validation(i18n.CodeInvalidSectionData)
 	}
 
 	section.ID = id
 	if err := h.sectionUC.Update(c.Request().Context(), &section); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "секция успешно обновлена",
+		"code":    i18n.MsgSectionUpdated,
+		"message": localize(c, i18n.MsgSectionUpdated),
 	})
 }
 
@@ -223,23 +197,21 @@ func (h *SectionHandler) Update(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /sections/{id} [delete]
 func (h *SectionHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID секции",
-		})
+		return errs.Validation(i18n.CodeInvalidSectionID)
 	}
 
 	if err := h.sectionUC.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "секция успешно удалена",
+		"code":    i18n.MsgSectionDeleted,
+		"message": localize(c, i18n.MsgSectionDeleted),
 	})
 }
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type StaffHandler struct {
+	staffUC usecase.StaffUseCase
+}
+
+func NewStaffHandler(staffUC usecase.StaffUseCase) *StaffHandler {
+	return &StaffHandler{
+		staffUC: staffUC,
+	}
+}
+
+func (h *StaffHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
+	e.GET("/restaurants/:id/staff", h.GetByRestaurant)
+	e.POST("/restaurants/:id/staff", h.Assign, manage)
+	e.DELETE("/restaurants/:id/staff/:userID", h.Remove, manage)
+	e.GET("/users/:id/restaurants", h.GetByUser)
+}
+
+// Assign godoc
+// @Summary Назначить сотрудника ресторана
+// @Description Назначает пользователя менеджером или официантом ресторана. Менеджеров назначает только администратор
+// @Tags staff
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param assignment body models.StaffAssignment true "Пользователь и роль"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/staff [post]
+func (h *StaffHandler) Assign(c echo.Context) error {
+	restaurantIDStr := c.Param("id")
+	restaurantID, err := strconv.ParseInt(restaurantIDStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var assignment models.StaffAssignment
+	if err := c.Bind(&assignment); err != nil {
+		return errs.Validation(i18n.CodeInvalidAssignmentData)
+	}
+
+	assignment.RestaurantID = restaurantID
+	if err := h.staffUC.Assign(c.Request().Context(), &assignment); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgStaffAssigned,
+		"message": localize(c, i18n.MsgStaffAssigned),
+	})
+}
+
+// Remove godoc
+// @Summary Снять сотрудника с ресторана
+// @Description Удаляет назначение пользователя в ресторане
+// @Tags staff
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param userID path int true "ID пользователя"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/staff/{userID} [delete]
+func (h *StaffHandler) Remove(c echo.Context) error {
+	restaurantIDStr := c.Param("id")
+	restaurantID, err := strconv.ParseInt(restaurantIDStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	userIDStr := c.Param("userID")
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidUserID)
+	}
+
+	if err := h.staffUC.Remove(c.Request().Context(), userID, restaurantID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgStaffRemoved,
+		"message": localize(c, i18n.MsgStaffRemoved),
+	})
+}
+
+// GetByRestaurant godoc
+// @Summary Получить сотрудников ресторана
+// @Description Возвращает список менеджеров и официантов ресторана
+// @Tags staff
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Success 200 {array} models.StaffAssignment
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/staff [get]
+func (h *StaffHandler) GetByRestaurant(c echo.Context) error {
+	restaurantIDStr := c.Param("id")
+	restaurantID, err := strconv.ParseInt(restaurantIDStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	staff, err := h.staffUC.GetByRestaurant(c.Request().Context(), restaurantID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, staff)
+}
+
+// GetByUser godoc
+// @Summary Получить рестораны сотрудника
+// @Description Возвращает рестораны, в которые назначен пользователь, и его роли в них
+// @Tags staff
+// @Accept json
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {array} models.StaffAssignment
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /users/{id}/restaurants [get]
+func (h *StaffHandler) GetByUser(c echo.Context) error {
+	userIDStr := c.Param("id")
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidUserID)
+	}
+
+	assignments, err := h.staffUC.GetByUser(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, assignments)
+}
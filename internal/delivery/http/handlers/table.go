@@ -6,6 +6,9 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/usecase"
 )
@@ -21,13 +24,15 @@ func NewTableHandler(tableUC usecase.TableUseCase) *TableHandler {
 }
 
 func (h *TableHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
 	tables := e.Group("/tables")
-	tables.POST("", h.Create)
+	tables.POST("", h.Create, manage)
 	tables.GET("/:id", h.GetByID)
 	tables.GET("/section/:sectionID", h.GetBySection)
-	tables.PUT("/:id", h.Update)
-	tables.DELETE("/:id", h.Delete)
-	tables.POST("/:id/qr", h.GenerateQR)
+	tables.PUT("/:id", h.Update, manage)
+	tables.DELETE("/:id", h.Delete, manage)
+	tables.POST("/:id/qr", h.GenerateQR, manage)
 }
 
 // Create godoc
@@ -40,25 +45,23 @@ func (h *TableHandler) Register(e *echo.Group) {
 // @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /tables [post]
 func (h *TableHandler) Create(c echo.Context) error {
 	var table models.Table
 	if err := c.Bind(&table); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные столика",
-		})
+		return errs.Validation(i18n.CodeInvalidTableData)
 	}
 
 	id, err := h.tableUC.Create(c.Request().Context(), &table)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"id":      id,
-		"message": "столик успешно создан",
+		"code":    i18n.MsgTableCreated,
+		"message": localize(c, i18n.MsgTableCreated),
 		"qr":      table.QR,
 	})
 }
@@ -74,21 +77,18 @@ func (h *TableHandler) Create(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /tables/{id} [get]
 func (h *TableHandler) GetByID(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID столика",
-		})
+		return errs.Validation(i18n.CodeInvalidTableID)
 	}
 
 	table, err := h.tableUC.GetByID(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, table)
@@ -105,21 +105,18 @@ func (h *TableHandler) GetByID(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /tables/section/{sectionID} [get]
 func (h *TableHandler) GetBySection(c echo.Context) error {
 	sectionIDStr := c.Param("sectionID")
 	sectionID, err := strconv.ParseInt(sectionIDStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID секции",
-		})
+		return errs.Validation(i18n.CodeInvalidSectionID)
 	}
 
 	tables, err := h.tableUC.GetBySection(c.Request().Context(), sectionID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, tables)
@@ -137,32 +134,28 @@ func (h *TableHandler) GetBySection(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /tables/{id} [put]
 func (h *TableHandler) Update(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID столика",
-		})
+		return errs.Validation(i18n.CodeInvalidTableID)
 	}
 
 	var table models.Table
 	if err := c.Bind(&table); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные столика",
-		})
+		return errs.Validation(i18n.CodeInvalidTableData)
 	}
 
 	table.ID = id
 	if err := h.tableUC.Update(c.Request().Context(), &table); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "столик успешно обновлен",
+		"code":    i18n.MsgTableUpdated,
+		"message": localize(c, i18n.MsgTableUpdated),
 	})
 }
 
@@ -177,24 +170,22 @@ func (h *TableHandler) Update(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /tables/{id} [delete]
 func (h *TableHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID столика",
-		})
+		return errs.Validation(i18n.CodeInvalidTableID)
 	}
 
 	if err := h.tableUC.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "столик успешно удален",
+		"code":    i18n.MsgTableDeleted,
+		"message": localize(c, i18n.MsgTableDeleted),
 	})
 }
 
@@ -209,25 +200,23 @@ func (h *TableHandler) Delete(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /tables/{id}/qr [post]
 func (h *TableHandler) GenerateQR(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID столика",
-		})
+		return errs.Validation(i18n.CodeInvalidTableID)
 	}
 
 	qr, err := h.tableUC.GenerateQR(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "QR-код успешно сгенерирован",
+		"code":    i18n.MsgQRGenerated,
+		"message": localize(c, i18n.MsgQRGenerated),
 		"qr":      qr,
 	})
 }
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type TableCombinationHandler struct {
+	combinationUC usecase.TableCombinationUseCase
+}
+
+func NewTableCombinationHandler(combinationUC usecase.TableCombinationUseCase) *TableCombinationHandler {
+	return &TableCombinationHandler{
+		combinationUC: combinationUC,
+	}
+}
+
+func (h *TableCombinationHandler) Register(e *echo.Group) {
+	manage := middleware.RequireRole(models.RoleAdmin, models.RoleManager)
+
+	e.GET("/sections/:id/combinations", h.GetBySection)
+	e.POST("/sections/:id/combinations", h.Create, manage)
+	e.PUT("/sections/:id/combinations/:combinationID", h.Update, manage)
+	e.DELETE("/sections/:id/combinations/:combinationID", h.Delete, manage)
+}
+
+// GetBySection godoc
+// @Summary Получить сочетания столов секции
+// @Description Возвращает столы секции, которые можно сдвинуть для большой компании, и вместимость каждого сочетания
+// @Tags tables
+// @Accept json
+// @Produce json
+// @Param id path int true "ID секции"
+// @Success 200 {array} models.TableCombination
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /sections/{id}/combinations [get]
+func (h *TableCombinationHandler) GetBySection(c echo.Context) error {
+	sectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidSectionID)
+	}
+
+	combinations, err := h.combinationUC.GetBySection(c.Request().Context(), sectionID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, combinations)
+}
+
+// Create godoc
+// @Summary Создать сочетание столов
+// @Description Задает столы секции (не меньше двух), которые можно сдвинуть вместе. Без max_seats вместимость — сумма мест столов
+// @Tags tables
+// @Accept json
+// @Produce json
+// @Param id path int true "ID секции"
+// @Param combination body models.TableCombination true "Название, столы и вместимость"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /sections/{id}/combinations [post]
+func (h *TableCombinationHandler) Create(c echo.Context) error {
+	sectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidSectionID)
+	}
+
+	var combination models.TableCombination
+	if err := c.Bind(&combination); err != nil {
+		return errs.Validation(i18n.CodeInvalidCombinationData)
+	}
+	combination.SectionID = sectionID
+
+	id, err := h.combinationUC.Create(c.Request().Context(), &combination)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"id":      id,
+		"code":    i18n.MsgCombinationCreated,
+		"message": localize(c, i18n.MsgCombinationCreated),
+	})
+}
+
+// Update godoc
+// @Summary Обновить сочетание столов
+// @Description Меняет название, вместимость и столы сочетания. Столы нельзя менять, пока сочетание держат предстоящие бронирования
+// @Tags tables
+// @Accept json
+// @Produce json
+// @Param id path int true "ID секции"
+// @Param combinationID path int true "ID сочетания"
+// @Param combination body models.TableCombination true "Обновленные данные сочетания"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /sections/{id}/combinations/{combinationID} [put]
+func (h *TableCombinationHandler) Update(c echo.Context) error {
+	sectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidSectionID)
+	}
+
+	id, err := strconv.ParseInt(c.Param("combinationID"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidCombinationID)
+	}
+
+	var combination models.TableCombination
+	if err := c.Bind(&combination); err != nil {
+		return errs.Validation(i18n.CodeInvalidCombinationData)
+	}
+	combination.ID = id
+	combination.SectionID = sectionID
+
+	if err := h.combinationUC.Update(c.Request().Context(), &combination); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgCombinationUpdated,
+		"message": localize(c, i18n.MsgCombinationUpdated),
+	})
+}
+
+// Delete godoc
+// @Summary Удалить сочетание столов
+// @Description Удаляет сочетание, если его не держат предстоящие бронирования
+// @Tags tables
+// @Accept json
+// @Produce json
+// @Param id path int true "ID секции"
+// @Param combinationID path int true "ID сочетания"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /sections/{id}/combinations/{combinationID} [delete]
+func (h *TableCombinationHandler) Delete(c echo.Context) error {
+	sectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidSectionID)
+	}
+
+	id, err := strconv.ParseInt(c.Param("combinationID"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidCombinationID)
+	}
+
+	if err := h.combinationUC.Delete(c.Request().Context(), sectionID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgCombinationDeleted,
+		"message": localize(c, i18n.MsgCombinationDeleted),
+	})
+}
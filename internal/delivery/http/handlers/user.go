@@ -2,10 +2,14 @@ package handlers
 
 import (
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/labstack/echo/v4"
 
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/usecase"
 )
@@ -27,7 +31,10 @@ func (h *UserHandler) Register(e *echo.Group) {
 	users.GET("/phone/:phone", h.GetByPhone)
 	users.PUT("/:id", h.Update)
 	users.DELETE("/:id", h.Delete)
-	users.GET("", h.List)
+	users.POST("/:id/restore", h.Restore, middleware.RequireRole(models.RoleAdmin))
+	users.POST("/:id/anonymize", h.Anonymize)
+	users.GET("/:id/export", h.Export)
+	users.GET("", h.List, middleware.RequireRole(models.RoleAdmin))
 }
 
 // Create godoc
@@ -40,25 +47,23 @@ func (h *UserHandler) Register(e *echo.Group) {
 // @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /users [post]
 func (h *UserHandler) Create(c echo.Context) error {
 	var user models.User
 	if err := c.Bind(&user); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные пользователя",
-		})
+		return errs.Validation(i18n.CodeInvalidUserData)
 	}
 
 	id, err := h.userUC.Create(c.Request().Context(), &user)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"id":      id,
-		"message": "пользователь успешно создан",
+		"code":    i18n.MsgUserCreated,
+		"message": localize(c, i18n.MsgUserCreated),
 	})
 }
 
@@ -73,21 +78,18 @@ func (h *UserHandler) Create(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /users/{id} [get]
 func (h *UserHandler) GetByID(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID пользователя",
-		})
+		return errs.Validation(i18n.CodeInvalidUserID)
 	}
 
 	user, err := h.userUC.GetByID(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, user)
@@ -95,22 +97,27 @@ func (h *UserHandler) GetByID(c echo.Context) error {
 
 // GetByPhone godoc
 // @Summary Получить пользователя по номеру телефона
-// @Description Возвращает пользователя по его номеру телефона
+// @Description Возвращает пользователя по его номеру телефона. Номер может быть записан в любом
+// @Description формате («+7 701 123-45-67», «87011234567»), знак «+» передается как %2B
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param phone path string true "Номер телефона пользователя"
 // @Success 200 {object} models.User
+// @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /users/phone/{phone} [get]
 func (h *UserHandler) GetByPhone(c echo.Context) error {
-	phone := c.Param("phone")
+	phone, err := url.PathUnescape(c.Param("phone"))
+	if err != nil {
+		return errs.Invalid("phone", i18n.CodePhoneInvalid)
+	}
+
 	user, err := h.userUC.GetByPhone(c.Request().Context(), phone)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, user)
@@ -128,38 +135,34 @@ func (h *UserHandler) GetByPhone(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /users/{id} [put]
 func (h *UserHandler) Update(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID пользователя",
-		})
+		return errs.Validation(i18n.CodeInvalidUserID)
 	}
 
 	var user models.User
 	if err := c.Bind(&user); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректные данные пользователя",
-		})
+		return errs.Validation(i18n.CodeInvalidUserData)
 	}
 
 	user.ID = id
 	if err := h.userUC.Update(c.Request().Context(), &user); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "пользователь успешно обновлен",
+		"code":    i18n.MsgUserUpdated,
+		"message": localize(c, i18n.MsgUserUpdated),
 	})
 }
 
 // Delete godoc
 // @Summary Удалить пользователя
-// @Description Удаляет пользователя по его ID
+// @Description Помечает пользователя удаленным и завершает его сессии. Данные сохраняются и могут быть восстановлены администратором
 // @Tags users
 // @Accept json
 // @Produce json
@@ -168,27 +171,113 @@ func (h *UserHandler) Update(c echo.Context) error {
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /users/{id} [delete]
 func (h *UserHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "некорректный ID пользователя",
-		})
+		return errs.Validation(i18n.CodeInvalidUserID)
 	}
 
 	if err := h.userUC.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusNotFound, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgUserDeleted,
+		"message": localize(c, i18n.MsgUserDeleted),
+	})
+}
+
+// Restore godoc
+// @Summary Восстановить пользователя
+// @Description Снимает пометку об удалении с пользователя. Анонимизированных пользователей восстановить нельзя
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /users/{id}/restore [post]
+func (h *UserHandler) Restore(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidUserID)
+	}
+
+	if err := h.userUC.Restore(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgUserRestored,
+		"message": localize(c, i18n.MsgUserRestored),
+	})
+}
+
+// Anonymize godoc
+// @Summary Анонимизировать пользователя
+// @Description Необратимо стирает номер телефона, имя и фамилию пользователя, сохраняя историю его действий
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /users/{id}/anonymize [post]
+func (h *UserHandler) Anonymize(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidUserID)
+	}
+
+	if err := h.userUC.Anonymize(c.Request().Context(), id); err != nil {
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "пользователь успешно удален",
+		"code":    i18n.MsgUserAnonymized,
+		"message": localize(c, i18n.MsgUserAnonymized),
 	})
 }
 
+// Export godoc
+// @Summary Выгрузить данные пользователя
+// @Description Возвращает все данные, которые сервис хранит о пользователе
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {object} models.UserExport
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /users/{id}/export [get]
+func (h *UserHandler) Export(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidUserID)
+	}
+
+	export, err := h.userUC.Export(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, export)
+}
+
 // List godoc
 // @Summary Получить список пользователей
 // @Description Возвращает список пользователей с пагинацией
@@ -199,6 +288,7 @@ func (h *UserHandler) Delete(c echo.Context) error {
 // @Param offset query int false "Смещение" default(0)
 // @Success 200 {array} models.User
 // @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
 // @Router /users [get]
 func (h *UserHandler) List(c echo.Context) error {
 	limitStr := c.QueryParam("limit")
@@ -222,9 +312,7 @@ func (h *UserHandler) List(c echo.Context) error {
 
 	users, err := h.userUC.List(c.Request().Context(), limit, offset)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, users)
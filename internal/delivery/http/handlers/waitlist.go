@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/delivery/http/middleware"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/usecase"
+)
+
+type WaitlistHandler struct {
+	waitlistUC usecase.WaitlistUseCase
+}
+
+func NewWaitlistHandler(waitlistUC usecase.WaitlistUseCase) *WaitlistHandler {
+	return &WaitlistHandler{
+		waitlistUC: waitlistUC,
+	}
+}
+
+func (h *WaitlistHandler) Register(e *echo.Group) {
+	staff := middleware.RequireRole(models.RoleAdmin, models.RoleManager, models.RoleWaiter)
+
+	e.POST("/restaurants/:id/waitlist", h.Add, staff)
+	e.GET("/restaurants/:id/waitlist", h.GetByRestaurant, staff)
+	e.GET("/restaurants/:id/waitlist/estimate", h.Estimate, staff)
+
+	waitlist := e.Group("/waitlist", staff)
+	waitlist.POST("/:id/notify", h.Notify)
+	waitlist.POST("/:id/seat", h.Seat)
+	waitlist.POST("/:id/cancel", h.Cancel)
+}
+
+// Add godoc
+// @Summary Поставить гостей в очередь
+// @Description Находит гостя по номеру телефона или создает нового (тогда нужно имя) и оценивает ожидание с учетом
+// @Description занятости столов, среднего времени посадки и гостей, которые уже в очереди
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param entry body models.WaitlistRequest true "Телефон, имя, количество гостей и примечание"
+// @Success 201 {object} models.WaitlistEntry
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/waitlist [post]
+func (h *WaitlistHandler) Add(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var req models.WaitlistRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidWaitlistData)
+	}
+	req.RestaurantID = restaurantID
+
+	entry, err := h.waitlistUC.Add(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, entry)
+}
+
+// GetByRestaurant godoc
+// @Summary Получить очередь ресторана
+// @Description Возвращает ожидающих и оповещенных гостей в порядке очереди с текущей оценкой ожидания
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Success 200 {array} models.WaitlistEntry
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/waitlist [get]
+func (h *WaitlistHandler) GetByRestaurant(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	entries, err := h.waitlistUC.GetByRestaurant(c.Request().Context(), restaurantID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, entries)
+}
+
+// Estimate godoc
+// @Summary Оценить ожидание
+// @Description Оценивает ожидание для гостей, не ставя их в очередь. Пустая оценка — ни один стол не вмещает гостей
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресторана"
+// @Param party_size query int true "Количество гостей"
+// @Success 200 {object} models.WaitEstimate
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /restaurants/{id}/waitlist/estimate [get]
+func (h *WaitlistHandler) Estimate(c echo.Context) error {
+	restaurantID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidRestaurantID)
+	}
+
+	var fields errs.Fields
+	var partySize int
+	if value := queryInt64(c, "party_size", &fields); value != nil {
+		partySize = int(*value)
+	}
+	if err := fields.Err(); err != nil {
+		return err
+	}
+
+	estimate, err := h.waitlistUC.Estimate(c.Request().Context(), restaurantID, partySize)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, estimate)
+}
+
+// Notify godoc
+// @Summary Сообщить, что стол готов
+// @Description Отправляет гостям SMS на языке их профиля. Повторное оповещение разрешено
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param id path int true "ID записи очереди"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 503 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /waitlist/{id}/notify [post]
+func (h *WaitlistHandler) Notify(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidWaitlistID)
+	}
+
+	if err := h.waitlistUC.Notify(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgWaitlistNotified,
+		"message": localize(c, i18n.MsgWaitlistNotified),
+	})
+}
+
+// Seat godoc
+// @Summary Посадить гостей из очереди
+// @Description Создает бронирование со статусом seated за указанным столом от текущего момента и закрывает запись очереди.
+// @Description Без duration_minutes стол занимается на среднее время посадки ресторана
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param id path int true "ID записи очереди"
+// @Param seat body models.SeatRequest true "Стол и продолжительность"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /waitlist/{id}/seat [post]
+func (h *WaitlistHandler) Seat(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidWaitlistID)
+	}
+
+	var req models.SeatRequest
+	if err := c.Bind(&req); err != nil {
+		return errs.Validation(i18n.CodeInvalidWaitlistData)
+	}
+
+	reservationID, err := h.waitlistUC.Seat(c.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"reservation_id": reservationID,
+		"code":           i18n.MsgWaitlistSeated,
+		"message":        localize(c, i18n.MsgWaitlistSeated),
+	})
+}
+
+// Cancel godoc
+// @Summary Убрать гостей из очереди
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param id path int true "ID записи очереди"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /waitlist/{id}/cancel [post]
+func (h *WaitlistHandler) Cancel(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return errs.Validation(i18n.CodeInvalidWaitlistID)
+	}
+
+	if err := h.waitlistUC.Cancel(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"code":    i18n.MsgWaitlistCancelled,
+		"message": localize(c, i18n.MsgWaitlistCancelled),
+	})
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/usecase"
+)
+
+const (
+	PrincipalKey = "principal"
+	APIKeyHeader = "X-API-Key"
+)
+
+// Auth проверяет access-токен из заголовка Authorization или API-ключ из
+// заголовка X-API-Key и кладет данные клиента в контекст запроса. Для
+// API-ключей здесь же проверяется область доступа к маршруту.
+func Auth(authUC usecase.AuthUseCase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if apiKey := strings.TrimSpace(c.Request().Header.Get(APIKeyHeader)); apiKey != "" {
+				principal, err := authUC.AuthenticateAPIKey(c.Request().Context(), apiKey)
+				if err != nil {
+					return authError(err, i18n.CodeInvalidAPIKey)
+				}
+
+				if !allowScope(c, principal) {
+					return errs.Forbidden(i18n.CodeAPIKeyScope)
+				}
+
+				return setPrincipal(c, next, principal)
+			}
+
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			token, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok || strings.TrimSpace(token) == "" {
+				return errs.Unauthorized(i18n.CodeUnauthorized)
+			}
+
+			principal, err := authUC.Authenticate(c.Request().Context(), strings.TrimSpace(token))
+			if err != nil {
+				return authError(err, i18n.CodeInvalidToken)
+			}
+
+			return setPrincipal(c, next, principal)
+		}
+	}
+}
+
+// authError скрывает от клиента причину отказа в авторизации, но пропускает
+// внутренние ошибки, чтобы сбой хранилища не выглядел как неверный токен.
+func authError(err error, code i18n.Code) error {
+	if errs.KindOf(err) == errs.KindInternal {
+		return err
+	}
+	return errs.Wrap(err, errs.KindUnauthorized, code)
+}
+
+func setPrincipal(c echo.Context, next echo.HandlerFunc, principal *auth.Principal) error {
+	c.Set(PrincipalKey, principal)
+	c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(), principal)))
+	setUserLang(c, principal)
+
+	return next(c)
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/i18n"
+)
+
+const headerAcceptLanguage = "Accept-Language"
+
+// Language определяет язык ответа по заголовку Accept-Language. После
+// авторизации Auth уточняет его по языку из профиля пользователя.
+func Language() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			setLang(c, i18n.Resolve(c.Request().Header.Get(headerAcceptLanguage), ""))
+			return next(c)
+		}
+	}
+}
+
+// Lang возвращает язык, на котором нужно ответить на запрос.
+func Lang(c echo.Context) i18n.Lang {
+	return i18n.LangFromContext(c.Request().Context())
+}
+
+func setUserLang(c echo.Context, principal *auth.Principal) {
+	setLang(c, i18n.Resolve(c.Request().Header.Get(headerAcceptLanguage), principal.Language))
+}
+
+func setLang(c echo.Context, lang i18n.Lang) {
+	c.SetRequest(c.Request().WithContext(i18n.WithLang(c.Request().Context(), lang)))
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/requestmeta"
+)
+
+// RequestMeta кладет в контекст запроса адрес клиента и X-Request-ID,
+// чтобы usecase могли записать их в журнал аудита. Должен стоять после
+// middleware RequestID.
+func RequestMeta() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			meta := requestmeta.Meta{
+				IP:        c.RealIP(),
+				RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+			}
+			c.SetRequest(c.Request().WithContext(requestmeta.WithMeta(c.Request().Context(), meta)))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+// RequireRole пропускает запрос, только если у пользователя одна из указанных
+// ролей платформы. Проверка доступа к конкретному ресторану выполняется в usecase.
+// API-ключи ролей не имеют: их область доступа уже проверена в Auth.
+func RequireRole(roles ...models.UserRole) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := auth.PrincipalFromContext(c.Request().Context())
+			if !ok {
+				return errs.Unauthorized(i18n.CodeUnauthorized)
+			}
+
+			if !principal.IsAPIKey() && !principal.HasRole(roles...) {
+				c.Logger().Warnf("Отказано в доступе: пользователь %d (роль %s) к %s %s",
+					principal.UserID, principal.Role, c.Request().Method, c.Path())
+				return errs.Forbidden(i18n.CodeForbidden)
+			}
+
+			return next(c)
+		}
+	}
+}
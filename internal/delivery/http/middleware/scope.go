@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"restaurant-management/internal/auth"
+)
+
+// routeResources сопоставляет шаблоны маршрутов с ресурсами, к которым
+// выдаются области доступа API-ключей. Выбирается самый длинный подходящий
+// префикс; маршруты, которых здесь нет, API-ключам недоступны.
+var routeResources = map[string]string{
+	"/api/v1/cities":                           "cities",
+	"/api/v1/restaurants":                      "restaurants",
+	"/api/v1/restaurants/:id/staff":            "staff",
+	"/api/v1/restaurants/:id/events":           "events",
+	"/api/v1/restaurants/:id/event-pricing":    "events",
+	"/api/v1/restaurants/:id/inquiries":        "events",
+	"/api/v1/restaurants/:id/reservations":     "reservations",
+	"/api/v1/restaurants/:id/table-assignment": "reservations",
+	"/api/v1/restaurants/:id/availability":     "reservations",
+	"/api/v1/restaurants/:id/waitlist":         "reservations",
+	"/api/v1/restaurants/:id/calendar":         "reservations",
+	"/api/v1/sections":                         "sections",
+	"/api/v1/sections/:id/combinations":        "tables",
+	"/api/v1/tables":                           "tables",
+	"/api/v1/menus":                            "menus",
+	"/api/v1/menu-types":                       "menu-types",
+	"/api/v1/events":                           "events",
+	"/api/v1/event-types":                      "events",
+	"/api/v1/inquiries":                        "events",
+	"/api/v1/reservations":                     "reservations",
+	"/api/v1/waitlist":                         "reservations",
+}
+
+func requiredScope(c echo.Context) (string, bool) {
+	path := c.Path()
+
+	var resource, matched string
+	for prefix, r := range routeResources {
+		if (path == prefix || strings.HasPrefix(path, prefix+"/")) && len(prefix) > len(matched) {
+			matched, resource = prefix, r
+		}
+	}
+
+	if resource == "" {
+		return "", false
+	}
+
+	action := auth.ScopeWrite
+	switch c.Request().Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		action = auth.ScopeRead
+	}
+
+	return auth.Scope(resource, action), true
+}
+
+// allowScope сообщает, есть ли у API-ключа область доступа к маршруту.
+func allowScope(c echo.Context, principal *auth.Principal) bool {
+	scope, ok := requiredScope(c)
+	if ok && principal.HasScope(scope) {
+		return true
+	}
+
+	c.Logger().Warnf("Отказано в доступе: API-ключ %d к %s %s (требуется %q)",
+		principal.APIKeyID, c.Request().Method, c.Path(), scope)
+	return false
+}
@@ -9,11 +9,12 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
 	echoSwagger "github.com/swaggo/echo-swagger"
 
 	"restaurant-management/internal/config"
 	"restaurant-management/internal/delivery/http/handlers"
+	"restaurant-management/internal/delivery/http/middleware"
 	"restaurant-management/internal/usecase"
 )
 
@@ -25,6 +26,7 @@ type Server struct {
 
 func NewServer(cfg *config.Config, useCase *usecase.UseCase) *Server {
 	e := echo.New()
+	e.HTTPErrorHandler = handlers.HTTPErrorHandler
 	return &Server{
 		echo:    e,
 		config:  cfg,
@@ -33,9 +35,12 @@ func NewServer(cfg *config.Config, useCase *usecase.UseCase) *Server {
 }
 
 func (s *Server) Start() error {
-	s.echo.Use(middleware.Logger())
-	s.echo.Use(middleware.Recover())
-	s.echo.Use(middleware.CORS())
+	s.echo.Use(echoMiddleware.RequestID())
+	s.echo.Use(echoMiddleware.Logger())
+	s.echo.Use(echoMiddleware.Recover())
+	s.echo.Use(echoMiddleware.CORS())
+	s.echo.Use(middleware.Language())
+	s.echo.Use(middleware.RequestMeta())
 
 	s.setupRoutes()
 
@@ -60,26 +65,78 @@ func (s *Server) setupRoutes() {
 	s.echo.GET("/swagger/*", echoSwagger.WrapHandler)
 	api := s.echo.Group("/api/v1")
 
+	authHandler := handlers.NewAuthHandler(s.useCase.Auth)
+	authHandler.Register(api)
+
+	paymentHandler := handlers.NewPaymentHandler(s.useCase.RestaurantEventTable)
+	paymentHandler.Register(api)
+
+	calendarHandler := handlers.NewCalendarHandler(s.useCase.Calendar)
+	calendarHandler.RegisterFeed(api)
+
+	protected := api.Group("", middleware.Auth(s.useCase.Auth))
+
 	userHandler := handlers.NewUserHandler(s.useCase.User)
-	userHandler.Register(api)
+	userHandler.Register(protected)
 
 	cityHandler := handlers.NewCityHandler(s.useCase.City)
-	cityHandler.Register(api)
+	cityHandler.Register(protected)
 
 	restaurantHandler := handlers.NewRestaurantHandler(s.useCase.Restaurant)
-	restaurantHandler.Register(api)
+	restaurantHandler.Register(protected)
 
 	sectionHandler := handlers.NewSectionHandler(s.useCase.Section)
-	sectionHandler.Register(api)
+	sectionHandler.Register(protected)
 
 	tableHandler := handlers.NewTableHandler(s.useCase.Table)
-	tableHandler.Register(api)
+	tableHandler.Register(protected)
+
+	combinationHandler := handlers.NewTableCombinationHandler(s.useCase.TableCombination)
+	combinationHandler.Register(protected)
 
 	menuTypeHandler := handlers.NewMenuTypeHandler(s.useCase.MenuType)
-	menuTypeHandler.Register(api)
+	menuTypeHandler.Register(protected)
+
+	eventTypeHandler := handlers.NewEventTypeHandler(s.useCase.EventType)
+	eventTypeHandler.Register(protected)
 
 	menuHandler := handlers.NewMenuHandler(s.useCase.Menu)
-	menuHandler.Register(api)
+	menuHandler.Register(protected)
+
+	staffHandler := handlers.NewStaffHandler(s.useCase.Staff)
+	staffHandler.Register(protected)
+
+	apiKeyHandler := handlers.NewAPIKeyHandler(s.useCase.APIKey)
+	apiKeyHandler.Register(protected)
+
+	eventHandler := handlers.NewRestaurantEventHandler(s.useCase.RestaurantEvent)
+	eventHandler.Register(protected)
+
+	packageHandler := handlers.NewEventPackageHandler(s.useCase.EventPackage)
+	packageHandler.Register(protected)
+
+	inquiryHandler := handlers.NewEventInquiryHandler(s.useCase.EventInquiry)
+	inquiryHandler.Register(protected)
+
+	bookingHandler := handlers.NewRestaurantEventTableHandler(s.useCase.RestaurantEventTable)
+	bookingHandler.Register(protected)
+
+	reservationHandler := handlers.NewReservationHandler(s.useCase.Reservation)
+	reservationHandler.Register(protected)
+
+	waitlistHandler := handlers.NewWaitlistHandler(s.useCase.Waitlist)
+	waitlistHandler.Register(protected)
+
+	scheduleHandler := handlers.NewScheduleHandler(s.useCase.Schedule)
+	scheduleHandler.Register(protected)
+
+	calendarHandler.Register(protected)
+
+	noShowHandler := handlers.NewNoShowHandler(s.useCase.Reservation)
+	noShowHandler.Register(protected)
+
+	auditHandler := handlers.NewAuditHandler(s.useCase.Audit)
+	auditHandler.Register(protected)
 
 	s.echo.GET("/health", func(c echo.Context) error {
 		return c.JSON(http.StatusOK, map[string]string{
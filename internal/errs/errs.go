@@ -0,0 +1,147 @@
+// Package errs описывает доменные ошибки: их вид определяет HTTP-статус
+// ответа, а код из каталога i18n — текст, который увидит клиент.
+package errs
+
+import (
+	"errors"
+
+	"restaurant-management/internal/i18n"
+)
+
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindValidation
+	KindNotFound
+	KindConflict
+	KindForbidden
+	KindUnauthorized
+	KindTooManyRequests
+	KindUnavailable
+)
+
+// FieldError описывает ошибку в конкретном поле запроса.
+type FieldError struct {
+	Field string
+	Code  i18n.Code
+	Args  []interface{}
+}
+
+// Error — доменная ошибка. Текст и код берутся из вложенной ошибки i18n,
+// поэтому i18n.Localize и i18n.CodeOf работают с ней без изменений.
+type Error struct {
+	Kind   Kind
+	Fields []FieldError
+	// Conflicting описывает запись, из-за которой возник конфликт, чтобы
+	// клиент мог показать ее, не делая отдельный запрос.
+	Conflicting interface{}
+	err         error
+}
+
+func New(kind Kind, code i18n.Code, args ...interface{}) error {
+	return &Error{Kind: kind, err: i18n.New(code, args...)}
+}
+
+// Wrap сохраняет причину err для логов, а клиенту отдает только code.
+func Wrap(err error, kind Kind, code i18n.Code, args ...interface{}) error {
+	return &Error{Kind: kind, err: i18n.Wrap(err, code, args...)}
+}
+
+func NotFound(code i18n.Code, args ...interface{}) error {
+	return New(KindNotFound, code, args...)
+}
+
+func Conflict(code i18n.Code, args ...interface{}) error {
+	return New(KindConflict, code, args...)
+}
+
+// ConflictWith возвращает конфликт с описанием мешающей записи.
+func ConflictWith(conflicting interface{}, code i18n.Code, args ...interface{}) error {
+	return &Error{Kind: KindConflict, Conflicting: conflicting, err: i18n.New(code, args...)}
+}
+
+func Validation(code i18n.Code, args ...interface{}) error {
+	return New(KindValidation, code, args...)
+}
+
+func Forbidden(code i18n.Code, args ...interface{}) error {
+	return New(KindForbidden, code, args...)
+}
+
+func Unauthorized(code i18n.Code, args ...interface{}) error {
+	return New(KindUnauthorized, code, args...)
+}
+
+func TooManyRequests(code i18n.Code, args ...interface{}) error {
+	return New(KindTooManyRequests, code, args...)
+}
+
+// Invalid возвращает ошибку валидации одного поля.
+func Invalid(field string, code i18n.Code, args ...interface{}) error {
+	var fields Fields
+	fields.Add(field, code, args...)
+	return fields.Err()
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// KindOf возвращает вид доменной ошибки из цепочки err. Ошибки без вида
+// считаются внутренними.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return KindInternal
+}
+
+func IsNotFound(err error) bool {
+	return KindOf(err) == KindNotFound
+}
+
+// FieldsOf возвращает ошибки полей, если err — ошибка валидации.
+func FieldsOf(err error) []FieldError {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Fields
+	}
+	return nil
+}
+
+// ConflictingOf возвращает запись, из-за которой возник конфликт, если
+// она известна.
+func ConflictingOf(err error) interface{} {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Conflicting
+	}
+	return nil
+}
+
+// Fields собирает ошибки валидации по всем полям запроса, чтобы клиент
+// получил их одним ответом.
+type Fields []FieldError
+
+func (f *Fields) Add(field string, code i18n.Code, args ...interface{}) {
+	*f = append(*f, FieldError{Field: field, Code: code, Args: args})
+}
+
+// Err возвращает nil, если ошибок нет. Код ответа совпадает с кодом первой
+// ошибки, полный список доступен через FieldsOf.
+func (f Fields) Err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return &Error{
+		Kind:   KindValidation,
+		Fields: f,
+		err:    i18n.New(f[0].Code, f[0].Args...),
+	}
+}
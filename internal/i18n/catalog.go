@@ -0,0 +1,1537 @@
+package i18n
+
+// catalog содержит переводы всех сообщений. Русский текст обязателен: он
+// используется как запасной вариант и в Error() для логов.
+var catalog = map[Code]map[Lang]string{
+	CodeInternal: {
+		LangRU: "внутренняя ошибка сервера",
+		LangKZ: "сервердің ішкі қатесі",
+		LangEN: "internal server error",
+	},
+	CodeForbidden: {
+		LangRU: "недостаточно прав для выполнения операции",
+		LangKZ: "операцияны орындауға құқық жеткіліксіз",
+		LangEN: "insufficient permissions for this operation",
+	},
+	CodeUnauthorized: {
+		LangRU: "требуется авторизация",
+		LangKZ: "авторизация қажет",
+		LangEN: "authorization required",
+	},
+	CodeInvalidToken: {
+		LangRU: "недействительный или просроченный токен",
+		LangKZ: "токен жарамсыз немесе мерзімі өткен",
+		LangEN: "invalid or expired token",
+	},
+	CodeCalendarTokenInvalid: {
+		LangRU: "недействительная ссылка на календарь",
+		LangKZ: "күнтізбе сілтемесі жарамсыз",
+		LangEN: "invalid calendar link",
+	},
+	CodeCalendarFeedNotFound: {
+		LangRU: "у ресторана %d нет ссылки на календарь",
+		LangKZ: "%d мейрамханасында күнтізбе сілтемесі жоқ",
+		LangEN: "restaurant %d has no calendar link",
+	},
+	CodeInvalidAPIKey: {
+		LangRU: "недействительный или отозванный API-ключ",
+		LangKZ: "API-кілт жарамсыз немесе кері қайтарылған",
+		LangEN: "invalid or revoked API key",
+	},
+	CodeAPIKeyScope: {
+		LangRU: "у ключа нет доступа к этому ресурсу",
+		LangKZ: "кілттің бұл ресурсқа рұқсаты жоқ",
+		LangEN: "the key has no access to this resource",
+	},
+	CodeInvalidRequest: {
+		LangRU: "некорректные данные запроса",
+		LangKZ: "сұраныс деректері дұрыс емес",
+		LangEN: "invalid request data",
+	},
+	CodeRouteNotFound: {
+		LangRU: "маршрут не найден",
+		LangKZ: "маршрут табылмады",
+		LangEN: "route not found",
+	},
+	CodeMethodNotAllowed: {
+		LangRU: "метод не поддерживается",
+		LangKZ: "әдіс қолдау көрсетілмейді",
+		LangEN: "method not allowed",
+	},
+	CodeRequestReadError: {
+		LangRU: "не удалось прочитать запрос",
+		LangKZ: "сұранысты оқу мүмкін болмады",
+		LangEN: "failed to read the request",
+	},
+
+	CodeInvalidUserData: {
+		LangRU: "некорректные данные пользователя",
+		LangKZ: "пайдаланушы деректері дұрыс емес",
+		LangEN: "invalid user data",
+	},
+	CodeInvalidCityData: {
+		LangRU: "некорректные данные города",
+		LangKZ: "қала деректері дұрыс емес",
+		LangEN: "invalid city data",
+	},
+	CodeInvalidRestaurantData: {
+		LangRU: "некорректные данные ресторана",
+		LangKZ: "мейрамхана деректері дұрыс емес",
+		LangEN: "invalid restaurant data",
+	},
+	CodeInvalidSectionData: {
+		LangRU: "некорректные данные секции",
+		LangKZ: "секция деректері дұрыс емес",
+		LangEN: "invalid section data",
+	},
+	CodeInvalidTableData: {
+		LangRU: "некорректные данные столика",
+		LangKZ: "үстел деректері дұрыс емес",
+		LangEN: "invalid table data",
+	},
+	CodeInvalidMenuTypeData: {
+		LangRU: "некорректные данные типа меню",
+		LangKZ: "мәзір түрінің деректері дұрыс емес",
+		LangEN: "invalid menu type data",
+	},
+	CodeInvalidMenuData: {
+		LangRU: "некорректные данные меню",
+		LangKZ: "мәзір деректері дұрыс емес",
+		LangEN: "invalid menu data",
+	},
+	CodeInvalidEventData: {
+		LangRU: "некорректные данные события ресторана",
+		LangKZ: "мейрамхана іс-шарасының деректері дұрыс емес",
+		LangEN: "invalid restaurant event data",
+	},
+	CodeInvalidAssignmentData: {
+		LangRU: "некорректные данные назначения",
+		LangKZ: "тағайындау деректері дұрыс емес",
+		LangEN: "invalid assignment data",
+	},
+	CodeInvalidAPIKeyData: {
+		LangRU: "некорректные данные ключа",
+		LangKZ: "кілт деректері дұрыс емес",
+		LangEN: "invalid API key data",
+	},
+
+	CodeInvalidUserID: {
+		LangRU: "некорректный ID пользователя",
+		LangKZ: "пайдаланушы ID дұрыс емес",
+		LangEN: "invalid user ID",
+	},
+	CodeInvalidCityID: {
+		LangRU: "некорректный ID города",
+		LangKZ: "қала ID дұрыс емес",
+		LangEN: "invalid city ID",
+	},
+	CodeInvalidRestaurantID: {
+		LangRU: "некорректный ID ресторана",
+		LangKZ: "мейрамхана ID дұрыс емес",
+		LangEN: "invalid restaurant ID",
+	},
+	CodeInvalidSectionID: {
+		LangRU: "некорректный ID секции",
+		LangKZ: "секция ID дұрыс емес",
+		LangEN: "invalid section ID",
+	},
+	CodeInvalidTableID: {
+		LangRU: "некорректный ID столика",
+		LangKZ: "үстел ID дұрыс емес",
+		LangEN: "invalid table ID",
+	},
+	CodeInvalidMenuTypeID: {
+		LangRU: "некорректный ID типа меню",
+		LangKZ: "мәзір түрінің ID дұрыс емес",
+		LangEN: "invalid menu type ID",
+	},
+	CodeInvalidMenuID: {
+		LangRU: "некорректный ID меню",
+		LangKZ: "мәзір ID дұрыс емес",
+		LangEN: "invalid menu ID",
+	},
+	CodeInvalidEventID: {
+		LangRU: "некорректный ID события ресторана",
+		LangKZ: "мейрамхана іс-шарасының ID дұрыс емес",
+		LangEN: "invalid restaurant event ID",
+	},
+	CodeInvalidAPIKeyID: {
+		LangRU: "некорректный ID ключа",
+		LangKZ: "кілт ID дұрыс емес",
+		LangEN: "invalid API key ID",
+	},
+	CodeInvalidQueryParam: {
+		LangRU: "некорректное значение параметра %s",
+		LangKZ: "%s параметрінің мәні дұрыс емес",
+		LangEN: "invalid value of parameter %s",
+	},
+	CodeInvalidEventTypeData: {
+		LangRU: "некорректные данные типа события",
+		LangKZ: "іс-шара түрінің деректері дұрыс емес",
+		LangEN: "invalid event type data",
+	},
+	CodeInvalidPackageData: {
+		LangRU: "некорректные данные пакета события",
+		LangKZ: "іс-шара пакетінің деректері дұрыс емес",
+		LangEN: "invalid event package data",
+	},
+	CodeInvalidEventPricing: {
+		LangRU: "некорректные надбавки к банкетам",
+		LangKZ: "банкеттерге үстемеақылар дұрыс емес",
+		LangEN: "invalid event pricing",
+	},
+	CodeInvalidInquiryData: {
+		LangRU: "некорректные данные заявки",
+		LangKZ: "өтінім деректері дұрыс емес",
+		LangEN: "invalid inquiry data",
+	},
+	CodeInvalidBookingData: {
+		LangRU: "некорректные данные бронирования",
+		LangKZ: "брондау деректері дұрыс емес",
+		LangEN: "invalid booking data",
+	},
+	CodeInvalidReservationData: {
+		LangRU: "некорректные данные бронирования стола",
+		LangKZ: "үстелді брондау деректері дұрыс емес",
+		LangEN: "invalid reservation data",
+	},
+	CodeInvalidReservationID: {
+		LangRU: "некорректный ID бронирования",
+		LangKZ: "брондау ID дұрыс емес",
+		LangEN: "invalid reservation ID",
+	},
+	CodeInvalidBookingDate: {
+		LangRU: "некорректная дата бронирования, используйте формат RFC3339",
+		LangKZ: "брондау күні дұрыс емес, RFC3339 форматын қолданыңыз",
+		LangEN: "invalid booking date, use RFC3339 format",
+	},
+
+	CodeNotAuthenticated: {
+		LangRU: "пользователь не авторизован",
+		LangKZ: "пайдаланушы авторизацияланбаған",
+		LangEN: "user is not authenticated",
+	},
+	CodeOTPPhoneAndCode: {
+		LangRU: "необходимо указать номер телефона и код подтверждения",
+		LangKZ: "телефон нөмірі мен растау кодын көрсету қажет",
+		LangEN: "phone number and confirmation code are required",
+	},
+	CodeOTPNotRequested: {
+		LangRU: "код подтверждения не запрашивался или уже использован",
+		LangKZ: "растау коды сұралмаған немесе бұрын пайдаланылған",
+		LangEN: "confirmation code was not requested or has already been used",
+	},
+	CodeOTPNotFound: {
+		LangRU: "код подтверждения для номера %s не найден",
+		LangKZ: "%s нөміріне арналған растау коды табылмады",
+		LangEN: "confirmation code for %s not found",
+	},
+	CodeOTPResendTooEarly: {
+		LangRU: "код уже отправлен, повторный запрос возможен через %s",
+		LangKZ: "код жіберілді, қайта сұрауға %s кейін болады",
+		LangEN: "code already sent, you can request a new one in %s",
+	},
+	CodeOTPExpired: {
+		LangRU: "срок действия кода подтверждения истек",
+		LangKZ: "растау кодының мерзімі өтті",
+		LangEN: "confirmation code has expired",
+	},
+	CodeOTPAttemptsExceeded: {
+		LangRU: "превышено количество попыток ввода кода, запросите новый код",
+		LangKZ: "кодты енгізу әрекеттерінің саны асып кетті, жаңа код сұраңыз",
+		LangEN: "too many attempts, please request a new code",
+	},
+	CodeOTPInvalid: {
+		LangRU: "неверный код подтверждения",
+		LangKZ: "растау коды қате",
+		LangEN: "invalid confirmation code",
+	},
+	CodeSMSSendFailed: {
+		LangRU: "не удалось отправить SMS",
+		LangKZ: "SMS жіберу мүмкін болмады",
+		LangEN: "failed to send SMS",
+	},
+	CodeUserBlocked: {
+		LangRU: "пользователь заблокирован",
+		LangKZ: "пайдаланушы бұғатталған",
+		LangEN: "user is blocked",
+	},
+	CodeUserBlockedOrDeleted: {
+		LangRU: "пользователь заблокирован или удален",
+		LangKZ: "пайдаланушы бұғатталған немесе жойылған",
+		LangEN: "user is blocked or deleted",
+	},
+	CodeRefreshTokenInvalid: {
+		LangRU: "некорректный refresh-токен",
+		LangKZ: "refresh-токен дұрыс емес",
+		LangEN: "invalid refresh token",
+	},
+	CodeRefreshTokenReused: {
+		LangRU: "refresh-токен уже использован, сессия завершена",
+		LangKZ: "refresh-токен бұрын пайдаланылған, сессия аяқталды",
+		LangEN: "refresh token has already been used, the session was terminated",
+	},
+	CodeSessionExpired: {
+		LangRU: "сессия не найдена или истекла",
+		LangKZ: "сессия табылмады немесе мерзімі өтті",
+		LangEN: "session not found or expired",
+	},
+	CodeSessionEnded: {
+		LangRU: "сессия завершена",
+		LangKZ: "сессия аяқталды",
+		LangEN: "session has ended",
+	},
+	CodeSessionNotFound: {
+		LangRU: "сессия %s не найдена",
+		LangKZ: "%s сессиясы табылмады",
+		LangEN: "session %s not found",
+	},
+	CodeAPIKeyNotValid: {
+		LangRU: "недействительный API-ключ",
+		LangKZ: "API-кілт жарамсыз",
+		LangEN: "invalid API key",
+	},
+	CodeAPIKeyRevoked: {
+		LangRU: "API-ключ отозван",
+		LangKZ: "API-кілт кері қайтарылған",
+		LangEN: "API key has been revoked",
+	},
+	CodeSessionsRevokeFailed: {
+		LangRU: "пользователь заблокирован, но не удалось завершить его сессии",
+		LangKZ: "пайдаланушы бұғатталды, бірақ оның сессияларын аяқтау мүмкін болмады",
+		LangEN: "user was blocked, but their sessions could not be terminated",
+	},
+	CodeManagerAssignFailed: {
+		LangRU: "ресторан создан, но не удалось назначить менеджера",
+		LangKZ: "мейрамхана құрылды, бірақ менеджерді тағайындау мүмкін болмады",
+		LangEN: "restaurant was created, but the manager could not be assigned",
+	},
+	CodeAPIKeyNameRequired: {
+		LangRU: "название ключа не может быть пустым",
+		LangKZ: "кілт атауы бос болмауы керек",
+		LangEN: "key name must not be empty",
+	},
+	CodeAPIKeyScopesRequired: {
+		LangRU: "необходимо указать хотя бы одну область доступа",
+		LangKZ: "кемінде бір рұқсат аясын көрсету қажет",
+		LangEN: "at least one scope is required",
+	},
+	CodeAPIKeyScopeUnknown: {
+		LangRU: "неизвестная область доступа: %s",
+		LangKZ: "белгісіз рұқсат аясы: %s",
+		LangEN: "unknown scope: %s",
+	},
+	CodeAPIKeyNotFound: {
+		LangRU: "API-ключ не найден",
+		LangKZ: "API-кілт табылмады",
+		LangEN: "API key not found",
+	},
+	CodeAPIKeyIDNotFound: {
+		LangRU: "API-ключ с ID %d не найден",
+		LangKZ: "ID %d API-кілті табылмады",
+		LangEN: "API key with ID %d not found",
+	},
+	CodeAPIKeyNotFoundRevoked: {
+		LangRU: "API-ключ с ID %d не найден или уже отозван",
+		LangKZ: "ID %d API-кілті табылмады немесе бұрын кері қайтарылған",
+		LangEN: "API key with ID %d not found or already revoked",
+	},
+
+	CodeUserNotFound: {
+		LangRU: "пользователь с ID %d не найден",
+		LangKZ: "ID %d пайдаланушы табылмады",
+		LangEN: "user with ID %d not found",
+	},
+	CodeUserPhoneNotFound: {
+		LangRU: "пользователь с номером телефона %s не найден",
+		LangKZ: "%s телефон нөмірі бар пайдаланушы табылмады",
+		LangEN: "user with phone number %s not found",
+	},
+	CodeUserNotExists: {
+		LangRU: "указанный пользователь не существует",
+		LangKZ: "көрсетілген пайдаланушы жоқ",
+		LangEN: "the specified user does not exist",
+	},
+	CodeUserPhoneExists: {
+		LangRU: "пользователь с номером телефона %s уже существует",
+		LangKZ: "%s телефон нөмірі бар пайдаланушы бұрыннан бар",
+		LangEN: "user with phone number %s already exists",
+	},
+	CodeUserPhoneTaken: {
+		LangRU: "номер телефона пользователя %d уже занят другим пользователем",
+		LangKZ: "%d пайдаланушының телефон нөмірін басқа пайдаланушы алған",
+		LangEN: "the phone number of user %d is already used by another user",
+	},
+	CodeUserDeleted: {
+		LangRU: "пользователь %d удален",
+		LangKZ: "%d пайдаланушы жойылған",
+		LangEN: "user %d has been deleted",
+	},
+	CodeUserNotFoundOrAnonymized: {
+		LangRU: "пользователь с ID %d не найден или уже анонимизирован",
+		LangKZ: "ID %d пайдаланушы табылмады немесе бұрын анонимделген",
+		LangEN: "user with ID %d not found or already anonymized",
+	},
+	CodeDeletedUserNotFound: {
+		LangRU: "удаленный пользователь с ID %d не найден или был анонимизирован",
+		LangKZ: "ID %d жойылған пайдаланушы табылмады немесе анонимделген",
+		LangEN: "deleted user with ID %d not found or has been anonymized",
+	},
+	CodePhoneRequired: {
+		LangRU: "номер телефона не может быть пустым",
+		LangKZ: "телефон нөмірі бос болмауы керек",
+		LangEN: "phone number must not be empty",
+	},
+	CodePhoneInvalid: {
+		LangRU: "некорректный номер телефона",
+		LangKZ: "телефон нөмірі дұрыс емес",
+		LangEN: "invalid phone number",
+	},
+	CodeUserNameRequired: {
+		LangRU: "имя не может быть пустым",
+		LangKZ: "аты бос болмауы керек",
+		LangEN: "name must not be empty",
+	},
+	CodeUserRoleUnknown: {
+		LangRU: "неизвестная роль пользователя: %s",
+		LangKZ: "пайдаланушының белгісіз рөлі: %s",
+		LangEN: "unknown user role: %s",
+	},
+	CodeUserIDRequired: {
+		LangRU: "необходимо указать корректный ID пользователя",
+		LangKZ: "дұрыс пайдаланушы ID көрсету қажет",
+		LangEN: "a valid user ID is required",
+	},
+	CodeStaffRoleInvalid: {
+		LangRU: "роль сотрудника должна быть manager или waiter",
+		LangKZ: "қызметкер рөлі manager немесе waiter болуы керек",
+		LangEN: "staff role must be manager or waiter",
+	},
+	CodeStaffNotFound: {
+		LangRU: "пользователь %d не является сотрудником ресторана %d",
+		LangKZ: "%d пайдаланушы %d мейрамханасының қызметкері емес",
+		LangEN: "user %d is not a staff member of restaurant %d",
+	},
+	CodeUserOrRestaurantNotExist: {
+		LangRU: "пользователь или ресторан не существует",
+		LangKZ: "пайдаланушы немесе мейрамхана жоқ",
+		LangEN: "user or restaurant does not exist",
+	},
+
+	CodeCityNotFound: {
+		LangRU: "город с ID %d не найден",
+		LangKZ: "ID %d қала табылмады",
+		LangEN: "city with ID %d not found",
+	},
+	CodeCityNameNotFound: {
+		LangRU: "город с названием %s не найден",
+		LangKZ: "%s атты қала табылмады",
+		LangEN: "city named %s not found",
+	},
+	CodeCityInUse: {
+		LangRU: "город с ID %d используется ресторанами",
+		LangKZ: "ID %d қаласы мейрамханаларда қолданылады",
+		LangEN: "city with ID %d is used by restaurants",
+	},
+	CodeCityNotExists: {
+		LangRU: "указанный город не существует",
+		LangKZ: "көрсетілген қала жоқ",
+		LangEN: "the specified city does not exist",
+	},
+	CodeCityExists: {
+		LangRU: "город с названием '%s' уже существует",
+		LangKZ: "'%s' атты қала бұрыннан бар",
+		LangEN: "city named '%s' already exists",
+	},
+	CodeCityNameRequired: {
+		LangRU: "название города не может быть пустым",
+		LangKZ: "қала атауы бос болмауы керек",
+		LangEN: "city name must not be empty",
+	},
+	CodeCityNameTooShort: {
+		LangRU: "название города должно быть не менее 2 символов",
+		LangKZ: "қала атауы кемінде 2 таңбадан тұруы керек",
+		LangEN: "city name must be at least 2 characters long",
+	},
+	CodeCityIDRequired: {
+		LangRU: "необходимо указать корректный ID города",
+		LangKZ: "дұрыс қала ID көрсету қажет",
+		LangEN: "a valid city ID is required",
+	},
+	CodeTimeZoneUnknown: {
+		LangRU: "неизвестный часовой пояс '%s', ожидается название из базы IANA, например Asia/Almaty",
+		LangKZ: "'%s' белгісіз сағат белдеуі, IANA базасындағы атау күтіледі, мысалы Asia/Almaty",
+		LangEN: "unknown time zone '%s', expected an IANA name such as Asia/Almaty",
+	},
+	CodeMenuTypeNotFound: {
+		LangRU: "тип меню с ID %d не найден",
+		LangKZ: "ID %d мәзір түрі табылмады",
+		LangEN: "menu type with ID %d not found",
+	},
+	CodeMenuTypeExists: {
+		LangRU: "тип меню с названием '%s' уже существует",
+		LangKZ: "'%s' атты мәзір түрі бұрыннан бар",
+		LangEN: "menu type named '%s' already exists",
+	},
+	CodeMenuTypeNameReq: {
+		LangRU: "название типа меню не может быть пустым",
+		LangKZ: "мәзір түрінің атауы бос болмауы керек",
+		LangEN: "menu type name must not be empty",
+	},
+	CodeMenuTypeNameShort: {
+		LangRU: "название типа меню должно быть не менее 2 символов",
+		LangKZ: "мәзір түрінің атауы кемінде 2 таңбадан тұруы керек",
+		LangEN: "menu type name must be at least 2 characters long",
+	},
+	CodeRestaurantNotFound: {
+		LangRU: "ресторан с ID %d не найден",
+		LangKZ: "ID %d мейрамхана табылмады",
+		LangEN: "restaurant with ID %d not found",
+	},
+	CodeRestaurantNotExist: {
+		LangRU: "указанный ресторан не существует",
+		LangKZ: "көрсетілген мейрамхана жоқ",
+		LangEN: "the specified restaurant does not exist",
+	},
+	CodeRestaurantIDReq: {
+		LangRU: "необходимо указать корректный ID ресторана",
+		LangKZ: "дұрыс мейрамхана ID көрсету қажет",
+		LangEN: "a valid restaurant ID is required",
+	},
+	CodeRestaurantNameReq: {
+		LangRU: "название ресторана не может быть пустым",
+		LangKZ: "мейрамхана атауы бос болмауы керек",
+		LangEN: "restaurant name must not be empty",
+	},
+	CodeRestaurantNameLen: {
+		LangRU: "название ресторана должно быть не менее 3 символов",
+		LangKZ: "мейрамхана атауы кемінде 3 таңбадан тұруы керек",
+		LangEN: "restaurant name must be at least 3 characters long",
+	},
+	CodeRestaurantAddress: {
+		LangRU: "адрес ресторана (RU) не может быть пустым",
+		LangKZ: "мейрамхана мекенжайы (RU) бос болмауы керек",
+		LangEN: "restaurant address (RU) must not be empty",
+	},
+	CodeSectionNotFound: {
+		LangRU: "секция с ID %d не найдена",
+		LangKZ: "ID %d секция табылмады",
+		LangEN: "section with ID %d not found",
+	},
+	CodeSectionNotExists: {
+		LangRU: "указанная секция не существует",
+		LangKZ: "көрсетілген секция жоқ",
+		LangEN: "the specified section does not exist",
+	},
+	CodeSectionNameReq: {
+		LangRU: "название секции не может быть пустым",
+		LangKZ: "секция атауы бос болмауы керек",
+		LangEN: "section name must not be empty",
+	},
+	CodeSectionNameTaken: {
+		LangRU: "секция с названием '%s' уже существует в этом ресторане",
+		LangKZ: "бұл мейрамханада '%s' атты секция бұрыннан бар",
+		LangEN: "section named '%s' already exists in this restaurant",
+	},
+	CodeSectionIDRequired: {
+		LangRU: "необходимо указать корректный ID секции",
+		LangKZ: "дұрыс секция ID көрсету қажет",
+		LangEN: "a valid section ID is required",
+	},
+	CodeTableNotFound: {
+		LangRU: "столик с ID %d не найден",
+		LangKZ: "ID %d үстел табылмады",
+		LangEN: "table with ID %d not found",
+	},
+	CodeTableNumberInvalid: {
+		LangRU: "номер столика должен быть положительным числом",
+		LangKZ: "үстел нөмірі оң сан болуы керек",
+		LangEN: "table number must be a positive number",
+	},
+	CodeTableNumberTaken: {
+		LangRU: "столик с номером %d уже существует в этой секции",
+		LangKZ: "бұл секцияда %d нөмірлі үстел бұрыннан бар",
+		LangEN: "table number %d already exists in this section",
+	},
+	CodeMenuNotFound: {
+		LangRU: "меню с ID %d не найдено",
+		LangKZ: "ID %d мәзір табылмады",
+		LangEN: "menu with ID %d not found",
+	},
+	CodeMenuNameRURequired: {
+		LangRU: "название меню на русском не может быть пустым",
+		LangKZ: "мәзірдің орысша атауы бос болмауы керек",
+		LangEN: "menu name in Russian must not be empty",
+	},
+	CodeEventNotFound: {
+		LangRU: "событие ресторана с ID %d не найдено",
+		LangKZ: "ID %d мейрамхана іс-шарасы табылмады",
+		LangEN: "restaurant event with ID %d not found",
+	},
+	CodeEventNameRequired: {
+		LangRU: "название события не может быть пустым",
+		LangKZ: "іс-шара атауы бос болмауы керек",
+		LangEN: "event name must not be empty",
+	},
+	CodeEventTypeUnknown: {
+		LangRU: "неизвестный тип события: %s",
+		LangKZ: "белгісіз іс-шара түрі: %s",
+		LangEN: "unknown event type: %s",
+	},
+	CodeEventTypeInactive: {
+		LangRU: "тип события %s отключен",
+		LangKZ: "%s іс-шара түрі өшірілген",
+		LangEN: "event type %s is disabled",
+	},
+	CodeEventTypeNotFound: {
+		LangRU: "тип события %v не найден",
+		LangKZ: "%v іс-шара түрі табылмады",
+		LangEN: "event type %v not found",
+	},
+	CodeEventTypeExists: {
+		LangRU: "тип события %s уже существует",
+		LangKZ: "%s іс-шара түрі бар",
+		LangEN: "event type %s already exists",
+	},
+	CodeEventTypeInUse: {
+		LangRU: "тип события %v используется событиями ресторанов, отключите его вместо удаления",
+		LangKZ: "%v іс-шара түрін мейрамхана іс-шаралары қолданады, оны жоюдың орнына өшіріңіз",
+		LangEN: "event type %v is used by restaurant events, disable it instead of deleting",
+	},
+	CodeEventTypeCodeInvalid: {
+		LangRU: "код типа события должен начинаться с латинской буквы и содержать только a-z, цифры и _, не длиннее %d символов",
+		LangKZ: "іс-шара түрінің коды латын әрпінен басталып, тек a-z, сандар мен _ таңбаларынан тұруы керек, ұзындығы %d таңбадан аспауы керек",
+		LangEN: "event type code must start with a letter, contain only a-z, digits and _, and be at most %d characters long",
+	},
+	CodeEventTypeNameReq: {
+		LangRU: "название типа события обязательно",
+		LangKZ: "іс-шара түрінің атауы міндетті",
+		LangEN: "event type name is required",
+	},
+	CodeEventPriceNegative: {
+		LangRU: "цена не может быть отрицательной",
+		LangKZ: "баға теріс болмауы керек",
+		LangEN: "price must not be negative",
+	},
+	CodeEventNotExists: {
+		LangRU: "указанное событие не существует",
+		LangKZ: "көрсетілген іс-шара жоқ",
+		LangEN: "the specified event does not exist",
+	},
+	CodeTableNotExists: {
+		LangRU: "указанный стол не существует",
+		LangKZ: "көрсетілген үстел жоқ",
+		LangEN: "the specified table does not exist",
+	},
+	CodeEventOrTableNotExist: {
+		LangRU: "событие или стол не существует",
+		LangKZ: "іс-шара немесе үстел жоқ",
+		LangEN: "event or table does not exist",
+	},
+	CodeBookingDateRequired: {
+		LangRU: "необходимо указать дату бронирования",
+		LangKZ: "брондау күнін көрсету қажет",
+		LangEN: "booking date is required",
+	},
+	CodeBookingDateInPast: {
+		LangRU: "дата бронирования должна быть в будущем",
+		LangKZ: "брондау күні болашақта болуы керек",
+		LangEN: "booking date must be in the future",
+	},
+	CodeTableAlreadyBooked: {
+		LangRU: "стол %d уже забронирован на %s",
+		LangKZ: "%d үстелі %s күнге брондалған",
+		LangEN: "table %d is already booked on %s",
+	},
+	CodeTableNotInRestaurant: {
+		LangRU: "стол %d не принадлежит ресторану %d",
+		LangKZ: "%d үстелі %d мейрамханасына тиесілі емес",
+		LangEN: "table %d does not belong to restaurant %d",
+	},
+	CodeSectionOutside: {
+		LangRU: "секция %d не принадлежит ресторану %d",
+		LangKZ: "%d бөлімі %d мейрамханасына тиесілі емес",
+		LangEN: "section %d does not belong to restaurant %d",
+	},
+	CodeSectionHasNoTables: {
+		LangRU: "в секции %d нет столов",
+		LangKZ: "%d бөлімінде үстелдер жоқ",
+		LangEN: "section %d has no tables",
+	},
+	CodeTableSeatsInvalid: {
+		LangRU: "вместимость стола должна быть от 1 до %d мест, минимум не больше максимума",
+		LangKZ: "үстел сыйымдылығы 1-ден %d орынға дейін болуы керек, ең азы ең көбінен аспауы керек",
+		LangEN: "table capacity must be between 1 and %d seats with min_seats not above max_seats",
+	},
+	CodeTableShapeUnknown: {
+		LangRU: "неизвестная форма стола: %s",
+		LangKZ: "үстелдің белгісіз пішіні: %s",
+		LangEN: "unknown table shape: %s",
+	},
+	CodeTableTagUnknown: {
+		LangRU: "неизвестный тег: %s",
+		LangKZ: "белгісіз тег: %s",
+		LangEN: "unknown tag: %s",
+	},
+	CodeNoTableAvailable: {
+		LangRU: "нет свободного стола для %d гостей в это время",
+		LangKZ: "бұл уақытта %d қонаққа бос үстел жоқ",
+		LangEN: "no free table for %d guests at this time",
+	},
+	CodePartyExceedsTable: {
+		LangRU: "за стол %d помещается не больше %d гостей",
+		LangKZ: "%d үстеліне %d қонақтан артық сыймайды",
+		LangEN: "table %d seats at most %d guests",
+	},
+	CodeInvalidScheduleData: {
+		LangRU: "некорректные данные расписания",
+		LangKZ: "кесте деректері дұрыс емес",
+		LangEN: "invalid schedule data",
+	},
+	CodeInvalidBlackoutData: {
+		LangRU: "некорректные данные закрытия секции",
+		LangKZ: "секцияның жабылу деректері дұрыс емес",
+		LangEN: "invalid blackout data",
+	},
+	CodeInvalidBlackoutID: {
+		LangRU: "некорректный ID закрытия секции",
+		LangKZ: "секцияның жабылу ID-і дұрыс емес",
+		LangEN: "invalid blackout ID",
+	},
+	CodeInvalidWaitlistData: {
+		LangRU: "некорректные данные очереди",
+		LangKZ: "кезек деректері дұрыс емес",
+		LangEN: "invalid waitlist data",
+	},
+	CodeInvalidWaitlistID: {
+		LangRU: "некорректный ID записи очереди",
+		LangKZ: "кезек жазбасының ID-і дұрыс емес",
+		LangEN: "invalid waitlist entry ID",
+	},
+	CodeWaitlistEntryNotFound: {
+		LangRU: "запись очереди с ID %d не найдена",
+		LangKZ: "ID %d кезек жазбасы табылмады",
+		LangEN: "waitlist entry with ID %d not found",
+	},
+	CodeWaitlistEntryClosed: {
+		LangRU: "гости из записи очереди %d уже посажены или ушли",
+		LangKZ: "%d кезек жазбасындағы қонақтар отырғызылған немесе кетіп қалған",
+		LangEN: "the party of waitlist entry %d has already been seated or left",
+	},
+	CodeWaitlistNoteTooLong: {
+		LangRU: "примечание не должно превышать %d символов",
+		LangKZ: "ескертпе %d таңбадан аспауы керек",
+		LangEN: "note must not exceed %d characters",
+	},
+	CodeInvalidCombinationData: {
+		LangRU: "некорректные данные сочетания столов",
+		LangKZ: "үстелдер тіркесімінің деректері дұрыс емес",
+		LangEN: "invalid table combination data",
+	},
+	CodeInvalidPaymentData: {
+		LangRU: "некорректное уведомление о платеже",
+		LangKZ: "төлем туралы хабарлама дұрыс емес",
+		LangEN: "invalid payment notification",
+	},
+	CodeInvalidNoShowPolicy: {
+		LangRU: "некорректные правила неявок",
+		LangKZ: "келмеу ережелері дұрыс емес",
+		LangEN: "invalid no-show policy",
+	},
+	CodeInvalidCombinationID: {
+		LangRU: "некорректный ID сочетания столов",
+		LangKZ: "үстелдер тіркесімінің ID-і дұрыс емес",
+		LangEN: "invalid table combination ID",
+	},
+	CodeInvalidEventTypeID: {
+		LangRU: "некорректный ID типа события",
+		LangKZ: "іс-шара түрінің ID-і дұрыс емес",
+		LangEN: "invalid event type ID",
+	},
+	CodeInvalidPackageID: {
+		LangRU: "некорректный ID пакета события",
+		LangKZ: "іс-шара пакетінің ID-і дұрыс емес",
+		LangEN: "invalid event package ID",
+	},
+	CodeInvalidInquiryID: {
+		LangRU: "некорректный ID заявки",
+		LangKZ: "өтінім ID-і дұрыс емес",
+		LangEN: "invalid inquiry ID",
+	},
+	CodeTableNotInSection: {
+		LangRU: "стол %d не относится к секции %d",
+		LangKZ: "%d үстелі %d секциясына жатпайды",
+		LangEN: "table %d does not belong to section %d",
+	},
+	CodeCombinationNotFound: {
+		LangRU: "сочетание столов с ID %d не найдено",
+		LangKZ: "ID %d үстелдер тіркесімі табылмады",
+		LangEN: "table combination with ID %d not found",
+	},
+	CodeCombinationNotExists: {
+		LangRU: "указанное сочетание столов не существует",
+		LangKZ: "көрсетілген үстелдер тіркесімі жоқ",
+		LangEN: "the specified table combination does not exist",
+	},
+	CodeCombinationTables: {
+		LangRU: "в сочетании должно быть не меньше двух разных столов",
+		LangKZ: "тіркесімде кемінде екі түрлі үстел болуы керек",
+		LangEN: "a combination must contain at least two different tables",
+	},
+	CodeCombinationSeats: {
+		LangRU: "вместимость сочетания: от 1 до %d гостей, min_seats не больше max_seats",
+		LangKZ: "тіркесім сыйымдылығы: 1-ден %d қонаққа дейін, min_seats max_seats-тен аспауы керек",
+		LangEN: "combination capacity must be between 1 and %d guests with min_seats not above max_seats",
+	},
+	CodeCombinationInUse: {
+		LangRU: "сочетание %d занято предстоящими бронированиями, его столы нельзя изменить",
+		LangKZ: "%d тіркесімі алдағы брондауларға бос емес, оның үстелдерін өзгертуге болмайды",
+		LangEN: "combination %d is held by upcoming bookings, its tables cannot be changed",
+	},
+	CodeCombinationOutside: {
+		LangRU: "сочетание столов %d не принадлежит ресторану %d",
+		LangKZ: "%d үстелдер тіркесімі %d мейрамханасына тиесілі емес",
+		LangEN: "table combination %d does not belong to restaurant %d",
+	},
+	CodePartyExceedsCombination: {
+		LangRU: "за сочетание столов %d помещается не больше %d гостей",
+		LangKZ: "%d үстелдер тіркесіміне %d қонақтан артық сыймайды",
+		LangEN: "table combination %d seats at most %d guests",
+	},
+	CodeInvalidDay: {
+		LangRU: "некорректная дата %s, используйте формат ГГГГ-ММ-ДД",
+		LangKZ: "%s күні дұрыс емес, ЖЖЖЖ-АА-КК форматын қолданыңыз",
+		LangEN: "invalid date %s, use YYYY-MM-DD format",
+	},
+	CodeWeekdayInvalid: {
+		LangRU: "день недели должен быть от 0 (воскресенье) до 6 (суббота)",
+		LangKZ: "апта күні 0-ден (жексенбі) 6-ға (сенбі) дейін болуы керек",
+		LangEN: "weekday must be between 0 (Sunday) and 6 (Saturday)",
+	},
+	CodeTimeOfDayInvalid: {
+		LangRU: "некорректное время %s, используйте формат ЧЧ:ММ",
+		LangKZ: "%s уақыты дұрыс емес, СС:ММ форматын қолданыңыз",
+		LangEN: "invalid time %s, use HH:MM format",
+	},
+	CodeOpeningHoursOverlap: {
+		LangRU: "интервалы работы пересекаются",
+		LangKZ: "жұмыс аралықтары қиылысады",
+		LangEN: "opening intervals overlap",
+	},
+	CodeSpecialDayNotFound: {
+		LangRU: "особый день %s не найден",
+		LangKZ: "%s ерекше күні табылмады",
+		LangEN: "special day %s not found",
+	},
+	CodeBlackoutNotFound: {
+		LangRU: "закрытие секции с ID %d не найдено",
+		LangKZ: "ID %d секция жабылуы табылмады",
+		LangEN: "section blackout with ID %d not found",
+	},
+	CodeBlackoutPeriod: {
+		LangRU: "конец закрытия секции должен быть позже начала",
+		LangKZ: "секция жабылуының соңы басынан кейін болуы керек",
+		LangEN: "blackout must end after it starts",
+	},
+	CodeRestaurantClosed: {
+		LangRU: "ресторан закрыт в это время",
+		LangKZ: "бұл уақытта мейрамхана жабық",
+		LangEN: "the restaurant is closed at this time",
+	},
+	CodeRestaurantClosedOn: {
+		LangRU: "ресторан не работает %s",
+		LangKZ: "мейрамхана %s жұмыс істемейді",
+		LangEN: "the restaurant is closed on %s",
+	},
+	CodeSectionBlackedOut: {
+		LangRU: "секция %d закрыта для бронирования в это время",
+		LangKZ: "бұл уақытта %d секциясы брондауға жабық",
+		LangEN: "section %d is closed for bookings at this time",
+	},
+	CodeReservationNotFound: {
+		LangRU: "бронирование с ID %d не найдено",
+		LangKZ: "ID %d брондауы табылмады",
+		LangEN: "reservation with ID %d not found",
+	},
+	CodeReservationRefsNotExist: {
+		LangRU: "ресторан, стол или пользователь не существует",
+		LangKZ: "мейрамхана, үстел немесе пайдаланушы жоқ",
+		LangEN: "restaurant, table or user does not exist",
+	},
+	CodePartySizeInvalid: {
+		LangRU: "количество гостей должно быть от 1 до %d",
+		LangKZ: "қонақтар саны 1-ден %d-ге дейін болуы керек",
+		LangEN: "party size must be between 1 and %d",
+	},
+	CodeReservationStartRequired: {
+		LangRU: "необходимо указать время начала бронирования",
+		LangKZ: "брондаудың басталу уақытын көрсету қажет",
+		LangEN: "reservation start time is required",
+	},
+	CodeReservationStartInPast: {
+		LangRU: "время начала бронирования должно быть в будущем",
+		LangKZ: "брондаудың басталу уақыты болашақта болуы керек",
+		LangEN: "reservation start time must be in the future",
+	},
+	CodeReservationDurationBounds: {
+		LangRU: "продолжительность бронирования должна быть от %d до %d минут",
+		LangKZ: "брондау ұзақтығы %d-ден %d минутқа дейін болуы керек",
+		LangEN: "reservation duration must be between %d and %d minutes",
+	},
+	CodeSpecialRequestsTooLong: {
+		LangRU: "пожелания не должны превышать %d символов",
+		LangKZ: "тілектер %d таңбадан аспауы керек",
+		LangEN: "special requests must not exceed %d characters",
+	},
+	CodeTableNotAvailable: {
+		LangRU: "стол %d занят в это время",
+		LangKZ: "%d үстелі бұл уақытта бос емес",
+		LangEN: "table %d is not available at this time",
+	},
+	CodeReservationNotEditable: {
+		LangRU: "бронирование в статусе %s нельзя изменить",
+		LangKZ: "%s мәртебесіндегі брондауды өзгертуге болмайды",
+		LangEN: "reservation in status %s cannot be changed",
+	},
+	CodeReservationStatusUnknown: {
+		LangRU: "неизвестный статус бронирования: %s",
+		LangKZ: "брондаудың белгісіз мәртебесі: %s",
+		LangEN: "unknown reservation status: %s",
+	},
+	CodeReservationTransition: {
+		LangRU: "нельзя перевести бронирование из статуса %s в %s",
+		LangKZ: "брондауды %s мәртебесінен %s мәртебесіне ауыстыруға болмайды",
+		LangEN: "reservation cannot move from %s to %s",
+	},
+	CodeReservationChanged: {
+		LangRU: "статус бронирования %d уже изменен, обновите данные и повторите",
+		LangKZ: "%d брондау мәртебесі өзгертілген, деректерді жаңартып, қайталаңыз",
+		LangEN: "reservation %d status has already changed, reload it and try again",
+	},
+	CodeBookingNotFound: {
+		LangRU: "бронирование стола %d на %s не найдено",
+		LangKZ: "%d үстелінің %s күнгі брондауы табылмады",
+		LangEN: "booking of table %d on %s not found",
+	},
+	CodeDepositTypeUnknown: {
+		LangRU: "неизвестный тип депозита: %s",
+		LangKZ: "белгісіз депозит түрі: %s",
+		LangEN: "unknown deposit type: %s",
+	},
+	CodeDepositValueInvalid: {
+		LangRU: "депозит должен быть положительным, а процент — не больше 100",
+		LangKZ: "депозит оң болуы керек, ал пайыз 100-ден аспауы керек",
+		LangEN: "deposit must be positive and a percentage must not exceed 100",
+	},
+	CodeEventGuestsInvalid: {
+		LangRU: "количество гостей должно быть от 0 до %d",
+		LangKZ: "қонақтар саны 0-ден %d-ге дейін болуы керек",
+		LangEN: "number of guests must be between 0 and %d",
+	},
+	CodeEventGuestsRequired: {
+		LangRU: "укажите количество гостей: от него считается депозит",
+		LangKZ: "қонақтар санын көрсетіңіз: депозит соған байланысты есептеледі",
+		LangEN: "number of guests is required to calculate the deposit",
+	},
+	CodePackageNotFound: {
+		LangRU: "пакет события с ID %d не найден",
+		LangKZ: "ID %d іс-шара пакеті табылмады",
+		LangEN: "event package with ID %d not found",
+	},
+	CodePackageExists: {
+		LangRU: "у события уже есть пакет «%s»",
+		LangKZ: "іс-шарада «%s» пакеті бар",
+		LangEN: "the event already has a package named %s",
+	},
+	CodePackageNameRequired: {
+		LangRU: "необходимо указать название пакета",
+		LangKZ: "пакет атауын көрсету қажет",
+		LangEN: "package name is required",
+	},
+	CodePackageTierUnknown: {
+		LangRU: "неизвестный уровень пакета %s, допустимы standard и premium",
+		LangKZ: "%s пакет деңгейі белгісіз, standard немесе premium болуы керек",
+		LangEN: "unknown package tier %s, expected standard or premium",
+	},
+	CodePackagePriceNegative: {
+		LangRU: "цена за гостя не может быть отрицательной",
+		LangKZ: "бір қонақтың бағасы теріс болмауы керек",
+		LangEN: "price per guest cannot be negative",
+	},
+	CodePackageMinGuests: {
+		LangRU: "минимальное число гостей должно быть от 1 до %d",
+		LangKZ: "қонақтардың ең аз саны 1-ден %d-ге дейін болуы керек",
+		LangEN: "minimum number of guests must be between 1 and %d",
+	},
+	CodePackageMaxGuests: {
+		LangRU: "максимальное число гостей должно быть не меньше минимального и не больше %d",
+		LangKZ: "қонақтардың ең көп саны ең аз санынан кем емес және %d-ден аспауы керек",
+		LangEN: "maximum number of guests must be at least the minimum and at most %d",
+	},
+	CodePackageInactive: {
+		LangRU: "пакет события %d отключен",
+		LangKZ: "%d іс-шара пакеті өшірілген",
+		LangEN: "event package %d is disabled",
+	},
+	CodeQuoteGuestsRequired: {
+		LangRU: "необходимо указать число гостей",
+		LangKZ: "қонақтар санын көрсету қажет",
+		LangEN: "number of guests is required",
+	},
+	CodeQuoteGuestsBelowMin: {
+		LangRU: "пакет рассчитан минимум на %d гостей",
+		LangKZ: "пакет кемінде %d қонаққа арналған",
+		LangEN: "the package requires at least %d guests",
+	},
+	CodeQuoteGuestsAboveMax: {
+		LangRU: "пакет рассчитан максимум на %d гостей",
+		LangKZ: "пакет ең көбі %d қонаққа арналған",
+		LangEN: "the package allows at most %d guests",
+	},
+	CodeQuoteDateRequired: {
+		LangRU: "необходимо указать дату банкета",
+		LangKZ: "банкет күнін көрсету қажет",
+		LangEN: "banquet date is required",
+	},
+	CodeQuoteDateInPast: {
+		LangRU: "дата банкета не может быть в прошлом",
+		LangKZ: "банкет күні өткен уақытта болмауы керек",
+		LangEN: "banquet date cannot be in the past",
+	},
+	CodeWeekendPercentInvalid: {
+		LangRU: "надбавка за выходные должна быть от 0 до 100 процентов",
+		LangKZ: "демалыс күндеріне үстемеақы 0-ден 100 пайызға дейін болуы керек",
+		LangEN: "weekend surcharge must be between 0 and 100 percent",
+	},
+	CodeInquiryNotFound: {
+		LangRU: "заявка с ID %d не найдена",
+		LangKZ: "ID %d өтінім табылмады",
+		LangEN: "inquiry with ID %d not found",
+	},
+	CodeInquiryClosed: {
+		LangRU: "заявка %d закрыта и не может быть изменена",
+		LangKZ: "%d өтінім жабылған, оны өзгертуге болмайды",
+		LangEN: "inquiry %d is closed and cannot be changed",
+	},
+	CodeInquiryStatusChanged: {
+		LangRU: "заявка %d уже изменена, обновите данные и повторите",
+		LangKZ: "%d өтінім өзгертілген, деректерді жаңартып, қайталаңыз",
+		LangEN: "inquiry %d has already changed, reload it and try again",
+	},
+	CodeInquiryStatusUnknown: {
+		LangRU: "неизвестный статус заявки: %s",
+		LangKZ: "өтінімнің белгісіз мәртебесі: %s",
+		LangEN: "unknown inquiry status: %s",
+	},
+	CodeInquiryTransition: {
+		LangRU: "нельзя перевести заявку из статуса %s в %s",
+		LangKZ: "өтінімді %s мәртебесінен %s мәртебесіне ауыстыруға болмайды",
+		LangEN: "cannot move inquiry from %s to %s",
+	},
+	CodeInquiryConfirmByConvert: {
+		LangRU: "заявка подтверждается только переводом в бронирование события",
+		LangKZ: "өтінім тек іс-шара брондауына ауыстыру арқылы расталады",
+		LangEN: "an inquiry is confirmed only by converting it into an event booking",
+	},
+	CodeInquiryNotConvertible: {
+		LangRU: "заявку в статусе %s нельзя перевести в бронирование",
+		LangKZ: "%s мәртебесіндегі өтінімді брондауға ауыстыруға болмайды",
+		LangEN: "an inquiry in status %s cannot be converted into a booking",
+	},
+	CodeInquiryDepositByPayment: {
+		LangRU: "статус deposit_paid ставится только при оплате депозита",
+		LangKZ: "deposit_paid мәртебесі тек депозит төленгенде қойылады",
+		LangEN: "status deposit_paid is set only when the deposit is paid",
+	},
+	CodeInquiryPaymentPending: {
+		LangRU: "по заявке ожидается оплата депозита %d",
+		LangKZ: "өтінім бойынша %d депозитінің төлемі күтілуде",
+		LangEN: "the inquiry is waiting for payment of deposit %d",
+	},
+	CodeInquiryDateRequired: {
+		LangRU: "необходимо указать желаемую дату в формате ГГГГ-ММ-ДД",
+		LangKZ: "қалаған күнді ЖЖЖЖ-АА-КК форматында көрсету қажет",
+		LangEN: "desired date in YYYY-MM-DD format is required",
+	},
+	CodeInquiryDateInPast: {
+		LangRU: "желаемая дата не может быть в прошлом",
+		LangKZ: "қалаған күн өткен уақытта болмауы керек",
+		LangEN: "desired date cannot be in the past",
+	},
+	CodeInquiryGuestsInvalid: {
+		LangRU: "количество гостей должно быть от 1 до %d",
+		LangKZ: "қонақтар саны 1-ден %d-ге дейін болуы керек",
+		LangEN: "number of guests must be between 1 and %d",
+	},
+	CodeInquiryContactTooLong: {
+		LangRU: "имя контакта не должно превышать %d символов",
+		LangKZ: "байланыс атауы %d таңбадан аспауы керек",
+		LangEN: "contact name must not exceed %d characters",
+	},
+	CodeInquiryNoteRequired: {
+		LangRU: "необходимо указать текст заметки",
+		LangKZ: "жазба мәтінін көрсету қажет",
+		LangEN: "note text is required",
+	},
+	CodeInquiryNoteTooLong: {
+		LangRU: "заметка не должна превышать %d символов",
+		LangKZ: "жазба %d таңбадан аспауы керек",
+		LangEN: "note must not exceed %d characters",
+	},
+	CodeInquiryAssigneeInvalid: {
+		LangRU: "пользователь %d не является менеджером ресторана %d",
+		LangKZ: "%d пайдаланушы %d мейрамханасының менеджері емес",
+		LangEN: "user %d is not a manager of restaurant %d",
+	},
+	CodeInquiryTablesOrSection: {
+		LangRU: "укажите столы table_ids или секцию section_id, но не то и другое",
+		LangKZ: "table_ids үстелдерін немесе section_id бөлімін көрсетіңіз, бірақ екеуін бірге емес",
+		LangEN: "specify either table_ids or section_id, not both",
+	},
+	CodeInquiryEventOutside: {
+		LangRU: "событие %d не относится к ресторану %d",
+		LangKZ: "%d іс-шарасы %d мейрамханасына жатпайды",
+		LangEN: "event %d does not belong to restaurant %d",
+	},
+	CodeDepositNotFound: {
+		LangRU: "депозит %v не найден",
+		LangKZ: "%v депозиті табылмады",
+		LangEN: "deposit %v not found",
+	},
+	CodeNoShowTooEarly: {
+		LangRU: "отметить неявку можно только после начала бронирования",
+		LangKZ: "келмегенін брондау басталғаннан кейін ғана белгілеуге болады",
+		LangEN: "a no-show can only be marked after the reservation starts",
+	},
+	CodeNoShowNotForgivable: {
+		LangRU: "бронирование %d не отмечено как неявка или неявка уже прощена",
+		LangKZ: "%d брондауы келмеу деп белгіленбеген немесе ол кешірілген",
+		LangEN: "reservation %d is not a no-show or was already forgiven",
+	},
+	CodeGuestBookingBlocked: {
+		LangRU: "онлайн-бронирование недоступно: неявок — %d, обратитесь в ресторан",
+		LangKZ: "онлайн брондау қолжетімсіз: келмеулер саны — %d, мейрамханаға хабарласыңыз",
+		LangEN: "online booking is unavailable after %d no-shows, please contact the restaurant",
+	},
+	CodeNoShowThresholdInvalid: {
+		LangRU: "порог неявок должен быть не меньше 1",
+		LangKZ: "келмеулер шегі кемінде 1 болуы керек",
+		LangEN: "no-show threshold must be at least 1",
+	},
+	CodeNoShowDepositAmount: {
+		LangRU: "укажите сумму депозита больше нуля",
+		LangKZ: "нөлден үлкен депозит сомасын көрсетіңіз",
+		LangEN: "deposit amount must be greater than zero",
+	},
+	CodeDepositNotPending: {
+		LangRU: "депозит уже не ждет оплаты: статус %s",
+		LangKZ: "депозит енді төлемді күтпейді: мәртебесі %s",
+		LangEN: "deposit is no longer awaiting payment: status %s",
+	},
+	CodePaymentSignature: {
+		LangRU: "неверная подпись уведомления о платеже",
+		LangKZ: "төлем туралы хабарламаның қолтаңбасы дұрыс емес",
+		LangEN: "invalid payment notification signature",
+	},
+	CodePaymentAmount: {
+		LangRU: "сумма платежа не совпадает с депозитом %.2f",
+		LangKZ: "төлем сомасы %.2f депозитіне сәйкес келмейді",
+		LangEN: "payment amount does not match the deposit of %.2f",
+	},
+	CodePaymentUnavailable: {
+		LangRU: "не удалось создать платеж, попробуйте позже",
+		LangKZ: "төлем жасау мүмкін болмады, кейінірек қайталаңыз",
+		LangEN: "failed to create a payment, try again later",
+	},
+
+	MsgOTPSent: {
+		LangRU: "код подтверждения отправлен",
+		LangKZ: "растау коды жіберілді",
+		LangEN: "confirmation code sent",
+	},
+	MsgSessionEnded: {
+		LangRU: "сессия завершена",
+		LangKZ: "сессия аяқталды",
+		LangEN: "session ended",
+	},
+	MsgAllSessionsEnded: {
+		LangRU: "все сессии завершены",
+		LangKZ: "барлық сессиялар аяқталды",
+		LangEN: "all sessions ended",
+	},
+	MsgUserCreated: {
+		LangRU: "пользователь успешно создан",
+		LangKZ: "пайдаланушы сәтті құрылды",
+		LangEN: "user created successfully",
+	},
+	MsgUserUpdated: {
+		LangRU: "пользователь успешно обновлен",
+		LangKZ: "пайдаланушы сәтті жаңартылды",
+		LangEN: "user updated successfully",
+	},
+	MsgUserDeleted: {
+		LangRU: "пользователь успешно удален",
+		LangKZ: "пайдаланушы сәтті жойылды",
+		LangEN: "user deleted successfully",
+	},
+	MsgUserRestored: {
+		LangRU: "пользователь успешно восстановлен",
+		LangKZ: "пайдаланушы сәтті қалпына келтірілді",
+		LangEN: "user restored successfully",
+	},
+	MsgUserAnonymized: {
+		LangRU: "данные пользователя анонимизированы",
+		LangKZ: "пайдаланушы деректері анонимделді",
+		LangEN: "user data anonymized",
+	},
+	MsgCityCreated: {
+		LangRU: "город успешно создан",
+		LangKZ: "қала сәтті құрылды",
+		LangEN: "city created successfully",
+	},
+	MsgCityUpdated: {
+		LangRU: "город успешно обновлен",
+		LangKZ: "қала сәтті жаңартылды",
+		LangEN: "city updated successfully",
+	},
+	MsgCityDeleted: {
+		LangRU: "город успешно удален",
+		LangKZ: "қала сәтті жойылды",
+		LangEN: "city deleted successfully",
+	},
+	MsgRestaurantCreated: {
+		LangRU: "ресторан успешно создан",
+		LangKZ: "мейрамхана сәтті құрылды",
+		LangEN: "restaurant created successfully",
+	},
+	MsgRestaurantUpdated: {
+		LangRU: "ресторан успешно обновлен",
+		LangKZ: "мейрамхана сәтті жаңартылды",
+		LangEN: "restaurant updated successfully",
+	},
+	MsgRestaurantDeleted: {
+		LangRU: "ресторан успешно удален",
+		LangKZ: "мейрамхана сәтті жойылды",
+		LangEN: "restaurant deleted successfully",
+	},
+	MsgSectionCreated: {
+		LangRU: "секция успешно создана",
+		LangKZ: "секция сәтті құрылды",
+		LangEN: "section created successfully",
+	},
+	MsgSectionUpdated: {
+		LangRU: "секция успешно обновлена",
+		LangKZ: "секция сәтті жаңартылды",
+		LangEN: "section updated successfully",
+	},
+	MsgSectionDeleted: {
+		LangRU: "секция успешно удалена",
+		LangKZ: "секция сәтті жойылды",
+		LangEN: "section deleted successfully",
+	},
+	MsgTableCreated: {
+		LangRU: "столик успешно создан",
+		LangKZ: "үстел сәтті құрылды",
+		LangEN: "table created successfully",
+	},
+	MsgTableUpdated: {
+		LangRU: "столик успешно обновлен",
+		LangKZ: "үстел сәтті жаңартылды",
+		LangEN: "table updated successfully",
+	},
+	MsgTableDeleted: {
+		LangRU: "столик успешно удален",
+		LangKZ: "үстел сәтті жойылды",
+		LangEN: "table deleted successfully",
+	},
+	MsgCombinationCreated: {
+		LangRU: "сочетание столов создано",
+		LangKZ: "үстелдер тіркесімі құрылды",
+		LangEN: "table combination created",
+	},
+	MsgCombinationUpdated: {
+		LangRU: "сочетание столов обновлено",
+		LangKZ: "үстелдер тіркесімі жаңартылды",
+		LangEN: "table combination updated",
+	},
+	MsgCombinationDeleted: {
+		LangRU: "сочетание столов удалено",
+		LangKZ: "үстелдер тіркесімі жойылды",
+		LangEN: "table combination deleted",
+	},
+	MsgQRGenerated: {
+		LangRU: "QR-код успешно сгенерирован",
+		LangKZ: "QR-код сәтті жасалды",
+		LangEN: "QR code generated successfully",
+	},
+	MsgMenuTypeCreated: {
+		LangRU: "тип меню успешно создан",
+		LangKZ: "мәзір түрі сәтті құрылды",
+		LangEN: "menu type created successfully",
+	},
+	MsgMenuTypeUpdated: {
+		LangRU: "тип меню успешно обновлен",
+		LangKZ: "мәзір түрі сәтті жаңартылды",
+		LangEN: "menu type updated successfully",
+	},
+	MsgMenuTypeDeleted: {
+		LangRU: "тип меню успешно удален",
+		LangKZ: "мәзір түрі сәтті жойылды",
+		LangEN: "menu type deleted successfully",
+	},
+	MsgMenuCreated: {
+		LangRU: "меню успешно создано",
+		LangKZ: "мәзір сәтті құрылды",
+		LangEN: "menu created successfully",
+	},
+	MsgMenuUpdated: {
+		LangRU: "меню успешно обновлено",
+		LangKZ: "мәзір сәтті жаңартылды",
+		LangEN: "menu updated successfully",
+	},
+	MsgMenuDeleted: {
+		LangRU: "меню успешно удалено",
+		LangKZ: "мәзір сәтті жойылды",
+		LangEN: "menu deleted successfully",
+	},
+	MsgEventCreated: {
+		LangRU: "событие ресторана успешно создано",
+		LangKZ: "мейрамхана іс-шарасы сәтті құрылды",
+		LangEN: "restaurant event created successfully",
+	},
+	MsgEventUpdated: {
+		LangRU: "событие ресторана успешно обновлено",
+		LangKZ: "мейрамхана іс-шарасы сәтті жаңартылды",
+		LangEN: "restaurant event updated successfully",
+	},
+	MsgEventDeleted: {
+		LangRU: "событие ресторана успешно удалено",
+		LangKZ: "мейрамхана іс-шарасы сәтті жойылды",
+		LangEN: "restaurant event deleted successfully",
+	},
+	MsgPackageCreated: {
+		LangRU: "пакет события создан",
+		LangKZ: "іс-шара пакеті құрылды",
+		LangEN: "event package created",
+	},
+	MsgPackageUpdated: {
+		LangRU: "пакет события обновлен",
+		LangKZ: "іс-шара пакеті жаңартылды",
+		LangEN: "event package updated",
+	},
+	MsgPackageDeleted: {
+		LangRU: "пакет события удален",
+		LangKZ: "іс-шара пакеті жойылды",
+		LangEN: "event package deleted",
+	},
+	MsgEventPricingUpdated: {
+		LangRU: "надбавки к банкетам обновлены",
+		LangKZ: "банкеттерге үстемеақылар жаңартылды",
+		LangEN: "event pricing updated",
+	},
+	MsgInquiryCreated: {
+		LangRU: "заявка создана",
+		LangKZ: "өтінім құрылды",
+		LangEN: "inquiry created",
+	},
+	MsgInquiryUpdated: {
+		LangRU: "заявка обновлена",
+		LangKZ: "өтінім жаңартылды",
+		LangEN: "inquiry updated",
+	},
+	MsgInquiryStatus: {
+		LangRU: "статус заявки обновлен",
+		LangKZ: "өтінім мәртебесі жаңартылды",
+		LangEN: "inquiry status updated",
+	},
+	MsgInquiryAssigned: {
+		LangRU: "менеджер заявки обновлен",
+		LangKZ: "өтінім менеджері жаңартылды",
+		LangEN: "inquiry manager updated",
+	},
+	MsgInquiryNoteAdded: {
+		LangRU: "заметка добавлена",
+		LangKZ: "жазба қосылды",
+		LangEN: "note added",
+	},
+	MsgInquiryConverted: {
+		LangRU: "заявка подтверждена, столы забронированы",
+		LangKZ: "өтінім расталды, үстелдер брондалды",
+		LangEN: "inquiry confirmed, tables booked",
+	},
+	MsgInquiryAwaitingPayment: {
+		LangRU: "столы забронированы, заявка подтвердится после оплаты депозита",
+		LangKZ: "үстелдер брондалды, өтінім депозит төленгеннен кейін расталады",
+		LangEN: "tables booked, the inquiry will be confirmed once the deposit is paid",
+	},
+	MsgReservationCreated: {
+		LangRU: "стол успешно забронирован",
+		LangKZ: "үстел сәтті брондалды",
+		LangEN: "reservation created successfully",
+	},
+	MsgReservationUpdated: {
+		LangRU: "бронирование успешно обновлено",
+		LangKZ: "брондау сәтті жаңартылды",
+		LangEN: "reservation updated successfully",
+	},
+	MsgReservationCancelled: {
+		LangRU: "бронирование отменено",
+		LangKZ: "брондау жойылды",
+		LangEN: "reservation cancelled",
+	},
+	MsgReservationStatus: {
+		LangRU: "статус бронирования обновлен",
+		LangKZ: "брондау мәртебесі жаңартылды",
+		LangEN: "reservation status updated",
+	},
+	MsgReservationAwaitsPayment: {
+		LangRU: "бронирование создано и ждет оплаты депозита",
+		LangKZ: "брондау жасалды және депозит төлемін күтуде",
+		LangEN: "reservation created and awaiting deposit payment",
+	},
+	MsgNoShowMarked: {
+		LangRU: "неявка отмечена",
+		LangKZ: "келмеу белгіленді",
+		LangEN: "no-show recorded",
+	},
+	MsgNoShowForgiven: {
+		LangRU: "неявка прощена",
+		LangKZ: "келмеу кешірілді",
+		LangEN: "no-show forgiven",
+	},
+	MsgNoShowPolicyUpdated: {
+		LangRU: "правила неявок обновлены",
+		LangKZ: "келмеу ережелері жаңартылды",
+		LangEN: "no-show policy updated",
+	},
+	MsgEventTypeCreated: {
+		LangRU: "тип события создан",
+		LangKZ: "іс-шара түрі жасалды",
+		LangEN: "event type created",
+	},
+	MsgEventTypeUpdated: {
+		LangRU: "тип события обновлен",
+		LangKZ: "іс-шара түрі жаңартылды",
+		LangEN: "event type updated",
+	},
+	MsgEventTypeDeleted: {
+		LangRU: "тип события удален",
+		LangKZ: "іс-шара түрі жойылды",
+		LangEN: "event type deleted",
+	},
+	MsgOpeningHoursUpdated: {
+		LangRU: "расписание работы обновлено",
+		LangKZ: "жұмыс кестесі жаңартылды",
+		LangEN: "opening hours updated",
+	},
+	MsgSpecialDaySaved: {
+		LangRU: "особый день сохранен",
+		LangKZ: "ерекше күн сақталды",
+		LangEN: "special day saved",
+	},
+	MsgSpecialDayDeleted: {
+		LangRU: "особый день удален",
+		LangKZ: "ерекше күн жойылды",
+		LangEN: "special day deleted",
+	},
+	MsgBlackoutCreated: {
+		LangRU: "закрытие секции добавлено",
+		LangKZ: "секцияның жабылуы қосылды",
+		LangEN: "section blackout created",
+	},
+	MsgBlackoutDeleted: {
+		LangRU: "закрытие секции удалено",
+		LangKZ: "секцияның жабылуы жойылды",
+		LangEN: "section blackout deleted",
+	},
+	MsgWaitlistNotified: {
+		LangRU: "гостям отправлено сообщение, что стол готов",
+		LangKZ: "қонақтарға үстел дайын екені туралы хабар жіберілді",
+		LangEN: "the party has been notified that their table is ready",
+	},
+	MsgWaitlistSeated: {
+		LangRU: "гости посажены за стол",
+		LangKZ: "қонақтар үстелге отырғызылды",
+		LangEN: "party seated",
+	},
+	MsgWaitlistCancelled: {
+		LangRU: "гости удалены из очереди",
+		LangKZ: "қонақтар кезектен шығарылды",
+		LangEN: "party removed from the waitlist",
+	},
+	MsgBookingAwaitsPayment: {
+		LangRU: "стол забронирован и ждет оплаты депозита",
+		LangKZ: "үстел брондалды, депозит төлемін күтуде",
+		LangEN: "table booked, awaiting deposit payment",
+	},
+	MsgPaymentAccepted: {
+		LangRU: "платеж принят",
+		LangKZ: "төлем қабылданды",
+		LangEN: "payment accepted",
+	},
+	MsgTableBooked: {
+		LangRU: "стол успешно забронирован",
+		LangKZ: "үстел сәтті брондалды",
+		LangEN: "table booked successfully",
+	},
+	MsgBookingCancelled: {
+		LangRU: "бронирование стола отменено",
+		LangKZ: "үстел брондауы жойылды",
+		LangEN: "table booking cancelled",
+	},
+	MsgStaffAssigned: {
+		LangRU: "сотрудник успешно назначен",
+		LangKZ: "қызметкер сәтті тағайындалды",
+		LangEN: "staff member assigned successfully",
+	},
+	MsgStaffRemoved: {
+		LangRU: "сотрудник успешно снят с ресторана",
+		LangKZ: "қызметкер мейрамханадан сәтті босатылды",
+		LangEN: "staff member removed from the restaurant",
+	},
+	MsgAPIKeyRevoked: {
+		LangRU: "API-ключ отозван",
+		LangKZ: "API-кілт кері қайтарылды",
+		LangEN: "API key revoked",
+	},
+	MsgOTPSMS: {
+		LangRU: "Ваш код подтверждения: %s",
+		LangKZ: "Сіздің растау кодыңыз: %s",
+		LangEN: "Your confirmation code: %s",
+	},
+	MsgTableReadySMS: {
+		LangRU: "Ваш стол в ресторане %s готов. Пожалуйста, подойдите к хостес.",
+		LangKZ: "%s мейрамханасындағы үстеліңіз дайын. Хостеске келуіңізді сұраймыз.",
+		LangEN: "Your table at %s is ready. Please come to the host stand.",
+	},
+	MsgCalendarFeedRevoked: {
+		LangRU: "ссылка на календарь отозвана",
+		LangKZ: "күнтізбе сілтемесі кері қайтарылды",
+		LangEN: "calendar link revoked",
+	},
+	MsgCalendarReservation: {
+		LangRU: "Бронирование, гостей: %d",
+		LangKZ: "Брондау, қонақтар: %d",
+		LangEN: "Reservation, party of %d",
+	},
+	MsgCalendarTables: {
+		LangRU: "Столы: %s",
+		LangKZ: "Үстелдер: %s",
+		LangEN: "Tables: %s",
+	},
+	MsgCalendarSections: {
+		LangRU: "Секции: %s",
+		LangKZ: "Секциялар: %s",
+		LangEN: "Sections: %s",
+	},
+	MsgCalendarGuests: {
+		LangRU: "Гостей: %d",
+		LangKZ: "Қонақтар: %d",
+		LangEN: "Guests: %d",
+	},
+	MsgCalendarUnpaid: {
+		LangRU: "Ждет оплаты депозита",
+		LangKZ: "Депозит төлемін күтуде",
+		LangEN: "Awaiting deposit payment",
+	},
+}
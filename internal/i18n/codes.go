@@ -0,0 +1,352 @@
+package i18n
+
+// Общие ошибки и ошибки запроса.
+const (
+	CodeInternal         Code = "internal_error"
+	CodeForbidden        Code = "forbidden"
+	CodeUnauthorized     Code = "unauthorized"
+	CodeInvalidToken     Code = "invalid_token"
+	CodeInvalidAPIKey    Code = "invalid_api_key"
+	CodeAPIKeyScope      Code = "api_key_scope_denied"
+	CodeInvalidRequest   Code = "invalid_request"
+	CodeRequestReadError Code = "request_read_failed"
+	CodeRouteNotFound    Code = "route_not_found"
+	CodeMethodNotAllowed Code = "method_not_allowed"
+
+	CodeInvalidUserData        Code = "invalid_user_data"
+	CodeInvalidCityData        Code = "invalid_city_data"
+	CodeInvalidRestaurantData  Code = "invalid_restaurant_data"
+	CodeInvalidSectionData     Code = "invalid_section_data"
+	CodeInvalidTableData       Code = "invalid_table_data"
+	CodeInvalidMenuTypeData    Code = "invalid_menu_type_data"
+	CodeInvalidMenuData        Code = "invalid_menu_data"
+	CodeInvalidEventData       Code = "invalid_event_data"
+	CodeInvalidAssignmentData  Code = "invalid_assignment_data"
+	CodeInvalidAPIKeyData      Code = "invalid_api_key_data"
+	CodeInvalidBookingData     Code = "invalid_booking_data"
+	CodeInvalidReservationData Code = "invalid_reservation_data"
+	CodeInvalidScheduleData    Code = "invalid_schedule_data"
+	CodeInvalidBlackoutData    Code = "invalid_blackout_data"
+	CodeInvalidWaitlistData    Code = "invalid_waitlist_data"
+	CodeInvalidCombinationData Code = "invalid_combination_data"
+	CodeInvalidPaymentData     Code = "invalid_payment_data"
+	CodeInvalidNoShowPolicy    Code = "invalid_no_show_policy"
+	CodeInvalidEventTypeData   Code = "invalid_event_type_data"
+	CodeInvalidPackageData     Code = "invalid_package_data"
+	CodeInvalidEventPricing    Code = "invalid_event_pricing"
+	CodeInvalidInquiryData     Code = "invalid_inquiry_data"
+
+	CodeInvalidUserID        Code = "invalid_user_id"
+	CodeInvalidCityID        Code = "invalid_city_id"
+	CodeInvalidRestaurantID  Code = "invalid_restaurant_id"
+	CodeInvalidSectionID     Code = "invalid_section_id"
+	CodeInvalidTableID       Code = "invalid_table_id"
+	CodeInvalidMenuTypeID    Code = "invalid_menu_type_id"
+	CodeInvalidMenuID        Code = "invalid_menu_id"
+	CodeInvalidEventID       Code = "invalid_event_id"
+	CodeInvalidAPIKeyID      Code = "invalid_api_key_id"
+	CodeInvalidQueryParam    Code = "invalid_query_param"
+	CodeInvalidBookingDate   Code = "invalid_booking_date"
+	CodeInvalidReservationID Code = "invalid_reservation_id"
+	CodeInvalidBlackoutID    Code = "invalid_blackout_id"
+	CodeInvalidDay           Code = "invalid_day"
+	CodeInvalidWaitlistID    Code = "invalid_waitlist_id"
+	CodeInvalidCombinationID Code = "invalid_combination_id"
+	CodeInvalidEventTypeID   Code = "invalid_event_type_id"
+	CodeInvalidPackageID     Code = "invalid_package_id"
+	CodeInvalidInquiryID     Code = "invalid_inquiry_id"
+)
+
+// Ошибки авторизации.
+const (
+	CodeNotAuthenticated      Code = "not_authenticated"
+	CodeOTPPhoneAndCode       Code = "otp_phone_and_code_required"
+	CodeOTPNotRequested       Code = "otp_not_requested"
+	CodeOTPNotFound           Code = "otp_not_found"
+	CodeOTPResendTooEarly     Code = "otp_resend_too_early"
+	CodeOTPExpired            Code = "otp_expired"
+	CodeOTPAttemptsExceeded   Code = "otp_attempts_exceeded"
+	CodeOTPInvalid            Code = "otp_invalid"
+	CodeSMSSendFailed         Code = "sms_send_failed"
+	CodeUserBlocked           Code = "user_blocked"
+	CodeUserBlockedOrDeleted  Code = "user_blocked_or_deleted"
+	CodeRefreshTokenInvalid   Code = "refresh_token_invalid"
+	CodeRefreshTokenReused    Code = "refresh_token_reused"
+	CodeSessionExpired        Code = "session_expired"
+	CodeSessionEnded          Code = "session_ended"
+	CodeSessionNotFound       Code = "session_not_found"
+	CodeAPIKeyNotValid        Code = "api_key_not_valid"
+	CodeAPIKeyRevoked         Code = "api_key_revoked"
+	CodeSessionsRevokeFailed  Code = "sessions_revoke_failed"
+	CodeManagerAssignFailed   Code = "manager_assign_failed"
+	CodeAPIKeyNameRequired    Code = "api_key_name_required"
+	CodeAPIKeyScopesRequired  Code = "api_key_scopes_required"
+	CodeAPIKeyScopeUnknown    Code = "api_key_scope_unknown"
+	CodeAPIKeyNotFound        Code = "api_key_not_found"
+	CodeAPIKeyIDNotFound      Code = "api_key_id_not_found"
+	CodeAPIKeyNotFoundRevoked Code = "api_key_not_found_or_revoked"
+	CodeCalendarTokenInvalid  Code = "calendar_token_invalid"
+	CodeCalendarFeedNotFound  Code = "calendar_feed_not_found"
+)
+
+// Ошибки пользователей и сотрудников.
+const (
+	CodeUserNotFound             Code = "user_not_found"
+	CodeUserPhoneNotFound        Code = "user_phone_not_found"
+	CodeUserNotExists            Code = "user_not_exists"
+	CodeUserPhoneExists          Code = "user_phone_exists"
+	CodeUserPhoneTaken           Code = "user_phone_taken"
+	CodeUserDeleted              Code = "user_deleted"
+	CodeUserNotFoundOrAnonymized Code = "user_not_found_or_anonymized"
+	CodeDeletedUserNotFound      Code = "deleted_user_not_found"
+	CodePhoneRequired            Code = "phone_required"
+	CodePhoneInvalid             Code = "phone_invalid"
+	CodeUserNameRequired         Code = "user_name_required"
+	CodeUserRoleUnknown          Code = "user_role_unknown"
+	CodeUserIDRequired           Code = "user_id_required"
+	CodeStaffRoleInvalid         Code = "staff_role_invalid"
+	CodeStaffNotFound            Code = "staff_not_found"
+	CodeUserOrRestaurantNotExist Code = "user_or_restaurant_not_exists"
+)
+
+// Ошибки справочников, ресторанов, секций, столиков, меню и событий.
+const (
+	CodeCityNotFound         Code = "city_not_found"
+	CodeCityNameNotFound     Code = "city_name_not_found"
+	CodeCityNotExists        Code = "city_not_exists"
+	CodeCityExists           Code = "city_exists"
+	CodeCityNameRequired     Code = "city_name_required"
+	CodeCityNameTooShort     Code = "city_name_too_short"
+	CodeCityIDRequired       Code = "city_id_required"
+	CodeTimeZoneUnknown      Code = "time_zone_unknown"
+	CodeCityInUse            Code = "city_in_use"
+	CodeMenuTypeNotFound     Code = "menu_type_not_found"
+	CodeMenuTypeExists       Code = "menu_type_exists"
+	CodeMenuTypeNameReq      Code = "menu_type_name_required"
+	CodeMenuTypeNameShort    Code = "menu_type_name_too_short"
+	CodeRestaurantNotFound   Code = "restaurant_not_found"
+	CodeRestaurantNotExist   Code = "restaurant_not_exists"
+	CodeRestaurantIDReq      Code = "restaurant_id_required"
+	CodeRestaurantNameReq    Code = "restaurant_name_required"
+	CodeRestaurantNameLen    Code = "restaurant_name_too_short"
+	CodeRestaurantAddress    Code = "restaurant_address_required"
+	CodeSectionNotFound      Code = "section_not_found"
+	CodeSectionNotExists     Code = "section_not_exists"
+	CodeSectionNameReq       Code = "section_name_required"
+	CodeSectionNameTaken     Code = "section_name_taken"
+	CodeSectionIDRequired    Code = "section_id_required"
+	CodeTableNotFound        Code = "table_not_found"
+	CodeTableNumberInvalid   Code = "table_number_invalid"
+	CodeTableNumberTaken     Code = "table_number_taken"
+	CodeTableSeatsInvalid    Code = "table_seats_invalid"
+	CodeTableShapeUnknown    Code = "table_shape_unknown"
+	CodeTableTagUnknown      Code = "table_tag_unknown"
+	CodeTableNotInSection    Code = "table_not_in_section"
+	CodeCombinationNotFound  Code = "combination_not_found"
+	CodeCombinationNotExists Code = "combination_not_exists"
+	CodeCombinationTables    Code = "combination_tables_invalid"
+	CodeCombinationSeats     Code = "combination_seats_invalid"
+	CodeCombinationInUse     Code = "combination_in_use"
+	CodeCombinationOutside   Code = "combination_not_in_restaurant"
+	CodeMenuNotFound         Code = "menu_not_found"
+	CodeMenuNameRURequired   Code = "menu_name_ru_required"
+	CodeEventNotFound        Code = "event_not_found"
+	CodeEventNameRequired    Code = "event_name_required"
+	CodeEventTypeUnknown     Code = "event_type_unknown"
+	CodeEventTypeInactive    Code = "event_type_inactive"
+	CodeEventTypeNotFound    Code = "event_type_not_found"
+	CodeEventTypeExists      Code = "event_type_exists"
+	CodeEventTypeInUse       Code = "event_type_in_use"
+	CodeEventTypeCodeInvalid Code = "event_type_code_invalid"
+	CodeEventTypeNameReq     Code = "event_type_name_required"
+	CodeEventPriceNegative   Code = "event_price_negative"
+	CodeEventNotExists       Code = "event_not_exists"
+	CodeTableNotExists       Code = "table_not_exists"
+	CodeEventOrTableNotExist Code = "event_or_table_not_exists"
+	CodeBookingDateRequired  Code = "booking_date_required"
+	CodeBookingDateInPast    Code = "booking_date_in_past"
+	CodeTableAlreadyBooked   Code = "table_already_booked"
+	CodeBookingNotFound      Code = "booking_not_found"
+	CodeTableNotInRestaurant Code = "table_not_in_restaurant"
+	CodeSectionOutside       Code = "section_not_in_restaurant"
+	CodeSectionHasNoTables   Code = "section_has_no_tables"
+)
+
+// Ошибки бронирований столов.
+const (
+	CodeReservationNotFound       Code = "reservation_not_found"
+	CodeReservationRefsNotExist   Code = "reservation_refs_not_exist"
+	CodePartySizeInvalid          Code = "party_size_invalid"
+	CodeReservationStartRequired  Code = "reservation_start_required"
+	CodeReservationStartInPast    Code = "reservation_start_in_past"
+	CodeReservationDurationBounds Code = "reservation_duration_out_of_bounds"
+	CodeSpecialRequestsTooLong    Code = "special_requests_too_long"
+	CodeTableNotAvailable         Code = "table_not_available"
+	CodeReservationNotEditable    Code = "reservation_not_editable"
+	CodeReservationStatusUnknown  Code = "reservation_status_unknown"
+	CodeReservationTransition     Code = "reservation_transition_not_allowed"
+	CodeReservationChanged        Code = "reservation_status_changed"
+	CodeNoTableAvailable          Code = "no_table_available"
+	CodePartyExceedsTable         Code = "party_exceeds_table"
+	CodePartyExceedsCombination   Code = "party_exceeds_combination"
+	CodeWaitlistEntryNotFound     Code = "waitlist_entry_not_found"
+	CodeWaitlistEntryClosed       Code = "waitlist_entry_closed"
+	CodeWaitlistNoteTooLong       Code = "waitlist_note_too_long"
+)
+
+// Ошибки учета неявок.
+const (
+	CodeNoShowTooEarly         Code = "no_show_too_early"
+	CodeNoShowNotForgivable    Code = "no_show_not_forgivable"
+	CodeGuestBookingBlocked    Code = "guest_booking_blocked"
+	CodeNoShowThresholdInvalid Code = "no_show_threshold_invalid"
+	CodeNoShowDepositAmount    Code = "no_show_deposit_amount_required"
+)
+
+// Ошибки депозитов и платежей.
+const (
+	CodeDepositTypeUnknown  Code = "deposit_type_unknown"
+	CodeDepositValueInvalid Code = "deposit_value_invalid"
+	CodeEventGuestsInvalid  Code = "event_guests_invalid"
+	CodeEventGuestsRequired Code = "event_guests_required"
+	CodeDepositNotFound     Code = "deposit_not_found"
+	CodeDepositNotPending   Code = "deposit_not_pending"
+	CodePaymentSignature    Code = "payment_signature_invalid"
+	CodePaymentAmount       Code = "payment_amount_mismatch"
+	CodePaymentUnavailable  Code = "payment_unavailable"
+)
+
+// Ошибки пакетов банкетов и расчета стоимости.
+const (
+	CodePackageNotFound       Code = "package_not_found"
+	CodePackageExists         Code = "package_exists"
+	CodePackageNameRequired   Code = "package_name_required"
+	CodePackageTierUnknown    Code = "package_tier_unknown"
+	CodePackagePriceNegative  Code = "package_price_negative"
+	CodePackageMinGuests      Code = "package_min_guests_invalid"
+	CodePackageMaxGuests      Code = "package_max_guests_invalid"
+	CodePackageInactive       Code = "package_inactive"
+	CodeQuoteGuestsRequired   Code = "quote_guests_required"
+	CodeQuoteGuestsBelowMin   Code = "quote_guests_below_min"
+	CodeQuoteGuestsAboveMax   Code = "quote_guests_above_max"
+	CodeQuoteDateRequired     Code = "quote_date_required"
+	CodeQuoteDateInPast       Code = "quote_date_in_past"
+	CodeWeekendPercentInvalid Code = "weekend_percent_invalid"
+)
+
+// Ошибки заявок на банкеты.
+const (
+	CodeInquiryNotFound         Code = "inquiry_not_found"
+	CodeInquiryClosed           Code = "inquiry_closed"
+	CodeInquiryStatusChanged    Code = "inquiry_status_changed"
+	CodeInquiryStatusUnknown    Code = "inquiry_status_unknown"
+	CodeInquiryTransition       Code = "inquiry_transition_not_allowed"
+	CodeInquiryConfirmByConvert Code = "inquiry_confirm_by_conversion"
+	CodeInquiryNotConvertible   Code = "inquiry_not_convertible"
+	CodeInquiryDepositByPayment Code = "inquiry_deposit_by_payment"
+	CodeInquiryPaymentPending   Code = "inquiry_payment_pending"
+	CodeInquiryDateRequired     Code = "inquiry_date_required"
+	CodeInquiryDateInPast       Code = "inquiry_date_in_past"
+	CodeInquiryGuestsInvalid    Code = "inquiry_guests_invalid"
+	CodeInquiryContactTooLong   Code = "inquiry_contact_name_too_long"
+	CodeInquiryNoteRequired     Code = "inquiry_note_required"
+	CodeInquiryNoteTooLong      Code = "inquiry_note_too_long"
+	CodeInquiryAssigneeInvalid  Code = "inquiry_assignee_not_manager"
+	CodeInquiryTablesOrSection  Code = "inquiry_tables_or_section_required"
+	CodeInquiryEventOutside     Code = "inquiry_event_not_in_restaurant"
+)
+
+// Ошибки расписания ресторанов.
+const (
+	CodeWeekdayInvalid      Code = "weekday_invalid"
+	CodeTimeOfDayInvalid    Code = "time_of_day_invalid"
+	CodeOpeningHoursOverlap Code = "opening_hours_overlap"
+	CodeSpecialDayNotFound  Code = "special_day_not_found"
+	CodeBlackoutNotFound    Code = "blackout_not_found"
+	CodeBlackoutPeriod      Code = "blackout_period_invalid"
+	CodeRestaurantClosed    Code = "restaurant_closed"
+	CodeRestaurantClosedOn  Code = "restaurant_closed_on_day"
+	CodeSectionBlackedOut   Code = "section_blacked_out"
+)
+
+// Сообщения об успешных операциях.
+const (
+	MsgOTPSent                  Code = "otp_sent"
+	MsgSessionEnded             Code = "session_logged_out"
+	MsgAllSessionsEnded         Code = "all_sessions_logged_out"
+	MsgUserCreated              Code = "user_created"
+	MsgUserUpdated              Code = "user_updated"
+	MsgUserDeleted              Code = "user_deleted_successfully"
+	MsgUserRestored             Code = "user_restored"
+	MsgUserAnonymized           Code = "user_anonymized"
+	MsgCityCreated              Code = "city_created"
+	MsgCityUpdated              Code = "city_updated"
+	MsgCityDeleted              Code = "city_deleted"
+	MsgRestaurantCreated        Code = "restaurant_created"
+	MsgRestaurantUpdated        Code = "restaurant_updated"
+	MsgRestaurantDeleted        Code = "restaurant_deleted"
+	MsgSectionCreated           Code = "section_created"
+	MsgSectionUpdated           Code = "section_updated"
+	MsgSectionDeleted           Code = "section_deleted"
+	MsgTableCreated             Code = "table_created"
+	MsgTableUpdated             Code = "table_updated"
+	MsgTableDeleted             Code = "table_deleted"
+	MsgCombinationCreated       Code = "combination_created"
+	MsgCombinationUpdated       Code = "combination_updated"
+	MsgCombinationDeleted       Code = "combination_deleted"
+	MsgQRGenerated              Code = "qr_generated"
+	MsgMenuTypeCreated          Code = "menu_type_created"
+	MsgMenuTypeUpdated          Code = "menu_type_updated"
+	MsgMenuTypeDeleted          Code = "menu_type_deleted"
+	MsgEventTypeCreated         Code = "event_type_created"
+	MsgEventTypeUpdated         Code = "event_type_updated"
+	MsgEventTypeDeleted         Code = "event_type_deleted"
+	MsgMenuCreated              Code = "menu_created"
+	MsgMenuUpdated              Code = "menu_updated"
+	MsgMenuDeleted              Code = "menu_deleted"
+	MsgEventCreated             Code = "event_created"
+	MsgEventUpdated             Code = "event_updated"
+	MsgEventDeleted             Code = "event_deleted"
+	MsgPackageCreated           Code = "package_created"
+	MsgPackageUpdated           Code = "package_updated"
+	MsgPackageDeleted           Code = "package_deleted"
+	MsgEventPricingUpdated      Code = "event_pricing_updated"
+	MsgInquiryCreated           Code = "inquiry_created"
+	MsgInquiryUpdated           Code = "inquiry_updated"
+	MsgInquiryStatus            Code = "inquiry_status_updated"
+	MsgInquiryAssigned          Code = "inquiry_assigned"
+	MsgInquiryNoteAdded         Code = "inquiry_note_added"
+	MsgInquiryConverted         Code = "inquiry_converted"
+	MsgInquiryAwaitingPayment   Code = "inquiry_awaiting_payment"
+	MsgTableBooked              Code = "table_booked"
+	MsgBookingCancelled         Code = "booking_cancelled"
+	MsgBookingAwaitsPayment     Code = "booking_awaits_payment"
+	MsgPaymentAccepted          Code = "payment_accepted"
+	MsgReservationCreated       Code = "reservation_created"
+	MsgReservationUpdated       Code = "reservation_updated"
+	MsgReservationCancelled     Code = "reservation_cancelled"
+	MsgReservationStatus        Code = "reservation_status_updated"
+	MsgReservationAwaitsPayment Code = "reservation_awaits_payment"
+	MsgNoShowMarked             Code = "no_show_marked"
+	MsgNoShowForgiven           Code = "no_show_forgiven"
+	MsgNoShowPolicyUpdated      Code = "no_show_policy_updated"
+	MsgOpeningHoursUpdated      Code = "opening_hours_updated"
+	MsgSpecialDaySaved          Code = "special_day_saved"
+	MsgSpecialDayDeleted        Code = "special_day_deleted"
+	MsgBlackoutCreated          Code = "blackout_created"
+	MsgBlackoutDeleted          Code = "blackout_deleted"
+	MsgWaitlistNotified         Code = "waitlist_notified"
+	MsgWaitlistSeated           Code = "waitlist_seated"
+	MsgWaitlistCancelled        Code = "waitlist_cancelled"
+	MsgStaffAssigned            Code = "staff_assigned"
+	MsgStaffRemoved             Code = "staff_removed"
+	MsgAPIKeyRevoked            Code = "api_key_revoked_successfully"
+	MsgOTPSMS                   Code = "otp_sms_text"
+	MsgTableReadySMS            Code = "table_ready_sms_text"
+	MsgCalendarFeedRevoked      Code = "calendar_feed_revoked"
+	MsgCalendarReservation      Code = "calendar_reservation_text"
+	MsgCalendarTables           Code = "calendar_tables_text"
+	MsgCalendarSections         Code = "calendar_sections_text"
+	MsgCalendarGuests           Code = "calendar_guests_text"
+	MsgCalendarUnpaid           Code = "calendar_unpaid_text"
+)
@@ -0,0 +1,172 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Lang string
+
+const (
+	LangRU Lang = "ru"
+	LangKZ Lang = "kz"
+	LangEN Lang = "en"
+
+	DefaultLang = LangRU
+)
+
+// Code — стабильный машинный код сообщения, по которому клиенты могут
+// обрабатывать ошибки независимо от языка ответа.
+type Code string
+
+// Error — ошибка с кодом из каталога сообщений. Метод Error возвращает
+// текст на языке по умолчанию, поэтому в логах ошибки выглядят как раньше.
+type Error struct {
+	Code Code
+	Args []interface{}
+	Err  error
+}
+
+func New(code Code, args ...interface{}) error {
+	return &Error{Code: code, Args: args}
+}
+
+// Wrap добавляет к ошибке err код, описывающий ее для клиента. Текст err
+// остается в Error() для логов, но не попадает в локализованный ответ.
+func Wrap(err error, code Code, args ...interface{}) error {
+	return &Error{Code: code, Args: args, Err: err}
+}
+
+func (e *Error) Error() string {
+	msg := Translate(DefaultLang, e.Code, e.Args...)
+	if e.Err != nil {
+		return msg + ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// CodeOf возвращает код ближайшей к вершине цепочки ошибки из каталога или
+// CodeInternal, если такой ошибки в цепочке нет.
+func CodeOf(err error) Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return CodeInternal
+}
+
+// Localize возвращает код ошибки и ее текст на языке lang. Ошибки без кода
+// считаются внутренними: их текст клиенту не показывается.
+func Localize(err error, lang Lang) (Code, string) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code, Translate(lang, e.Code, e.Args...)
+	}
+	return CodeInternal, Translate(lang, CodeInternal)
+}
+
+func Translate(lang Lang, code Code, args ...interface{}) string {
+	translations, ok := catalog[code]
+	if !ok {
+		return string(code)
+	}
+
+	format, ok := translations[lang]
+	if !ok {
+		format = translations[DefaultLang]
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// ParseLang приводит код языка к одному из поддерживаемых. Принимает как
+// значения users.language ("kz"), так и теги из заголовков ("kk-KZ", "en-US").
+func ParseLang(value string) (Lang, bool) {
+	tag := strings.ToLower(strings.TrimSpace(value))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+
+	switch tag {
+	case "ru":
+		return LangRU, true
+	case "kz", "kk":
+		return LangKZ, true
+	case "en":
+		return LangEN, true
+	}
+	return "", false
+}
+
+// FromAcceptLanguage выбирает поддерживаемый язык с наибольшим весом из
+// заголовка Accept-Language.
+func FromAcceptLanguage(header string) (Lang, bool) {
+	type candidate struct {
+		lang   Lang
+		weight float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		lang, ok := ParseLang(tag)
+		if !ok {
+			continue
+		}
+
+		weight := 1.0
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				weight = parsed
+			}
+		}
+		if weight > 0 {
+			candidates = append(candidates, candidate{lang: lang, weight: weight})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].weight > candidates[j].weight
+	})
+	return candidates[0].lang, true
+}
+
+// Resolve определяет язык ответа: сначала Accept-Language, затем язык из
+// профиля пользователя, иначе язык по умолчанию.
+func Resolve(acceptLanguage, userLanguage string) Lang {
+	if lang, ok := FromAcceptLanguage(acceptLanguage); ok {
+		return lang
+	}
+	if lang, ok := ParseLang(userLanguage); ok {
+		return lang
+	}
+	return DefaultLang
+}
+
+type langKey struct{}
+
+func WithLang(ctx context.Context, lang Lang) context.Context {
+	return context.WithValue(ctx, langKey{}, lang)
+}
+
+func LangFromContext(ctx context.Context) Lang {
+	if lang, ok := ctx.Value(langKey{}).(Lang); ok {
+		return lang
+	}
+	return DefaultLang
+}
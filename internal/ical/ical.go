@@ -0,0 +1,244 @@
+// Package ical формирует календари в формате iCalendar (RFC 5545): ленту
+// бронирований ресторана для подписки и файлы отдельных бронирований.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	prodID = "-//restaurant-management//calendar//RU"
+	// maxLineOctets — длина строки, после которой ее нужно перенести.
+	maxLineOctets = 75
+
+	dateLayout     = "20060102"
+	localLayout    = "20060102T150405"
+	utcLayout      = "20060102T150405Z"
+	transitionStep = 24 * time.Hour
+)
+
+type Status string
+
+const (
+	StatusTentative Status = "TENTATIVE"
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// Event — событие календаря. У события на весь день (AllDay) учитываются
+// только даты Start и End, End — следующий день после последнего.
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Summary     string
+	Description string
+	Location    string
+	Status      Status
+	Modified    time.Time
+}
+
+// Calendar — календарь в часовом поясе Location. Время событий с часами
+// записывается в этом поясе, а его правила перехода на летнее время —
+// блоком VTIMEZONE на промежуток, который занимают события.
+type Calendar struct {
+	Name     string
+	Location *time.Location
+	Events   []Event
+}
+
+// Encode возвращает календарь в формате iCalendar.
+func Encode(cal *Calendar) []byte {
+	w := &writer{}
+	loc := cal.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + prodID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if cal.Name != "" {
+		w.line("X-WR-CALNAME:" + escape(cal.Name))
+	}
+	w.line("X-WR-TIMEZONE:" + loc.String())
+
+	if from, to, ok := timedSpan(cal.Events); ok {
+		writeTimeZone(w, loc, from, to)
+	}
+
+	now := time.Now()
+	for _, event := range cal.Events {
+		writeEvent(w, loc, event, now)
+	}
+
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes()
+}
+
+func writeEvent(w *writer, loc *time.Location, event Event, now time.Time) {
+	stamp := event.Modified
+	if stamp.IsZero() {
+		stamp = now
+	}
+
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + escape(event.UID))
+	w.line("DTSTAMP:" + stamp.UTC().Format(utcLayout))
+	if !event.Modified.IsZero() {
+		w.line("LAST-MODIFIED:" + event.Modified.UTC().Format(utcLayout))
+	}
+
+	if event.AllDay {
+		w.line("DTSTART;VALUE=DATE:" + event.Start.Format(dateLayout))
+		w.line("DTEND;VALUE=DATE:" + event.End.Format(dateLayout))
+	} else {
+		tzid := ";TZID=" + loc.String() + ":"
+		w.line("DTSTART" + tzid + event.Start.In(loc).Format(localLayout))
+		w.line("DTEND" + tzid + event.End.In(loc).Format(localLayout))
+	}
+
+	w.line("SUMMARY:" + escape(event.Summary))
+	if event.Description != "" {
+		w.line("DESCRIPTION:" + escape(event.Description))
+	}
+	if event.Location != "" {
+		w.line("LOCATION:" + escape(event.Location))
+	}
+	if event.Status != "" {
+		w.line("STATUS:" + string(event.Status))
+	}
+	if event.AllDay {
+		w.line("TRANSP:TRANSPARENT")
+	}
+	w.line("END:VEVENT")
+}
+
+// writeTimeZone описывает пояс loc на промежутке [from, to]: правило,
+// действующее в from, и каждый переход внутри промежутка.
+func writeTimeZone(w *writer, loc *time.Location, from, to time.Time) {
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + loc.String())
+
+	_, offset := from.In(loc).Zone()
+	writeObservance(w, from.In(loc), offset)
+
+	for t := from; ; {
+		next, ok := nextTransition(loc, t, to)
+		if !ok {
+			break
+		}
+		writeObservance(w, next.In(loc), offset)
+		_, offset = next.In(loc).Zone()
+		t = next
+	}
+
+	w.line("END:VTIMEZONE")
+}
+
+// writeObservance записывает правило, которое действует с момента start.
+// DTSTART по RFC 5545 — местное время по прежнему смещению fromOffset.
+func writeObservance(w *writer, start time.Time, fromOffset int) {
+	name, offset := start.Zone()
+	component := "STANDARD"
+	if start.IsDST() {
+		component = "DAYLIGHT"
+	}
+
+	w.line("BEGIN:" + component)
+	w.line("DTSTART:" + start.UTC().Add(time.Duration(fromOffset)*time.Second).Format(localLayout))
+	w.line("TZOFFSETFROM:" + formatOffset(fromOffset))
+	w.line("TZOFFSETTO:" + formatOffset(offset))
+	w.line("TZNAME:" + escape(name))
+	w.line("END:" + component)
+}
+
+// nextTransition ищет первую смену смещения пояса после after и не позже
+// until: идет по суткам, а найденные сутки делит пополам до секунды.
+func nextTransition(loc *time.Location, after, until time.Time) (time.Time, bool) {
+	_, offset := after.In(loc).Zone()
+
+	prev := after
+	for prev.Before(until) {
+		next := prev.Add(transitionStep)
+		if _, o := next.In(loc).Zone(); o != offset {
+			lo, hi := prev.Unix(), next.Unix()
+			for hi-lo > 1 {
+				mid := lo + (hi-lo)/2
+				if _, o := time.Unix(mid, 0).In(loc).Zone(); o == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			return time.Unix(hi, 0), true
+		}
+		prev = next
+	}
+
+	return time.Time{}, false
+}
+
+func timedSpan(events []Event) (time.Time, time.Time, bool) {
+	var from, to time.Time
+	found := false
+	for _, event := range events {
+		if event.AllDay {
+			continue
+		}
+		if !found || event.Start.Before(from) {
+			from = event.Start
+		}
+		if !found || event.End.After(to) {
+			to = event.End
+		}
+		found = true
+	}
+	return from, to, found
+}
+
+func formatOffset(seconds int) string {
+	sign := byte('+')
+	if seconds < 0 {
+		sign = '-'
+		seconds = -seconds
+	}
+
+	return fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escape(value string) string {
+	return escaper.Replace(value)
+}
+
+type writer struct {
+	buf bytes.Buffer
+}
+
+// line записывает строку с CRLF, перенося ее каждые 75 октетов. Перенос не
+// разрывает многобайтовые символы.
+func (w *writer) line(value string) {
+	limit := maxLineOctets
+	for len(value) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(value[cut]) {
+			cut--
+		}
+		w.buf.WriteString(value[:cut])
+		w.buf.WriteString("\r\n ")
+		value = value[cut:]
+		// Продолжение начинается с пробела, который тоже занимает октет.
+		limit = maxLineOctets - 1
+	}
+	w.buf.WriteString(value)
+	w.buf.WriteString("\r\n")
+}
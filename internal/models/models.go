@@ -1,19 +1,54 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
+
+type UserRole string
+
+const (
+	RoleAdmin   UserRole = "admin"
+	RoleManager UserRole = "manager"
+	RoleWaiter  UserRole = "waiter"
+	RoleGuest   UserRole = "guest"
+)
 
 type User struct {
-	ID          int64  `json:"id" db:"id"`
-	PhoneNumber string `json:"phone_number" db:"phone_number"`
-	Name        string `json:"name" db:"name"`
-	LastName    string `json:"last_name" db:"last_name"`
-	Language    string `json:"language" db:"language"`
-	IsActive    bool   `json:"is_active" db:"is_active"`
+	ID           int64      `json:"id" db:"id"`
+	PhoneNumber  string     `json:"phone_number" db:"phone_number"`
+	Name         string     `json:"name" db:"name"`
+	LastName     string     `json:"last_name" db:"last_name"`
+	Language     string     `json:"language" db:"language"`
+	IsActive     bool       `json:"is_active" db:"is_active"`
+	Role         UserRole   `json:"role" db:"role"`
+	NoShowCount  int        `json:"no_show_count" db:"no_show_count"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	AnonymizedAt *time.Time `json:"anonymized_at,omitempty" db:"anonymized_at"`
+}
+
+// UserExport содержит все данные, которые сервис хранит о пользователе.
+type UserExport struct {
+	User             *User              `json:"user"`
+	StaffAssignments []*StaffAssignment `json:"staff_assignments"`
+	Sessions         []*Session         `json:"sessions"`
+	Reservations     []*Reservation     `json:"reservations"`
+	WaitlistEntries  []*WaitlistEntry   `json:"waitlist_entries"`
+	Deposits         []*EventDeposit    `json:"deposits"`
+	ExportedAt       time.Time          `json:"exported_at"`
+}
+
+type StaffAssignment struct {
+	UserID       int64    `json:"user_id" db:"user_id"`
+	RestaurantID int64    `json:"restaurant_id" db:"restaurant_id"`
+	Role         UserRole `json:"role" db:"role"`
 }
 
 type City struct {
 	ID   int64  `json:"id" db:"id"`
 	Name string `json:"name" db:"name"`
+	// TimeZone — часовой пояс IANA, например Asia/Almaty или Asia/Aqtau.
+	TimeZone string `json:"time_zone" db:"time_zone"`
 }
 
 type Restaurant struct {
@@ -24,19 +59,140 @@ type Restaurant struct {
 	AddressKZ string `json:"address_kz" db:"address_kz"`
 	IsActive  bool   `json:"is_active" db:"is_active"`
 	Map2GIS   string `json:"_2gis_map" db:"_2gis_map"`
+	// TimeZone переопределяет часовой пояс города. Пустая строка — пояс города.
+	TimeZone string `json:"time_zone" db:"time_zone"`
+	// OpenNow заполняется только при получении одного ресторана.
+	OpenNow *bool `json:"open_now,omitempty" db:"-"`
+}
+
+// TimeInterval — интервал работы в формате ЧЧ:ММ. Если ClosesAt не позже
+// OpensAt, интервал заканчивается на следующий день.
+type TimeInterval struct {
+	OpensAt  string `json:"opens_at"`
+	ClosesAt string `json:"closes_at"`
+}
+
+// OpeningInterval — интервал недельного расписания. Weekday: 0 — воскресенье.
+type OpeningInterval struct {
+	Weekday time.Weekday `json:"weekday" swaggertype:"integer"`
+	TimeInterval
+}
+
+// SpecialDay заменяет недельное расписание на дату (ГГГГ-ММ-ДД): праздник,
+// закрытое мероприятие, сокращенный день. Без интервалов ресторан закрыт
+// весь день.
+type SpecialDay struct {
+	RestaurantID int64          `json:"restaurant_id"`
+	Day          string         `json:"day"`
+	Intervals    []TimeInterval `json:"intervals"`
+	Note         string         `json:"note"`
+}
+
+// SectionBlackout — промежуток, когда секцию нельзя бронировать.
+type SectionBlackout struct {
+	ID        int64     `json:"id" db:"id"`
+	SectionID int64     `json:"section_id" db:"section_id"`
+	StartsAt  time.Time `json:"starts_at" db:"starts_at"`
+	EndsAt    time.Time `json:"ends_at" db:"ends_at"`
+	Reason    string    `json:"reason" db:"reason"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
 }
 
 type Section struct {
-	ID           int64  `json:"id" db:"id"`
-	RestaurantID int64  `json:"restaurant_id" db:"restaurant_id"`
-	Name         string `json:"name" db:"name"`
+	ID           int64    `json:"id" db:"id"`
+	RestaurantID int64    `json:"restaurant_id" db:"restaurant_id"`
+	Name         string   `json:"name" db:"name"`
+	Tags         []string `json:"tags" db:"tags"`
 }
 
+type TableShape string
+
+const (
+	TableShapeRound     TableShape = "round"
+	TableShapeSquare    TableShape = "square"
+	TableShapeRectangle TableShape = "rectangle"
+	TableShapeBooth     TableShape = "booth"
+	TableShapeBar       TableShape = "bar"
+)
+
+// Теги столов и секций. Тег секции распространяется на все ее столы.
+const (
+	TableTagWindow       = "window"
+	TableTagVIP          = "vip"
+	TableTagSmoking      = "smoking"
+	TableTagKidsFriendly = "kids_friendly"
+	TableTagWheelchair   = "wheelchair"
+)
+
 type Table struct {
-	ID            int64  `json:"id" db:"id"`
-	NumberOfTable int    `json:"number_of_table" db:"number_of_table"`
-	SectionID     int64  `json:"section_id" db:"section_id"`
-	QR            string `json:"qr" db:"qr"`
+	ID            int64      `json:"id" db:"id"`
+	NumberOfTable int        `json:"number_of_table" db:"number_of_table"`
+	SectionID     int64      `json:"section_id" db:"section_id"`
+	QR            string     `json:"qr" db:"qr"`
+	MinSeats      int        `json:"min_seats" db:"min_seats"`
+	MaxSeats      int        `json:"max_seats" db:"max_seats"`
+	Shape         TableShape `json:"shape" db:"shape"`
+	Tags          []string   `json:"tags" db:"tags"`
+}
+
+// RestaurantTable — стол вместе с секцией, к которой он относится. Теги
+// секции уже добавлены в Tags.
+type RestaurantTable struct {
+	Table
+	RestaurantID int64  `json:"restaurant_id"`
+	SectionName  string `json:"section_name"`
+}
+
+// TableCombination — столы одной секции, которые можно сдвинуть для большой
+// компании. MinSeats и MaxSeats задают вместимость сочетания целиком.
+type TableCombination struct {
+	ID        int64   `json:"id" db:"id"`
+	SectionID int64   `json:"section_id" db:"section_id"`
+	Name      string  `json:"name" db:"name"`
+	TableIDs  []int64 `json:"table_ids"`
+	MinSeats  int     `json:"min_seats" db:"min_seats"`
+	MaxSeats  int     `json:"max_seats" db:"max_seats"`
+}
+
+// AssignmentRequest описывает, какой стол нужен гостям.
+type AssignmentRequest struct {
+	RestaurantID    int64      `json:"restaurant_id"`
+	PartySize       int        `json:"party_size"`
+	StartTime       time.Time  `json:"start_time"`
+	DurationMinutes int        `json:"duration_minutes"`
+	SectionID       int64      `json:"section_id,omitempty"`
+	Shape           TableShape `json:"shape,omitempty"`
+	Tags            []string   `json:"tags,omitempty"`
+}
+
+// AvailabilityRequest — поиск свободного времени на дату для компании гостей.
+type AvailabilityRequest struct {
+	RestaurantID    int64
+	Date            time.Time
+	PartySize       int
+	DurationMinutes int
+	SectionID       int64
+}
+
+// AvailabilitySlot — время, на которое можно забронировать один из столов
+// Tables или одно из сочетаний Combinations. Столы упорядочены от наиболее
+// подходящего, сочетания — по вместимости.
+type AvailabilitySlot struct {
+	StartTime    time.Time           `json:"start_time"`
+	EndTime      time.Time           `json:"end_time"`
+	Tables       []*RestaurantTable  `json:"tables"`
+	Combinations []*TableCombination `json:"combinations,omitempty"`
+}
+
+// TableAssignment — выбранный стол или, если ни один стол не вмещает
+// гостей, несколько столов одной секции. CombinationID заполнен, если столы
+// образуют настроенное сочетание: только такой набор можно забронировать.
+type TableAssignment struct {
+	Tables        []*RestaurantTable `json:"tables"`
+	SectionID     int64              `json:"section_id"`
+	Capacity      int                `json:"capacity"`
+	Combined      bool               `json:"combined"`
+	CombinationID *int64             `json:"combination_id,omitempty"`
 }
 
 type MenuType struct {
@@ -53,25 +209,569 @@ type Menu struct {
 	Img          string `json:"img" db:"img"`
 }
 
-type EventType string
+// EventType — тип события из справочника. Code хранится в событиях
+// ресторанов и не меняется; неактивный тип нельзя выбрать для нового
+// события.
+type EventType struct {
+	ID        int64     `json:"id" db:"id"`
+	Code      string    `json:"code" db:"code"`
+	NameRU    string    `json:"name_ru" db:"name_ru"`
+	NameKZ    string    `json:"name_kz" db:"name_kz"`
+	Icon      string    `json:"icon" db:"icon"`
+	IsActive  bool      `json:"is_active" db:"is_active"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// DepositType задает, как считается депозит за бронирование под событие.
+type DepositType string
 
 const (
-	EventTypeWedding   EventType = "wedding"
-	EventTypeBirthday  EventType = "birthday"
-	EventTypeCorporate EventType = "corporate"
+	DepositNone    DepositType = "none"
+	DepositFixed   DepositType = "fixed"
+	DepositPercent DepositType = "percent"
 )
 
+// RestaurantEvent — событие ресторана. Price — цена на одного гостя.
+// DepositValue — сумма депозита для DepositFixed или процент от Price,
+// умноженной на число гостей, для DepositPercent.
 type RestaurantEvent struct {
-	ID          int64     `json:"id" db:"id"`
-	Name        string    `json:"name" db:"name"`
-	EventType   EventType `json:"eventtype" db:"eventtype"`
-	Description string    `json:"desc" db:"desc"`
-	Price       float64   `json:"price" db:"price"`
-	Img         string    `json:"img" db:"img"`
+	ID           int64       `json:"id" db:"id"`
+	RestaurantID int64       `json:"restaurant_id" db:"restaurant_id"`
+	Name         string      `json:"name" db:"name"`
+	EventType    string      `json:"eventtype" db:"eventtype"`
+	Description  string      `json:"desc" db:"desc"`
+	Price        float64     `json:"price" db:"price"`
+	Img          string      `json:"img" db:"img"`
+	DepositType  DepositType `json:"deposit_type" db:"deposit_type"`
+	DepositValue float64     `json:"deposit_value" db:"deposit_value"`
 }
 
+type PackageTier string
+
+const (
+	PackageTierStandard PackageTier = "standard"
+	PackageTierPremium  PackageTier = "premium"
+)
+
+// EventPackage — пакет банкета под событие: цена за гостя, допустимое число
+// гостей и включенные услуги. MaxGuests nil — без верхней границы.
+type EventPackage struct {
+	ID               int64       `json:"id" db:"id"`
+	EventID          int64       `json:"event_id" db:"event_id"`
+	Name             string      `json:"name" db:"name"`
+	Tier             PackageTier `json:"tier" db:"tier"`
+	PricePerGuest    float64     `json:"price_per_guest" db:"price_per_guest"`
+	MinGuests        int         `json:"min_guests" db:"min_guests"`
+	MaxGuests        *int        `json:"max_guests" db:"max_guests"`
+	IncludedServices []string    `json:"included_services" db:"included_services"`
+	IsActive         bool        `json:"is_active" db:"is_active"`
+	CreatedAt        time.Time   `json:"created_at" db:"created_at"`
+}
+
+// EventPricing — надбавка ресторана к банкетам в выходные дни.
+// WeekendDays: 0 — воскресенье.
+type EventPricing struct {
+	RestaurantID   int64          `json:"restaurant_id" db:"restaurant_id"`
+	WeekendDays    []time.Weekday `json:"weekend_days" db:"weekend_days" swaggertype:"array,integer"`
+	WeekendPercent float64        `json:"weekend_percent" db:"weekend_percent"`
+	UpdatedAt      time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// EventQuote — расчет стоимости пакета на число гостей и дату. Ничего не
+// бронирует. Date — ГГГГ-ММ-ДД по календарю ресторана.
+type EventQuote struct {
+	EventID          int64       `json:"event_id"`
+	PackageID        int64       `json:"package_id"`
+	Tier             PackageTier `json:"tier"`
+	Date             string      `json:"date"`
+	Guests           int         `json:"guests"`
+	PricePerGuest    float64     `json:"price_per_guest"`
+	Subtotal         float64     `json:"subtotal"`
+	Weekend          bool        `json:"weekend"`
+	SurchargePercent float64     `json:"surcharge_percent"`
+	Surcharge        float64     `json:"surcharge"`
+	Total            float64     `json:"total"`
+}
+
+type EventBookingStatus string
+
+const (
+	EventBookingPendingPayment EventBookingStatus = "pending_payment"
+	EventBookingConfirmed      EventBookingStatus = "confirmed"
+)
+
+// RestaurantEventTable — стол, занятый событием на весь день. BookingDate —
+// полночь этого дня по времени ресторана. Столы сочетания бронируются
+// вместе и отмечаются общим CombinationID. Бронирование с депозитом ждет
+// оплаты в статусе pending_payment, но уже держит стол.
 type RestaurantEventTable struct {
-	EventID     int64     `json:"event_id" db:"event_id"`
-	TableID     int64     `json:"table_id" db:"table_id"`
-	BookingDate time.Time `json:"booking_date" db:"booking_date"`
+	EventID       int64              `json:"event_id" db:"event_id"`
+	TableID       int64              `json:"table_id" db:"table_id"`
+	BookingDate   time.Time          `json:"booking_date" db:"booking_date"`
+	CombinationID *int64             `json:"combination_id,omitempty" db:"combination_id"`
+	Status        EventBookingStatus `json:"status" db:"status"`
+	DepositID     *int64             `json:"deposit_id,omitempty" db:"deposit_id"`
+}
+
+// CancelledEventBooking — след отмененного бронирования без депозита для
+// ленты календаря.
+type CancelledEventBooking struct {
+	EventID       int64     `json:"event_id"`
+	TableID       int64     `json:"table_id"`
+	BookingDate   time.Time `json:"booking_date"`
+	CombinationID *int64    `json:"combination_id,omitempty"`
+	CancelledAt   time.Time `json:"cancelled_at"`
+}
+
+// EventBookingRequest — запрос на бронирование стола или сочетания под
+// событие. Guests нужно, если депозит считается от числа гостей.
+type EventBookingRequest struct {
+	TableID       int64     `json:"table_id"`
+	CombinationID *int64    `json:"combination_id,omitempty"`
+	BookingDate   time.Time `json:"booking_date"`
+	Guests        int       `json:"guests"`
+}
+
+type DepositStatus string
+
+const (
+	DepositPending   DepositStatus = "pending"
+	DepositPaid      DepositStatus = "paid"
+	DepositExpired   DepositStatus = "expired"
+	DepositCancelled DepositStatus = "cancelled"
+)
+
+// EventDeposit — депозит за бронирование под событие или бронирование
+// стола: заполнено ровно одно из полей EventID и ReservationID. Пока он не
+// оплачен до ExpiresAt, бронирование ждет оплаты; просроченный депозит
+// освобождает столы.
+type EventDeposit struct {
+	ID            int64         `json:"id" db:"id"`
+	EventID       *int64        `json:"event_id,omitempty" db:"event_id"`
+	ReservationID *int64        `json:"reservation_id,omitempty" db:"reservation_id"`
+	BookingDate   time.Time     `json:"booking_date" db:"booking_date"`
+	Guests        int           `json:"guests" db:"guests"`
+	Amount        float64       `json:"amount" db:"amount"`
+	Status        DepositStatus `json:"status" db:"status"`
+	PaymentID     string        `json:"payment_id,omitempty" db:"payment_id"`
+	PaymentURL    string        `json:"payment_url,omitempty" db:"payment_url"`
+	ExpiresAt     time.Time     `json:"expires_at" db:"expires_at"`
+	PaidAt        *time.Time    `json:"paid_at,omitempty" db:"paid_at"`
+	CreatedAt     time.Time     `json:"created_at" db:"created_at"`
+}
+
+type ReservationStatus string
+
+const (
+	ReservationPending   ReservationStatus = "pending"
+	ReservationConfirmed ReservationStatus = "confirmed"
+	ReservationSeated    ReservationStatus = "seated"
+	ReservationCompleted ReservationStatus = "completed"
+	ReservationCancelled ReservationStatus = "cancelled"
+	ReservationNoShow    ReservationStatus = "no_show"
+)
+
+// Reservation — бронирование стола гостем на промежуток времени. При
+// бронировании сочетания TableID — его основной стол, а занимаются все
+// столы сочетания.
+type Reservation struct {
+	ID              int64             `json:"id" db:"id"`
+	RestaurantID    int64             `json:"restaurant_id" db:"restaurant_id"`
+	TableID         int64             `json:"table_id" db:"table_id"`
+	CombinationID   *int64            `json:"combination_id,omitempty" db:"combination_id"`
+	UserID          int64             `json:"user_id" db:"user_id"`
+	PartySize       int               `json:"party_size" db:"party_size"`
+	StartTime       time.Time         `json:"start_time" db:"start_time"`
+	DurationMinutes int               `json:"duration_minutes" db:"duration_minutes"`
+	Status          ReservationStatus `json:"status" db:"status"`
+	SpecialRequests string            `json:"special_requests" db:"special_requests"`
+	// NoShowForgivenAt — когда ресторан простил неявку: она больше не
+	// учитывается в счетчике гостя.
+	NoShowForgivenAt *time.Time `json:"no_show_forgiven_at,omitempty" db:"no_show_forgiven_at"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+	// TableIDs — столы сочетания, которые занимает бронирование. Заполняется
+	// перед записью, из базы не читается.
+	TableIDs []int64 `json:"-" db:"-"`
+	// Deposit — депозит, который требуется внести за новое бронирование.
+	// Сохраняется вместе с бронированием, из базы не читается.
+	Deposit *EventDeposit `json:"-" db:"-"`
+}
+
+// NoShowPolicy — правила ресторана для гостей с неявками. Порог
+// сравнивается со счетчиком неявок гостя по всем ресторанам; nil отключает
+// правило.
+type NoShowPolicy struct {
+	RestaurantID  int64     `json:"restaurant_id" db:"restaurant_id"`
+	DepositAfter  *int      `json:"deposit_after" db:"deposit_after"`
+	DepositAmount float64   `json:"deposit_amount" db:"deposit_amount"`
+	BlockAfter    *int      `json:"block_after" db:"block_after"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type InquiryStatus string
+
+const (
+	InquiryNew         InquiryStatus = "new"
+	InquiryContacted   InquiryStatus = "contacted"
+	InquiryQuoted      InquiryStatus = "quoted"
+	InquiryDepositPaid InquiryStatus = "deposit_paid"
+	InquiryConfirmed   InquiryStatus = "confirmed"
+	InquiryLost        InquiryStatus = "lost"
+)
+
+// EventInquiry — заявка на банкет до бронирования столов. DesiredDate —
+// ГГГГ-ММ-ДД по календарю ресторана. EventID заполняется, когда заявка
+// переведена в бронирование события, DepositID — если бронирование ждет
+// оплаты депозита.
+type EventInquiry struct {
+	ID           int64         `json:"id" db:"id"`
+	RestaurantID int64         `json:"restaurant_id" db:"restaurant_id"`
+	ContactName  string        `json:"contact_name" db:"contact_name"`
+	ContactPhone string        `json:"contact_phone" db:"contact_phone"`
+	DesiredDate  string        `json:"desired_date" db:"desired_date"`
+	Guests       int           `json:"guests" db:"guests"`
+	EventType    string        `json:"event_type" db:"event_type"`
+	Notes        string        `json:"notes" db:"notes"`
+	Status       InquiryStatus `json:"status" db:"status"`
+	AssignedTo   *int64        `json:"assigned_to" db:"assigned_to"`
+	EventID      *int64        `json:"event_id" db:"event_id"`
+	DepositID    *int64        `json:"deposit_id,omitempty" db:"deposit_id"`
+	CreatedAt    time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at" db:"updated_at"`
 }
+
+type InquiryFilter struct {
+	Status     InquiryStatus
+	AssignedTo *int64
+}
+
+type InquiryActivityKind string
+
+const (
+	InquiryActivityCreated   InquiryActivityKind = "created"
+	InquiryActivityUpdated   InquiryActivityKind = "updated"
+	InquiryActivityStatus    InquiryActivityKind = "status_changed"
+	InquiryActivityAssigned  InquiryActivityKind = "assigned"
+	InquiryActivityNote      InquiryActivityKind = "note"
+	InquiryActivityConverted InquiryActivityKind = "converted"
+)
+
+// InquiryActivity — запись журнала заявки. Status — новый статус заявки,
+// AssignedTo — назначенный менеджер (nil при снятии назначения).
+type InquiryActivity struct {
+	ID            int64               `json:"id" db:"id"`
+	InquiryID     int64               `json:"inquiry_id" db:"inquiry_id"`
+	Kind          InquiryActivityKind `json:"kind" db:"kind"`
+	Status        *InquiryStatus      `json:"status,omitempty" db:"status"`
+	AssignedTo    *int64              `json:"assigned_to,omitempty" db:"assigned_to"`
+	Note          string              `json:"note" db:"note"`
+	ActorUserID   *int64              `json:"actor_user_id,omitempty" db:"actor_user_id"`
+	ActorAPIKeyID *int64              `json:"actor_api_key_id,omitempty" db:"actor_api_key_id"`
+	CreatedAt     time.Time           `json:"created_at" db:"created_at"`
+}
+
+// InquiryStatusRequest меняет статус заявки. Note попадает в журнал,
+// например причина потери заявки.
+type InquiryStatusRequest struct {
+	Status InquiryStatus `json:"status"`
+	Note   string        `json:"note"`
+}
+
+// InquiryAssignRequest назначает заявке менеджера ресторана; null снимает
+// назначение.
+type InquiryAssignRequest struct {
+	UserID *int64 `json:"user_id"`
+}
+
+type InquiryNoteRequest struct {
+	Note string `json:"note"`
+}
+
+// InquiryConversion переводит заявку в бронирование события на желаемую
+// дату: занимаются столы TableIDs или все столы секции SectionID.
+type InquiryConversion struct {
+	EventID   int64   `json:"event_id"`
+	TableIDs  []int64 `json:"table_ids,omitempty"`
+	SectionID *int64  `json:"section_id,omitempty"`
+	Note      string  `json:"note"`
+}
+
+// InquiryConversionResult — бронирования, созданные по заявке. Если событие
+// требует депозит, бронирования ждут оплаты Deposit.
+type InquiryConversionResult struct {
+	Bookings []*RestaurantEventTable `json:"bookings"`
+	Deposit  *EventDeposit           `json:"deposit,omitempty"`
+}
+
+// TableOccupancy — промежуток [StartsAt, EndsAt), в течение которого стол
+// занят событием или бронированием. Заполнено ровно одно из полей EventID и
+// ReservationID.
+type TableOccupancy struct {
+	TableID       int64     `json:"table_id"`
+	StartsAt      time.Time `json:"starts_at"`
+	EndsAt        time.Time `json:"ends_at"`
+	EventID       *int64    `json:"event_id,omitempty"`
+	ReservationID *int64    `json:"reservation_id,omitempty"`
+}
+
+type ReservationStatusRequest struct {
+	Status ReservationStatus `json:"status"`
+}
+
+type ReservationFilter struct {
+	From   *time.Time
+	To     *time.Time
+	Status ReservationStatus
+}
+
+type WaitlistStatus string
+
+const (
+	WaitlistWaiting   WaitlistStatus = "waiting"
+	WaitlistNotified  WaitlistStatus = "notified"
+	WaitlistSeated    WaitlistStatus = "seated"
+	WaitlistCancelled WaitlistStatus = "cancelled"
+)
+
+// WaitlistEntry — гости без брони в очереди ресторана. QuotedWaitMinutes
+// названо гостям при записи; EstimatedWaitMinutes пересчитывается при
+// каждом чтении очереди. Оба поля пусты, если ни один стол не вмещает
+// гостей.
+type WaitlistEntry struct {
+	ID                   int64          `json:"id" db:"id"`
+	RestaurantID         int64          `json:"restaurant_id" db:"restaurant_id"`
+	UserID               int64          `json:"user_id" db:"user_id"`
+	PartySize            int            `json:"party_size" db:"party_size"`
+	Note                 string         `json:"note" db:"note"`
+	Status               WaitlistStatus `json:"status" db:"status"`
+	QuotedWaitMinutes    *int           `json:"quoted_wait_minutes" db:"quoted_wait_minutes"`
+	EstimatedWaitMinutes *int           `json:"estimated_wait_minutes,omitempty"`
+	ReservationID        *int64         `json:"reservation_id,omitempty" db:"reservation_id"`
+	CreatedAt            time.Time      `json:"created_at" db:"created_at"`
+	NotifiedAt           *time.Time     `json:"notified_at,omitempty" db:"notified_at"`
+	UpdatedAt            time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// WaitlistRequest ставит гостей в очередь по номеру телефона. Имя
+// сохраняется, только если гостя с таким номером еще нет; без него гость
+// записывается как «Гость».
+type WaitlistRequest struct {
+	RestaurantID int64  `json:"-"`
+	PhoneNumber  string `json:"phone_number"`
+	Name         string `json:"name"`
+	PartySize    int    `json:"party_size"`
+	Note         string `json:"note"`
+}
+
+// SeatRequest сажает гостей из очереди за стол. Без продолжительности стол
+// занимается на среднее время посадки ресторана.
+type SeatRequest struct {
+	TableID         int64 `json:"table_id"`
+	DurationMinutes int   `json:"duration_minutes"`
+}
+
+// WaitEstimate — ожидание для новых гостей с учетом тех, кто уже в очереди.
+type WaitEstimate struct {
+	PartySize            int  `json:"party_size"`
+	EstimatedWaitMinutes *int `json:"estimated_wait_minutes"`
+	PartiesAhead         int  `json:"parties_ahead"`
+	TurnMinutes          int  `json:"turn_minutes"`
+}
+
+type OTPCode struct {
+	PhoneNumber string    `json:"phone_number" db:"phone_number"`
+	CodeHash    string    `json:"-" db:"code_hash"`
+	Attempts    int       `json:"attempts" db:"attempts"`
+	ExpiresAt   time.Time `json:"expires_at" db:"expires_at"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+type OTPRequest struct {
+	PhoneNumber string `json:"phone_number"`
+}
+
+type OTPVerifyRequest struct {
+	PhoneNumber string `json:"phone_number"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	LastName    string `json:"last_name"`
+	Language    string `json:"language"`
+}
+
+type AuthTokens struct {
+	AccessToken      string    `json:"access_token"`
+	TokenType        string    `json:"token_type"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+	User             *User     `json:"user"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type DeviceInfo struct {
+	UserAgent string `json:"user_agent"`
+	IP        string `json:"ip"`
+}
+
+type Session struct {
+	ID         string    `json:"id"`
+	UserID     int64     `json:"user_id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+type APIKey struct {
+	ID           int64      `json:"id" db:"id"`
+	Name         string     `json:"name" db:"name"`
+	Prefix       string     `json:"prefix" db:"key_prefix"`
+	KeyHash      string     `json:"-" db:"key_hash"`
+	Scopes       []string   `json:"scopes" db:"scopes"`
+	RestaurantID *int64     `json:"restaurant_id,omitempty" db:"restaurant_id"`
+	CreatedBy    *int64     `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	LastUsedAt   *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+}
+
+type APIKeyCreateRequest struct {
+	Name         string   `json:"name"`
+	Scopes       []string `json:"scopes"`
+	RestaurantID *int64   `json:"restaurant_id"`
+}
+
+// APIKeyCreated возвращается один раз при создании ключа: в открытом виде
+// ключ больше нигде не хранится.
+type APIKeyCreated struct {
+	APIKey *APIKey `json:"api_key"`
+	Key    string  `json:"key"`
+}
+
+// CalendarFeed — ссылка на календарь бронирований ресторана. Токен ссылки
+// хранится только в виде хеша.
+type CalendarFeed struct {
+	RestaurantID int64     `json:"restaurant_id" db:"restaurant_id"`
+	TokenHash    string    `json:"-" db:"token_hash"`
+	CreatedBy    *int64    `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// CalendarFeedCreated возвращается один раз при выпуске ссылки: токен в
+// открытом виде больше нигде не хранится.
+type CalendarFeedCreated struct {
+	Feed  *CalendarFeed `json:"feed"`
+	Token string        `json:"token"`
+	URL   string        `json:"url"`
+}
+
+type CalendarEntryKind string
+
+const (
+	CalendarReservation  CalendarEntryKind = "reservation"
+	CalendarEventBooking CalendarEntryKind = "event_booking"
+)
+
+type CalendarStatus string
+
+const (
+	CalendarTentative CalendarStatus = "tentative"
+	CalendarConfirmed CalendarStatus = "confirmed"
+	CalendarCancelled CalendarStatus = "cancelled"
+)
+
+// CalendarEntry — бронирование в календаре ресторана. Бронирование под
+// событие занимает весь день: Start и End — полночи по времени ресторана.
+type CalendarEntry struct {
+	UID       string
+	Kind      CalendarEntryKind
+	Start     time.Time
+	End       time.Time
+	Status    CalendarStatus
+	PartySize int
+	EventName string
+	Tables    []int
+	Sections  []string
+	UpdatedAt time.Time
+}
+
+// Calendar — бронирования ресторана в его часовом поясе.
+type Calendar struct {
+	RestaurantName string
+	AddressRU      string
+	AddressKZ      string
+	Location       *time.Location
+	Entries        []*CalendarEntry
+}
+
+type AuditAction string
+
+const (
+	AuditActionCreate    AuditAction = "create"
+	AuditActionUpdate    AuditAction = "update"
+	AuditActionDelete    AuditAction = "delete"
+	AuditActionRestore   AuditAction = "restore"
+	AuditActionAnonymize AuditAction = "anonymize"
+)
+
+// AuditEntry — запись журнала изменений. Before и After содержат JSON
+// сущности до и после изменения; для создания нет Before, для удаления — After.
+type AuditEntry struct {
+	ID            int64           `json:"id" db:"id"`
+	ActorUserID   *int64          `json:"actor_user_id,omitempty" db:"actor_user_id"`
+	ActorAPIKeyID *int64          `json:"actor_api_key_id,omitempty" db:"actor_api_key_id"`
+	Action        AuditAction     `json:"action" db:"action"`
+	EntityType    string          `json:"entity_type" db:"entity_type"`
+	EntityID      int64           `json:"entity_id" db:"entity_id"`
+	Before        json.RawMessage `json:"before,omitempty" db:"before" swaggertype:"object"`
+	After         json.RawMessage `json:"after,omitempty" db:"after" swaggertype:"object"`
+	IP            string          `json:"ip" db:"ip"`
+	RequestID     string          `json:"request_id" db:"request_id"`
+	CreatedAt     time.Time       `json:"created_at" db:"created_at"`
+}
+
+type AuditFilter struct {
+	ActorUserID   *int64
+	ActorAPIKeyID *int64
+	Action        AuditAction
+	EntityType    string
+	EntityID      *int64
+	From          *time.Time
+	To            *time.Time
+	Limit         int
+	Offset        int
+}
+
+// Типы сущностей в журнале аудита.
+const (
+	AuditEntityUser         = "user"
+	AuditEntityCity         = "city"
+	AuditEntityRestaurant   = "restaurant"
+	AuditEntitySection      = "section"
+	AuditEntityTable        = "table"
+	AuditEntityCombination  = "table_combination"
+	AuditEntityMenuType     = "menu_type"
+	AuditEntityEventType    = "event_type"
+	AuditEntityMenu         = "menu"
+	AuditEntityEvent        = "event"
+	AuditEntityBooking      = "event_booking"
+	AuditEntityDeposit      = "event_deposit"
+	AuditEntityReservation  = "reservation"
+	AuditEntityOpeningHours = "opening_hours"
+	AuditEntitySpecialDay   = "special_day"
+	AuditEntityBlackout     = "section_blackout"
+	AuditEntityWaitlist     = "waitlist_entry"
+	AuditEntityStaff        = "staff"
+	AuditEntityAPIKey       = "api_key"
+	AuditEntityCalendarFeed = "calendar_feed"
+	AuditEntityNoShowPolicy = "no_show_policy"
+	AuditEntityPackage      = "event_package"
+	AuditEntityEventPricing = "event_pricing"
+	AuditEntityInquiry      = "event_inquiry"
+)
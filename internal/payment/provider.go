@@ -0,0 +1,109 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type Status string
+
+const (
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// ErrInvalidSignature — подпись уведомления не совпала с секретом.
+var ErrInvalidSignature = errors.New("неверная подпись уведомления о платеже")
+
+// Request — платеж, который нужно принять у гостя.
+type Request struct {
+	OrderID     string
+	Amount      float64
+	Description string
+}
+
+// Checkout — созданный у провайдера платеж и страница, где гость его оплачивает.
+type Checkout struct {
+	PaymentID string
+	URL       string
+}
+
+// Callback — уведомление провайдера о результате платежа.
+type Callback struct {
+	PaymentID string  `json:"payment_id"`
+	Status    Status  `json:"status"`
+	Amount    float64 `json:"amount"`
+}
+
+type Provider interface {
+	CreatePayment(ctx context.Context, req Request) (*Checkout, error)
+	// ParseCallback проверяет подпись уведомления и разбирает его тело.
+	ParseCallback(body []byte, signature string) (*Callback, error)
+}
+
+// FakeProvider не списывает деньги, а только выдает идентификаторы платежей
+// и проверяет подпись уведомлений секретом. Используется в разработке и
+// тестах, пока не подключен реальный провайдер: уведомление об оплате
+// формирует SignCallback.
+type FakeProvider struct {
+	secret      []byte
+	checkoutURL string
+}
+
+func NewFakeProvider(secret, checkoutURL string) *FakeProvider {
+	return &FakeProvider{
+		secret:      []byte(secret),
+		checkoutURL: strings.TrimRight(checkoutURL, "/"),
+	}
+}
+
+func (p *FakeProvider) CreatePayment(ctx context.Context, req Request) (*Checkout, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("не удалось создать идентификатор платежа: %w", err)
+	}
+
+	paymentID := "fake_" + hex.EncodeToString(buf)
+	return &Checkout{
+		PaymentID: paymentID,
+		URL:       p.checkoutURL + "/" + paymentID,
+	}, nil
+}
+
+func (p *FakeProvider) ParseCallback(body []byte, signature string) (*Callback, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, p.sign(body)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var callback Callback
+	if err := json.Unmarshal(body, &callback); err != nil {
+		return nil, fmt.Errorf("не удалось разобрать уведомление о платеже: %w", err)
+	}
+
+	return &callback, nil
+}
+
+// SignCallback возвращает тело уведомления и его подпись — так, как их
+// прислал бы провайдер.
+func (p *FakeProvider) SignCallback(callback Callback) ([]byte, string, error) {
+	body, err := json.Marshal(callback)
+	if err != nil {
+		return nil, "", fmt.Errorf("не удалось сформировать уведомление о платеже: %w", err)
+	}
+
+	return body, hex.EncodeToString(p.sign(body)), nil
+}
+
+func (p *FakeProvider) sign(body []byte) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+type APIKeyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAPIKeyRepository(db *pgxpool.Pool) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+const apiKeyColumns = `id, name, key_prefix, key_hash, scopes, restaurant_id, created_by, created_at, last_used_at, revoked_at`
+
+func (r *APIKeyRepository) Create(ctx context.Context, key *models.APIKey) (int64, error) {
+	query := `
+        INSERT INTO api_keys (name, key_prefix, key_hash, scopes, restaurant_id, created_by)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING id, created_at
+    `
+	err := r.db.QueryRow(ctx, query,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		key.Scopes,
+		key.RestaurantID,
+		key.CreatedBy,
+	).Scan(&key.ID, &key.CreatedAt)
+
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, errs.Invalid("restaurant_id", i18n.CodeRestaurantNotExist)
+		}
+		return 0, fmt.Errorf("не удалось создать API-ключ: %w", err)
+	}
+
+	return key.ID, nil
+}
+
+func (r *APIKeyRepository) GetByID(ctx context.Context, id int64) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = $1`
+	key, err := scanAPIKey(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeAPIKeyIDNotFound, id)
+		}
+		return nil, fmt.Errorf("не удалось получить API-ключ: %w", err)
+	}
+
+	return key, nil
+}
+
+func (r *APIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1`
+	key, err := scanAPIKey(r.db.QueryRow(ctx, query, keyHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeAPIKeyNotFound)
+		}
+		return nil, fmt.Errorf("не удалось получить API-ключ: %w", err)
+	}
+
+	return key, nil
+}
+
+func (r *APIKeyRepository) List(ctx context.Context) ([]*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys ORDER BY id`
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить список API-ключей: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*models.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании API-ключа: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по API-ключам: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, id int64) error {
+	query := `UPDATE api_keys SET revoked_at = CURRENT_TIMESTAMP WHERE id = $1 AND revoked_at IS NULL`
+	commandTag, err := r.db.Exec(ctx, query, id)
+
+	if err != nil {
+		return fmt.Errorf("не удалось отозвать API-ключ: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeAPIKeyNotFoundRevoked, id)
+	}
+
+	return nil
+}
+
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id int64) error {
+	query := `UPDATE api_keys SET last_used_at = CURRENT_TIMESTAMP WHERE id = $1`
+	if _, err := r.db.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("не удалось обновить время использования API-ключа: %w", err)
+	}
+
+	return nil
+}
+
+func scanAPIKey(row pgx.Row) (*models.APIKey, error) {
+	var key models.APIKey
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.Scopes,
+		&key.RestaurantID,
+		&key.CreatedBy,
+		&key.CreatedAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
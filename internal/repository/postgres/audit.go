@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/models"
+)
+
+type AuditRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAuditRepository(db *pgxpool.Pool) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+func (r *AuditRepository) Create(ctx context.Context, entry *models.AuditEntry) error {
+	query := `
+        INSERT INTO audit_log (actor_user_id, actor_api_key_id, action, entity_type, entity_id,
+                               before, after, ip, request_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        RETURNING id, created_at
+    `
+	err := r.db.QueryRow(ctx, query,
+		entry.ActorUserID,
+		entry.ActorAPIKeyID,
+		entry.Action,
+		entry.EntityType,
+		entry.EntityID,
+		[]byte(entry.Before),
+		[]byte(entry.After),
+		entry.IP,
+		entry.RequestID,
+	).Scan(&entry.ID, &entry.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf("не удалось записать событие аудита: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuditRepository) List(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	var conditions []string
+	var args []interface{}
+	where := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.ActorUserID != nil {
+		where("actor_user_id = $%d", *filter.ActorUserID)
+	}
+	if filter.ActorAPIKeyID != nil {
+		where("actor_api_key_id = $%d", *filter.ActorAPIKeyID)
+	}
+	if filter.Action != "" {
+		where("action = $%d", filter.Action)
+	}
+	if filter.EntityType != "" {
+		where("entity_type = $%d", filter.EntityType)
+	}
+	if filter.EntityID != nil {
+		where("entity_id = $%d", *filter.EntityID)
+	}
+	if filter.From != nil {
+		where("created_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		where("created_at < $%d", *filter.To)
+	}
+
+	query := `
+        SELECT id, actor_user_id, actor_api_key_id, action, entity_type, entity_id,
+               before, after, ip, request_id, created_at
+        FROM audit_log
+    `
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, filter.Limit, filter.Offset)
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить журнал аудита: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.AuditEntry
+	for rows.Next() {
+		var entry models.AuditEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.ActorUserID,
+			&entry.ActorAPIKeyID,
+			&entry.Action,
+			&entry.EntityType,
+			&entry.EntityID,
+			&entry.Before,
+			&entry.After,
+			&entry.IP,
+			&entry.RequestID,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании записи аудита: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по журналу аудита: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ScrubUser удаляет из журнала снимки пользователя и связанных с ним
+// сущностей, оставляя сам факт изменения. Используется при анонимизации
+// пользователя.
+func (r *AuditRepository) ScrubUser(ctx context.Context, userID int64) error {
+	query := `
+        UPDATE audit_log SET before = NULL, after = NULL
+        WHERE (entity_type = $2 AND entity_id = $1)
+           OR (entity_type = $3 AND entity_id IN (SELECT id FROM reservations WHERE user_id = $1))
+           OR (entity_type = $4 AND entity_id IN (SELECT id FROM waitlist_entries WHERE user_id = $1))
+           OR (entity_type = $5 AND entity_id IN (
+                   SELECT d.id FROM event_deposits d
+                   JOIN reservations r ON r.id = d.reservation_id
+                   WHERE r.user_id = $1))
+    `
+	_, err := r.db.Exec(ctx, query, userID, models.AuditEntityUser, models.AuditEntityReservation,
+		models.AuditEntityWaitlist, models.AuditEntityDeposit)
+	if err != nil {
+		return fmt.Errorf("не удалось очистить журнал аудита: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuditRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM audit_log WHERE created_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось удалить старые записи аудита: %w", err)
+	}
+
+	return commandTag.RowsAffected(), nil
+}
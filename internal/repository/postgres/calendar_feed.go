@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+type CalendarFeedRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCalendarFeedRepository(db *pgxpool.Pool) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db: db}
+}
+
+func (r *CalendarFeedRepository) Save(ctx context.Context, feed *models.CalendarFeed) error {
+	query := `
+        INSERT INTO calendar_feeds (restaurant_id, token_hash, created_by)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (restaurant_id) DO UPDATE
+        SET token_hash = EXCLUDED.token_hash,
+            created_by = EXCLUDED.created_by,
+            created_at = CURRENT_TIMESTAMP
+        RETURNING created_at
+    `
+	err := r.db.QueryRow(ctx, query, feed.RestaurantID, feed.TokenHash, feed.CreatedBy).Scan(&feed.CreatedAt)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.NotFound(i18n.CodeRestaurantNotFound, feed.RestaurantID)
+		}
+		return fmt.Errorf("не удалось сохранить ссылку на календарь: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CalendarFeedRepository) GetByRestaurant(ctx context.Context, restaurantID int64) (*models.CalendarFeed, error) {
+	query := `
+        SELECT restaurant_id, token_hash, created_by, created_at
+        FROM calendar_feeds
+        WHERE restaurant_id = $1
+    `
+	var feed models.CalendarFeed
+	err := r.db.QueryRow(ctx, query, restaurantID).Scan(
+		&feed.RestaurantID,
+		&feed.TokenHash,
+		&feed.CreatedBy,
+		&feed.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeCalendarFeedNotFound, restaurantID)
+		}
+		return nil, fmt.Errorf("не удалось получить ссылку на календарь: %w", err)
+	}
+
+	return &feed, nil
+}
+
+func (r *CalendarFeedRepository) Delete(ctx context.Context, restaurantID int64) error {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM calendar_feeds WHERE restaurant_id = $1`, restaurantID)
+	if err != nil {
+		return fmt.Errorf("не удалось удалить ссылку на календарь: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeCalendarFeedNotFound, restaurantID)
+	}
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 )
 
@@ -21,14 +23,17 @@ func NewCityRepository(db *pgxpool.Pool) *CityRepository {
 
 func (r *CityRepository) Create(ctx context.Context, city *models.City) (int64, error) {
 	query := `
-        INSERT INTO cities (name)
-        VALUES ($1)
+        INSERT INTO cities (name, time_zone)
+        VALUES ($1, $2)
         RETURNING id
     `
 	var id int64
-	err := r.db.QueryRow(ctx, query, city.Name).Scan(&id)
+	err := r.db.QueryRow(ctx, query, city.Name, city.TimeZone).Scan(&id)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, errs.Conflict(i18n.CodeCityExists, city.Name)
+		}
 		return 0, fmt.Errorf("не удалось создать город: %w", err)
 	}
 
@@ -37,7 +42,7 @@ func (r *CityRepository) Create(ctx context.Context, city *models.City) (int64,
 
 func (r *CityRepository) GetByID(ctx context.Context, id int64) (*models.City, error) {
 	query := `
-        SELECT id, name
+        SELECT id, name, time_zone
         FROM cities
         WHERE id = $1
     `
@@ -45,11 +50,12 @@ func (r *CityRepository) GetByID(ctx context.Context, id int64) (*models.City, e
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&city.ID,
 		&city.Name,
+		&city.TimeZone,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("город с ID %d не найден", id)
+			return nil, errs.NotFound(i18n.CodeCityNotFound, id)
 		}
 		return nil, fmt.Errorf("не удалось получить город: %w", err)
 	}
@@ -59,7 +65,7 @@ func (r *CityRepository) GetByID(ctx context.Context, id int64) (*models.City, e
 
 func (r *CityRepository) GetByName(ctx context.Context, name string) (*models.City, error) {
 	query := `
-        SELECT id, name
+        SELECT id, name, time_zone
         FROM cities
         WHERE name = $1
     `
@@ -67,11 +73,12 @@ func (r *CityRepository) GetByName(ctx context.Context, name string) (*models.Ci
 	err := r.db.QueryRow(ctx, query, name).Scan(
 		&city.ID,
 		&city.Name,
+		&city.TimeZone,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("город с названием %s не найден", name)
+			return nil, errs.NotFound(i18n.CodeCityNameNotFound, name)
 		}
 		return nil, fmt.Errorf("не удалось получить город: %w", err)
 	}
@@ -82,17 +89,20 @@ func (r *CityRepository) GetByName(ctx context.Context, name string) (*models.Ci
 func (r *CityRepository) Update(ctx context.Context, city *models.City) error {
 	query := `
         UPDATE cities
-        SET name = $1
-        WHERE id = $2
+        SET name = $1, time_zone = $2
+        WHERE id = $3
     `
-	commandTag, err := r.db.Exec(ctx, query, city.Name, city.ID)
+	commandTag, err := r.db.Exec(ctx, query, city.Name, city.TimeZone, city.ID)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return errs.Conflict(i18n.CodeCityExists, city.Name)
+		}
 		return fmt.Errorf("не удалось обновить город: %w", err)
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("город с ID %d не найден", city.ID)
+		return errs.NotFound(i18n.CodeCityNotFound, city.ID)
 	}
 
 	return nil
@@ -103,11 +113,14 @@ func (r *CityRepository) Delete(ctx context.Context, id int64) error {
 	commandTag, err := r.db.Exec(ctx, query, id)
 
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.Conflict(i18n.CodeCityInUse, id)
+		}
 		return fmt.Errorf("не удалось удалить город: %w", err)
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("город с ID %d не найден", id)
+		return errs.NotFound(i18n.CodeCityNotFound, id)
 	}
 
 	return nil
@@ -115,7 +128,7 @@ func (r *CityRepository) Delete(ctx context.Context, id int64) error {
 
 func (r *CityRepository) List(ctx context.Context) ([]*models.City, error) {
 	query := `
-        SELECT id, name
+        SELECT id, name, time_zone
         FROM cities
         ORDER BY name
     `
@@ -131,6 +144,7 @@ func (r *CityRepository) List(ctx context.Context) ([]*models.City, error) {
 		if err := rows.Scan(
 			&city.ID,
 			&city.Name,
+			&city.TimeZone,
 		); err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании города: %w", err)
 		}
@@ -0,0 +1,315 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+const depositColumns = `id, event_id, reservation_id, booking_date, guests, amount, status, COALESCE(payment_id, ''),
+               payment_url, expires_at, paid_at, created_at`
+
+type EventDepositRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewEventDepositRepository(db *pgxpool.Pool) *EventDepositRepository {
+	return &EventDepositRepository{db: db}
+}
+
+func (r *EventDepositRepository) GetByID(ctx context.Context, id int64) (*models.EventDeposit, error) {
+	query := `SELECT ` + depositColumns + ` FROM event_deposits WHERE id = $1`
+	deposit, err := scanDeposit(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeDepositNotFound, id)
+		}
+		return nil, fmt.Errorf("не удалось получить депозит: %w", err)
+	}
+
+	return deposit, nil
+}
+
+func (r *EventDepositRepository) GetByPaymentID(ctx context.Context, paymentID string) (*models.EventDeposit, error) {
+	query := `SELECT ` + depositColumns + ` FROM event_deposits WHERE payment_id = $1`
+	deposit, err := scanDeposit(r.db.QueryRow(ctx, query, paymentID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeDepositNotFound, paymentID)
+		}
+		return nil, fmt.Errorf("не удалось получить депозит: %w", err)
+	}
+
+	return deposit, nil
+}
+
+func (r *EventDepositRepository) SetPayment(ctx context.Context, id int64, paymentID, paymentURL string) error {
+	query := `UPDATE event_deposits SET payment_id = $1, payment_url = $2 WHERE id = $3`
+	commandTag, err := r.db.Exec(ctx, query, paymentID, paymentURL, id)
+	if err != nil {
+		return fmt.Errorf("не удалось сохранить платеж депозита: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeDepositNotFound, id)
+	}
+
+	return nil
+}
+
+// MarkPaid принимает оплату, только пока депозит ждет ее и срок не истек:
+// просроченный депозит уже мог освободить столы.
+func (r *EventDepositRepository) MarkPaid(ctx context.Context, id int64) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE event_deposits
+        SET status = 'paid', paid_at = CURRENT_TIMESTAMP
+        WHERE id = $1 AND status = 'pending' AND expires_at > CURRENT_TIMESTAMP
+    `
+	commandTag, err := tx.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("не удалось отметить оплату депозита: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		var status models.DepositStatus
+		query = `
+            SELECT CASE WHEN status = 'pending' THEN 'expired' ELSE status::text END
+            FROM event_deposits
+            WHERE id = $1
+        `
+		if err := tx.QueryRow(ctx, query, id).Scan(&status); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errs.NotFound(i18n.CodeDepositNotFound, id)
+			}
+			return fmt.Errorf("не удалось получить статус депозита: %w", err)
+		}
+
+		if status == models.DepositPaid {
+			return nil
+		}
+		return errs.Conflict(i18n.CodeDepositNotPending, status)
+	}
+
+	query = `UPDATE restaurant_event_tables SET status = 'confirmed' WHERE deposit_id = $1`
+	if _, err := tx.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("не удалось подтвердить бронирования депозита: %w", err)
+	}
+
+	query = `
+        UPDATE reservations SET status = 'confirmed', updated_at = CURRENT_TIMESTAMP
+        WHERE id = (SELECT reservation_id FROM event_deposits WHERE id = $1) AND status = 'pending'
+    `
+	if _, err := tx.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("не удалось подтвердить бронирование депозита: %w", err)
+	}
+
+	// Оплата депозита по заявке проводит ее через deposit_paid в confirmed;
+	// журнал заявки получает оба статуса.
+	query = `
+        WITH confirmed AS (
+            UPDATE event_inquiries SET status = 'confirmed', updated_at = CURRENT_TIMESTAMP
+            WHERE deposit_id = $1 AND ` + openInquiry + `
+            RETURNING id
+        )
+        INSERT INTO event_inquiry_activities (inquiry_id, kind, status)
+        SELECT c.id, 'status_changed', s.status
+        FROM confirmed c
+        CROSS JOIN (VALUES (1, 'deposit_paid'), (2, 'confirmed')) AS s(step, status)
+        ORDER BY c.id, s.step
+    `
+	if _, err := tx.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("не удалось подтвердить заявку депозита: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось отметить оплату депозита: %w", err)
+	}
+
+	return nil
+}
+
+func (r *EventDepositRepository) Release(ctx context.Context, id int64, status models.DepositStatus) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE event_deposits SET status = $1 WHERE id = $2 AND status = 'pending'`
+	commandTag, err := tx.Exec(ctx, query, status, id)
+	if err != nil {
+		return fmt.Errorf("не удалось обновить статус депозита: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeDepositNotFound, id)
+	}
+
+	query = `DELETE FROM restaurant_event_tables WHERE deposit_id = $1 AND status = 'pending_payment'`
+	if _, err := tx.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("не удалось снять бронирования депозита: %w", err)
+	}
+
+	query = `
+        WITH cancelled AS (
+            UPDATE reservations SET status = 'cancelled', updated_at = CURRENT_TIMESTAMP
+            WHERE id = (SELECT reservation_id FROM event_deposits WHERE id = $1) AND status = 'pending'
+            RETURNING id
+        )
+        DELETE FROM table_occupancy WHERE reservation_id IN (SELECT id FROM cancelled)
+    `
+	if _, err := tx.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("не удалось снять бронирование депозита: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось обновить статус депозита: %w", err)
+	}
+
+	return nil
+}
+
+// ExpireOverdue удаляет бронирования под события вместе с записями журнала
+// занятости, а бронирования столов, которые еще ждут подтверждения,
+// отменяет, поэтому столы сразу становятся свободными.
+func (r *EventDepositRepository) ExpireOverdue(ctx context.Context) (int64, error) {
+	query := `
+        WITH expired AS (
+            UPDATE event_deposits
+            SET status = 'expired'
+            WHERE status = 'pending' AND expires_at <= CURRENT_TIMESTAMP
+            RETURNING id, reservation_id
+        ), released AS (
+            DELETE FROM restaurant_event_tables
+            WHERE deposit_id IN (SELECT id FROM expired) AND status = 'pending_payment'
+        ), cancelled AS (
+            UPDATE reservations SET status = 'cancelled', updated_at = CURRENT_TIMESTAMP
+            WHERE id IN (SELECT reservation_id FROM expired) AND status = 'pending'
+            RETURNING id
+        ), freed AS (
+            DELETE FROM table_occupancy WHERE reservation_id IN (SELECT id FROM cancelled)
+        )
+        SELECT count(*) FROM expired
+    `
+	var expired int64
+	if err := r.db.QueryRow(ctx, query).Scan(&expired); err != nil {
+		return 0, fmt.Errorf("не удалось снять просроченные депозиты: %w", err)
+	}
+
+	return expired, nil
+}
+
+func (r *EventDepositRepository) GetReleased(ctx context.Context, restaurantID int64,
+	from, to time.Time) ([]*models.EventDeposit, error) {
+	query := `
+        SELECT ` + depositColumns + `
+        FROM event_deposits
+        WHERE event_id IN (SELECT id FROM restaurant_events WHERE restaurant_id = $1)
+          AND status IN ('expired', 'cancelled')
+          AND booking_date >= $2 AND booking_date < $3
+        ORDER BY booking_date, id
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить снятые депозиты: %w", err)
+	}
+	defer rows.Close()
+
+	var deposits []*models.EventDeposit
+	for rows.Next() {
+		deposit, err := scanDeposit(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании депозита: %w", err)
+		}
+		deposits = append(deposits, deposit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по депозитам: %w", err)
+	}
+
+	return deposits, nil
+}
+
+func (r *EventDepositRepository) GetByUser(ctx context.Context, userID int64) ([]*models.EventDeposit, error) {
+	query := `
+        SELECT ` + depositColumns + `
+        FROM event_deposits
+        WHERE reservation_id IN (SELECT id FROM reservations WHERE user_id = $1)
+        ORDER BY created_at DESC, id DESC
+    `
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить депозиты пользователя: %w", err)
+	}
+	defer rows.Close()
+
+	var deposits []*models.EventDeposit
+	for rows.Next() {
+		deposit, err := scanDeposit(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании депозита: %w", err)
+		}
+		deposits = append(deposits, deposit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по депозитам: %w", err)
+	}
+
+	return deposits, nil
+}
+
+func insertDeposit(ctx context.Context, tx pgx.Tx, deposit *models.EventDeposit) error {
+	query := `
+        INSERT INTO event_deposits (event_id, reservation_id, booking_date, guests, amount, status, expires_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING id, created_at
+    `
+	return tx.QueryRow(ctx, query,
+		deposit.EventID,
+		deposit.ReservationID,
+		deposit.BookingDate,
+		deposit.Guests,
+		deposit.Amount,
+		deposit.Status,
+		deposit.ExpiresAt,
+	).Scan(&deposit.ID, &deposit.CreatedAt)
+}
+
+func scanDeposit(row pgx.Row) (*models.EventDeposit, error) {
+	var deposit models.EventDeposit
+	err := row.Scan(
+		&deposit.ID,
+		&deposit.EventID,
+		&deposit.ReservationID,
+		&deposit.BookingDate,
+		&deposit.Guests,
+		&deposit.Amount,
+		&deposit.Status,
+		&deposit.PaymentID,
+		&deposit.PaymentURL,
+		&deposit.ExpiresAt,
+		&deposit.PaidAt,
+		&deposit.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deposit, nil
+}
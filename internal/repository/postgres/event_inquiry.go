@@ -0,0 +1,364 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+const inquiryColumns = `id, restaurant_id, contact_name, contact_phone, to_char(desired_date, 'YYYY-MM-DD'),
+               guests, COALESCE(event_type, ''), notes, status, assigned_to, event_id, deposit_id,
+               created_at, updated_at`
+
+// openInquiry — условие для заявок, которые еще можно менять.
+const openInquiry = `status NOT IN ('confirmed', 'lost')`
+
+type EventInquiryRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewEventInquiryRepository(db *pgxpool.Pool) *EventInquiryRepository {
+	return &EventInquiryRepository{db: db}
+}
+
+func (r *EventInquiryRepository) Create(ctx context.Context, inquiry *models.EventInquiry,
+	activity *models.InquiryActivity) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO event_inquiries (restaurant_id, contact_name, contact_phone, desired_date, guests,
+                                     event_type, notes, status)
+        VALUES ($1, $2, $3, $4::date, $5, NULLIF($6, ''), $7, $8)
+        RETURNING id, created_at, updated_at
+    `
+	err = tx.QueryRow(ctx, query,
+		inquiry.RestaurantID,
+		inquiry.ContactName,
+		inquiry.ContactPhone,
+		inquiry.DesiredDate,
+		inquiry.Guests,
+		inquiry.EventType,
+		inquiry.Notes,
+		inquiry.Status,
+	).Scan(&inquiry.ID, &inquiry.CreatedAt, &inquiry.UpdatedAt)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, inquiryReferenceError(err, inquiry)
+		}
+		return 0, fmt.Errorf("не удалось создать заявку: %w", err)
+	}
+
+	activity.InquiryID = inquiry.ID
+	if err := insertInquiryActivity(ctx, tx, activity); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("не удалось создать заявку: %w", err)
+	}
+
+	return inquiry.ID, nil
+}
+
+func (r *EventInquiryRepository) GetByID(ctx context.Context, id int64) (*models.EventInquiry, error) {
+	query := `SELECT ` + inquiryColumns + ` FROM event_inquiries WHERE id = $1`
+
+	inquiry, err := scanInquiry(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeInquiryNotFound, id)
+		}
+		return nil, fmt.Errorf("не удалось получить заявку: %w", err)
+	}
+
+	return inquiry, nil
+}
+
+// GetByRestaurant возвращает заявки ресторана по возрастанию желаемой даты.
+func (r *EventInquiryRepository) GetByRestaurant(ctx context.Context, restaurantID int64,
+	filter models.InquiryFilter) ([]*models.EventInquiry, error) {
+	query := `
+        SELECT ` + inquiryColumns + `
+        FROM event_inquiries
+        WHERE restaurant_id = $1
+          AND ($2 = '' OR status = $2)
+          AND ($3::int IS NULL OR assigned_to = $3)
+        ORDER BY desired_date, id
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID, string(filter.Status), filter.AssignedTo)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить заявки ресторана: %w", err)
+	}
+	defer rows.Close()
+
+	var inquiries []*models.EventInquiry
+	for rows.Next() {
+		inquiry, err := scanInquiry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании заявки: %w", err)
+		}
+		inquiries = append(inquiries, inquiry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по заявкам: %w", err)
+	}
+
+	return inquiries, nil
+}
+
+func (r *EventInquiryRepository) Update(ctx context.Context, inquiry *models.EventInquiry,
+	activity *models.InquiryActivity) error {
+	query := `
+        UPDATE event_inquiries
+        SET contact_name = $1, contact_phone = $2, desired_date = $3::date, guests = $4,
+            event_type = NULLIF($5, ''), notes = $6, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $7 AND ` + openInquiry + `
+    `
+	return r.change(ctx, inquiry.ID, activity, func(tx pgx.Tx) (int64, error) {
+		commandTag, err := tx.Exec(ctx, query,
+			inquiry.ContactName,
+			inquiry.ContactPhone,
+			inquiry.DesiredDate,
+			inquiry.Guests,
+			inquiry.EventType,
+			inquiry.Notes,
+			inquiry.ID,
+		)
+		if err != nil {
+			if isForeignKeyViolation(err) {
+				return 0, inquiryReferenceError(err, inquiry)
+			}
+			return 0, fmt.Errorf("не удалось обновить заявку: %w", err)
+		}
+		return commandTag.RowsAffected(), nil
+	})
+}
+
+func (r *EventInquiryRepository) UpdateStatus(ctx context.Context, id int64, from, to models.InquiryStatus,
+	activity *models.InquiryActivity) error {
+	query := `
+        UPDATE event_inquiries
+        SET status = $1, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND status = $3
+    `
+	return r.change(ctx, id, activity, func(tx pgx.Tx) (int64, error) {
+		commandTag, err := tx.Exec(ctx, query, to, id, from)
+		if err != nil {
+			return 0, fmt.Errorf("не удалось изменить статус заявки: %w", err)
+		}
+		return commandTag.RowsAffected(), nil
+	})
+}
+
+func (r *EventInquiryRepository) Assign(ctx context.Context, id int64, userID *int64,
+	activity *models.InquiryActivity) error {
+	query := `
+        UPDATE event_inquiries
+        SET assigned_to = $1, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND ` + openInquiry + `
+    `
+	return r.change(ctx, id, activity, func(tx pgx.Tx) (int64, error) {
+		commandTag, err := tx.Exec(ctx, query, userID, id)
+		if err != nil {
+			if isForeignKeyViolation(err) {
+				return 0, errs.Invalid("user_id", i18n.CodeUserNotExists)
+			}
+			return 0, fmt.Errorf("не удалось назначить менеджера заявки: %w", err)
+		}
+		return commandTag.RowsAffected(), nil
+	})
+}
+
+func (r *EventInquiryRepository) AddActivity(ctx context.Context, activity *models.InquiryActivity) error {
+	return insertInquiryActivity(ctx, r.db, activity)
+}
+
+func (r *EventInquiryRepository) GetActivities(ctx context.Context, inquiryID int64) ([]*models.InquiryActivity, error) {
+	query := `
+        SELECT id, inquiry_id, kind, status, assigned_to, note, actor_user_id, actor_api_key_id, created_at
+        FROM event_inquiry_activities
+        WHERE inquiry_id = $1
+        ORDER BY created_at, id
+    `
+	rows, err := r.db.Query(ctx, query, inquiryID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить журнал заявки: %w", err)
+	}
+	defer rows.Close()
+
+	var activities []*models.InquiryActivity
+	for rows.Next() {
+		var activity models.InquiryActivity
+		err := rows.Scan(
+			&activity.ID,
+			&activity.InquiryID,
+			&activity.Kind,
+			&activity.Status,
+			&activity.AssignedTo,
+			&activity.Note,
+			&activity.ActorUserID,
+			&activity.ActorAPIKeyID,
+			&activity.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании журнала заявки: %w", err)
+		}
+		activities = append(activities, &activity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по журналу заявки: %w", err)
+	}
+
+	return activities, nil
+}
+
+func (r *EventInquiryRepository) Convert(ctx context.Context, id int64, from models.InquiryStatus, eventID int64,
+	bookings []*models.RestaurantEventTable, deposit *models.EventDeposit, activity *models.InquiryActivity) error {
+	query := `
+        UPDATE event_inquiries
+        SET status = 'confirmed', event_id = $1, deposit_id = NULL, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND status = $3
+    `
+	return r.change(ctx, id, activity, func(tx pgx.Tx) (int64, error) {
+		args := []interface{}{eventID, id, from}
+		if deposit != nil {
+			if err := insertDeposit(ctx, tx, deposit); err != nil {
+				if isForeignKeyViolation(err) {
+					return 0, errs.Validation(i18n.CodeEventOrTableNotExist)
+				}
+				return 0, fmt.Errorf("не удалось создать депозит: %w", err)
+			}
+			for _, booking := range bookings {
+				booking.DepositID = &deposit.ID
+			}
+
+			query = `
+                UPDATE event_inquiries
+                SET event_id = $1, deposit_id = $4, updated_at = CURRENT_TIMESTAMP
+                WHERE id = $2 AND status = $3
+            `
+			args = append(args, deposit.ID)
+		}
+
+		commandTag, err := tx.Exec(ctx, query, args...)
+		if err != nil {
+			return 0, fmt.Errorf("не удалось перевести заявку в бронирование: %w", err)
+		}
+		if commandTag.RowsAffected() == 0 {
+			return 0, nil
+		}
+
+		if err := insertEventTables(ctx, tx, r.db, bookings); err != nil {
+			return 0, err
+		}
+		return commandTag.RowsAffected(), nil
+	})
+}
+
+// change выполняет изменение заявки и записывает действие в журнал в одной
+// транзакции. Если update не затронул ни одной строки, заявка не найдена
+// или уже в другом статусе.
+func (r *EventInquiryRepository) change(ctx context.Context, id int64, activity *models.InquiryActivity,
+	update func(tx pgx.Tx) (int64, error)) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	affected, err := update(tx)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		tx.Rollback(ctx)
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return errs.Conflict(i18n.CodeInquiryStatusChanged, id)
+	}
+
+	if err := insertInquiryActivity(ctx, tx, activity); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось изменить заявку: %w", err)
+	}
+
+	return nil
+}
+
+func insertInquiryActivity(ctx context.Context, db queryRower, activity *models.InquiryActivity) error {
+	query := `
+        INSERT INTO event_inquiry_activities (inquiry_id, kind, status, assigned_to, note,
+                                              actor_user_id, actor_api_key_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING id, created_at
+    `
+	err := db.QueryRow(ctx, query,
+		activity.InquiryID,
+		activity.Kind,
+		activity.Status,
+		activity.AssignedTo,
+		activity.Note,
+		activity.ActorUserID,
+		activity.ActorAPIKeyID,
+	).Scan(&activity.ID, &activity.CreatedAt)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.NotFound(i18n.CodeInquiryNotFound, activity.InquiryID)
+		}
+		return fmt.Errorf("не удалось записать действие по заявке: %w", err)
+	}
+
+	return nil
+}
+
+// inquiryReferenceError отличает неизвестный тип события от удаленного
+// ресторана.
+func inquiryReferenceError(err error, inquiry *models.EventInquiry) error {
+	if violatedConstraint(err) == "event_inquiries_event_type_fkey" {
+		return errs.Invalid("event_type", i18n.CodeEventTypeUnknown, inquiry.EventType)
+	}
+	return errs.NotFound(i18n.CodeRestaurantNotFound, inquiry.RestaurantID)
+}
+
+func scanInquiry(row pgx.Row) (*models.EventInquiry, error) {
+	var inquiry models.EventInquiry
+	err := row.Scan(
+		&inquiry.ID,
+		&inquiry.RestaurantID,
+		&inquiry.ContactName,
+		&inquiry.ContactPhone,
+		&inquiry.DesiredDate,
+		&inquiry.Guests,
+		&inquiry.EventType,
+		&inquiry.Notes,
+		&inquiry.Status,
+		&inquiry.AssignedTo,
+		&inquiry.EventID,
+		&inquiry.DepositID,
+		&inquiry.CreatedAt,
+		&inquiry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inquiry, nil
+}
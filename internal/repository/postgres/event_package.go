@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+const eventPackageColumns = `id, event_id, name, tier, price_per_guest, min_guests, max_guests,
+        included_services, is_active, created_at`
+
+type EventPackageRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewEventPackageRepository(db *pgxpool.Pool) *EventPackageRepository {
+	return &EventPackageRepository{db: db}
+}
+
+func (r *EventPackageRepository) Create(ctx context.Context, pkg *models.EventPackage) (int64, error) {
+	query := `
+        INSERT INTO event_packages (event_id, name, tier, price_per_guest, min_guests, max_guests,
+                                    included_services, is_active)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        RETURNING id, created_at
+    `
+	err := r.db.QueryRow(ctx, query,
+		pkg.EventID,
+		pkg.Name,
+		pkg.Tier,
+		pkg.PricePerGuest,
+		pkg.MinGuests,
+		pkg.MaxGuests,
+		pkg.IncludedServices,
+		pkg.IsActive,
+	).Scan(&pkg.ID, &pkg.CreatedAt)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, errs.Conflict(i18n.CodePackageExists, pkg.Name)
+		}
+		if isForeignKeyViolation(err) {
+			return 0, errs.NotFound(i18n.CodeEventNotFound, pkg.EventID)
+		}
+		return 0, fmt.Errorf("не удалось создать пакет события: %w", err)
+	}
+
+	return pkg.ID, nil
+}
+
+func (r *EventPackageRepository) GetByID(ctx context.Context, id int64) (*models.EventPackage, error) {
+	query := `SELECT ` + eventPackageColumns + ` FROM event_packages WHERE id = $1`
+	pkg, err := scanEventPackage(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodePackageNotFound, id)
+		}
+		return nil, fmt.Errorf("не удалось получить пакет события: %w", err)
+	}
+
+	return pkg, nil
+}
+
+func (r *EventPackageRepository) GetByEvent(ctx context.Context, eventID int64, includeInactive bool) ([]*models.EventPackage, error) {
+	query := `
+        SELECT ` + eventPackageColumns + `
+        FROM event_packages
+        WHERE event_id = $1 AND (is_active OR $2)
+        ORDER BY price_per_guest, id
+    `
+	rows, err := r.db.Query(ctx, query, eventID, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить пакеты события: %w", err)
+	}
+	defer rows.Close()
+
+	var packages []*models.EventPackage
+	for rows.Next() {
+		pkg, err := scanEventPackage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании пакета события: %w", err)
+		}
+		packages = append(packages, pkg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по пакетам события: %w", err)
+	}
+
+	return packages, nil
+}
+
+// Update не переносит пакет в другое событие.
+func (r *EventPackageRepository) Update(ctx context.Context, pkg *models.EventPackage) error {
+	query := `
+        UPDATE event_packages
+        SET name = $1, tier = $2, price_per_guest = $3, min_guests = $4, max_guests = $5,
+            included_services = $6, is_active = $7
+        WHERE id = $8
+    `
+	commandTag, err := r.db.Exec(ctx, query,
+		pkg.Name,
+		pkg.Tier,
+		pkg.PricePerGuest,
+		pkg.MinGuests,
+		pkg.MaxGuests,
+		pkg.IncludedServices,
+		pkg.IsActive,
+		pkg.ID,
+	)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return errs.Conflict(i18n.CodePackageExists, pkg.Name)
+		}
+		return fmt.Errorf("не удалось обновить пакет события: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodePackageNotFound, pkg.ID)
+	}
+
+	return nil
+}
+
+func (r *EventPackageRepository) Delete(ctx context.Context, id int64) error {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM event_packages WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("не удалось удалить пакет события: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodePackageNotFound, id)
+	}
+
+	return nil
+}
+
+func scanEventPackage(row pgx.Row) (*models.EventPackage, error) {
+	var pkg models.EventPackage
+	err := row.Scan(
+		&pkg.ID,
+		&pkg.EventID,
+		&pkg.Name,
+		&pkg.Tier,
+		&pkg.PricePerGuest,
+		&pkg.MinGuests,
+		&pkg.MaxGuests,
+		&pkg.IncludedServices,
+		&pkg.IsActive,
+		&pkg.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pkg, nil
+}
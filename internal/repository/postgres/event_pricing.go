@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+type EventPricingRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewEventPricingRepository(db *pgxpool.Pool) *EventPricingRepository {
+	return &EventPricingRepository{db: db}
+}
+
+func (r *EventPricingRepository) Save(ctx context.Context, pricing *models.EventPricing) error {
+	days := make([]int16, len(pricing.WeekendDays))
+	for i, day := range pricing.WeekendDays {
+		days[i] = int16(day)
+	}
+
+	query := `
+        INSERT INTO event_pricing (restaurant_id, weekend_days, weekend_percent)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (restaurant_id) DO UPDATE
+        SET weekend_days = EXCLUDED.weekend_days,
+            weekend_percent = EXCLUDED.weekend_percent,
+            updated_at = CURRENT_TIMESTAMP
+        RETURNING updated_at
+    `
+	err := r.db.QueryRow(ctx, query,
+		pricing.RestaurantID,
+		days,
+		pricing.WeekendPercent,
+	).Scan(&pricing.UpdatedAt)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.NotFound(i18n.CodeRestaurantNotFound, pricing.RestaurantID)
+		}
+		return fmt.Errorf("не удалось сохранить надбавки к банкетам: %w", err)
+	}
+
+	return nil
+}
+
+func (r *EventPricingRepository) GetByRestaurant(ctx context.Context, restaurantID int64) (*models.EventPricing, error) {
+	query := `
+        SELECT restaurant_id, weekend_days, weekend_percent, updated_at
+        FROM event_pricing
+        WHERE restaurant_id = $1
+    `
+	var pricing models.EventPricing
+	var days []int16
+	err := r.db.QueryRow(ctx, query, restaurantID).Scan(
+		&pricing.RestaurantID,
+		&days,
+		&pricing.WeekendPercent,
+		&pricing.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("не удалось получить надбавки к банкетам: %w", err)
+	}
+
+	pricing.WeekendDays = make([]time.Weekday, len(days))
+	for i, day := range days {
+		pricing.WeekendDays[i] = time.Weekday(day)
+	}
+
+	return &pricing, nil
+}
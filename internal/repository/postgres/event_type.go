@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+const eventTypeColumns = `id, code, name_ru, name_kz, icon, is_active, created_at`
+
+type EventTypeRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewEventTypeRepository(db *pgxpool.Pool) *EventTypeRepository {
+	return &EventTypeRepository{db: db}
+}
+
+func (r *EventTypeRepository) Create(ctx context.Context, eventType *models.EventType) (int64, error) {
+	query := `
+        INSERT INTO event_types (code, name_ru, name_kz, icon, is_active)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id, created_at
+    `
+	err := r.db.QueryRow(ctx, query,
+		eventType.Code,
+		eventType.NameRU,
+		eventType.NameKZ,
+		eventType.Icon,
+		eventType.IsActive,
+	).Scan(&eventType.ID, &eventType.CreatedAt)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, errs.Conflict(i18n.CodeEventTypeExists, eventType.Code)
+		}
+		return 0, fmt.Errorf("не удалось создать тип события: %w", err)
+	}
+
+	return eventType.ID, nil
+}
+
+func (r *EventTypeRepository) GetByID(ctx context.Context, id int64) (*models.EventType, error) {
+	query := `SELECT ` + eventTypeColumns + ` FROM event_types WHERE id = $1`
+	eventType, err := scanEventType(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeEventTypeNotFound, id)
+		}
+		return nil, fmt.Errorf("не удалось получить тип события: %w", err)
+	}
+
+	return eventType, nil
+}
+
+func (r *EventTypeRepository) GetByCode(ctx context.Context, code string) (*models.EventType, error) {
+	query := `SELECT ` + eventTypeColumns + ` FROM event_types WHERE code = $1`
+	eventType, err := scanEventType(r.db.QueryRow(ctx, query, code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeEventTypeNotFound, code)
+		}
+		return nil, fmt.Errorf("не удалось получить тип события: %w", err)
+	}
+
+	return eventType, nil
+}
+
+// Update не меняет код типа: по нему тип хранится в событиях.
+func (r *EventTypeRepository) Update(ctx context.Context, eventType *models.EventType) error {
+	query := `
+        UPDATE event_types
+        SET name_ru = $1, name_kz = $2, icon = $3, is_active = $4
+        WHERE id = $5
+    `
+	commandTag, err := r.db.Exec(ctx, query,
+		eventType.NameRU,
+		eventType.NameKZ,
+		eventType.Icon,
+		eventType.IsActive,
+		eventType.ID,
+	)
+
+	if err != nil {
+		return fmt.Errorf("не удалось обновить тип события: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeEventTypeNotFound, eventType.ID)
+	}
+
+	return nil
+}
+
+// Delete удаляет тип, только если ни одно событие его не использует.
+func (r *EventTypeRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM event_types WHERE id = $1 RETURNING code`
+	var code string
+	err := r.db.QueryRow(ctx, query, id).Scan(&code)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errs.NotFound(i18n.CodeEventTypeNotFound, id)
+		}
+		if isForeignKeyViolation(err) {
+			return errs.Conflict(i18n.CodeEventTypeInUse, id)
+		}
+		return fmt.Errorf("не удалось удалить тип события: %w", err)
+	}
+
+	return nil
+}
+
+func (r *EventTypeRepository) List(ctx context.Context, includeInactive bool) ([]*models.EventType, error) {
+	query := `
+        SELECT ` + eventTypeColumns + `
+        FROM event_types
+        WHERE is_active OR $1
+        ORDER BY name_ru
+    `
+	rows, err := r.db.Query(ctx, query, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить список типов событий: %w", err)
+	}
+	defer rows.Close()
+
+	var eventTypes []*models.EventType
+	for rows.Next() {
+		eventType, err := scanEventType(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании типа события: %w", err)
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по типам событий: %w", err)
+	}
+
+	return eventTypes, nil
+}
+
+func scanEventType(row pgx.Row) (*models.EventType, error) {
+	var eventType models.EventType
+	err := row.Scan(
+		&eventType.ID,
+		&eventType.Code,
+		&eventType.NameRU,
+		&eventType.NameKZ,
+		&eventType.Icon,
+		&eventType.IsActive,
+		&eventType.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventType, nil
+}
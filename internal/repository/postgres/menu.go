@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 )
 
@@ -34,6 +36,9 @@ func (r *MenuRepository) Create(ctx context.Context, menu *models.Menu) (int64,
 	).Scan(&id)
 
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, errs.Invalid("restaurant_id", i18n.CodeRestaurantNotExist)
+		}
 		return 0, fmt.Errorf("не удалось создать меню: %w", err)
 	}
 
@@ -57,7 +62,7 @@ func (r *MenuRepository) GetByID(ctx context.Context, id int64) (*models.Menu, e
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("меню с ID %d не найдено", id)
+			return nil, errs.NotFound(i18n.CodeMenuNotFound, id)
 		}
 		return nil, fmt.Errorf("не удалось получить меню: %w", err)
 	}
@@ -115,11 +120,14 @@ func (r *MenuRepository) Update(ctx context.Context, menu *models.Menu) error {
 	)
 
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.Invalid("restaurant_id", i18n.CodeRestaurantNotExist)
+		}
 		return fmt.Errorf("не удалось обновить меню: %w", err)
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("меню с ID %d не найдено", menu.ID)
+		return errs.NotFound(i18n.CodeMenuNotFound, menu.ID)
 	}
 
 	return nil
@@ -134,7 +142,7 @@ func (r *MenuRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("меню с ID %d не найдено", id)
+		return errs.NotFound(i18n.CodeMenuNotFound, id)
 	}
 
 	return nil
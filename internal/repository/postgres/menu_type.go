@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 )
 
@@ -50,7 +52,7 @@ func (r *MenuTypeRepository) GetByID(ctx context.Context, id int64) (*models.Men
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("тип меню с ID %d не найден", id)
+			return nil, errs.NotFound(i18n.CodeMenuTypeNotFound, id)
 		}
 		return nil, fmt.Errorf("не удалось получить тип меню: %w", err)
 	}
@@ -71,7 +73,7 @@ func (r *MenuTypeRepository) Update(ctx context.Context, menuType *models.MenuTy
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("тип меню с ID %d не найден", menuType.ID)
+		return errs.NotFound(i18n.CodeMenuTypeNotFound, menuType.ID)
 	}
 
 	return nil
@@ -86,7 +88,7 @@ func (r *MenuTypeRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("тип меню с ID %d не найден", id)
+		return errs.NotFound(i18n.CodeMenuTypeNotFound, id)
 	}
 
 	return nil
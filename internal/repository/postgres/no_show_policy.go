@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+type NoShowPolicyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewNoShowPolicyRepository(db *pgxpool.Pool) *NoShowPolicyRepository {
+	return &NoShowPolicyRepository{db: db}
+}
+
+func (r *NoShowPolicyRepository) Save(ctx context.Context, policy *models.NoShowPolicy) error {
+	query := `
+        INSERT INTO no_show_policies (restaurant_id, deposit_after, deposit_amount, block_after)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (restaurant_id) DO UPDATE
+        SET deposit_after = EXCLUDED.deposit_after,
+            deposit_amount = EXCLUDED.deposit_amount,
+            block_after = EXCLUDED.block_after,
+            updated_at = CURRENT_TIMESTAMP
+        RETURNING updated_at
+    `
+	err := r.db.QueryRow(ctx, query,
+		policy.RestaurantID,
+		policy.DepositAfter,
+		policy.DepositAmount,
+		policy.BlockAfter,
+	).Scan(&policy.UpdatedAt)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.NotFound(i18n.CodeRestaurantNotFound, policy.RestaurantID)
+		}
+		return fmt.Errorf("не удалось сохранить правила неявок: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NoShowPolicyRepository) GetByRestaurant(ctx context.Context, restaurantID int64) (*models.NoShowPolicy, error) {
+	query := `
+        SELECT restaurant_id, deposit_after, deposit_amount, block_after, updated_at
+        FROM no_show_policies
+        WHERE restaurant_id = $1
+    `
+	var policy models.NoShowPolicy
+	err := r.db.QueryRow(ctx, query, restaurantID).Scan(
+		&policy.RestaurantID,
+		&policy.DepositAfter,
+		&policy.DepositAmount,
+		&policy.BlockAfter,
+		&policy.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("не удалось получить правила неявок: %w", err)
+	}
+
+	return &policy, nil
+}
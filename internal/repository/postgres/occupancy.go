@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+// Бронирования под события и бронирования столов записываются в общий
+// журнал table_occupancy. Пересечения по одному столу запрещает ограничение
+// исключения table_occupancy_no_overlap, поэтому проверка и запись не могут
+// разойтись при одновременных запросах.
+//
+// Промежуток [$3, $4) в запросах журнала: $1 и $2 заняты столами и
+// исключаемым бронированием. Для события это сутки от полуночи до полуночи
+// по времени ресторана, их границы считаются в Go с учетом часового пояса.
+const occupancyPeriod = `tstzrange($3, $4)`
+
+// Запись бронирования стола продлена на перерыв после гостей, чтобы его
+// соблюдало ограничение исключения. Читателям журнала нужен конец самого
+// визита: перерыв они добавляют сами. occupancyEnd берет его из бронирования,
+// для события это конец записи; запросы присоединяют бронирование как r.
+const (
+	occupancyEnd         = `COALESCE(r.start_time + r.duration_minutes * INTERVAL '1 minute', upper(o.period))`
+	occupancyVisit       = `tstzrange(lower(o.period), ` + occupancyEnd + `)`
+	occupancyReservation = `LEFT JOIN reservations r ON r.id = o.reservation_id`
+)
+
+type queryRower interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// occupancyConflict находит запись журнала, пересекающуюся с промежутком
+// period на одном из столов tableIDs, и превращает ее в ошибку конфликта.
+// Вызывается после нарушения ограничения исключения, чтобы клиент увидел,
+// чем занят стол.
+func occupancyConflict(ctx context.Context, db queryRower, tableIDs []int64, excludeReservationID int64,
+	period string, args ...interface{}) error {
+	query := `
+        SELECT o.table_id, lower(o.period), ` + occupancyEnd + `, o.event_id, o.reservation_id
+        FROM table_occupancy o
+        ` + occupancyReservation + `
+        WHERE o.table_id = ANY($1) AND o.reservation_id IS DISTINCT FROM $2 AND o.period && ` + period + `
+        ORDER BY lower(o.period), o.table_id
+        LIMIT 1
+    `
+	var occupancy models.TableOccupancy
+	err := db.QueryRow(ctx, query, occupancyArgs(tableIDs, excludeReservationID, args)...).Scan(
+		&occupancy.TableID,
+		&occupancy.StartsAt,
+		&occupancy.EndsAt,
+		&occupancy.EventID,
+		&occupancy.ReservationID,
+	)
+	if err != nil {
+		// Мешающая запись могла быть уже удалена, конфликт от этого не исчезает.
+		return errs.Conflict(i18n.CodeTableNotAvailable, tableIDs[0])
+	}
+
+	return errs.ConflictWith(&occupancy, i18n.CodeTableNotAvailable, occupancy.TableID)
+}
+
+// isTableFree сообщает, нет ли в журнале записей, пересекающихся с
+// промежутком period на столе tableID.
+func isTableFree(ctx context.Context, db queryRower, tableID, excludeReservationID int64,
+	period string, args ...interface{}) (bool, error) {
+	query := `
+        SELECT NOT EXISTS (
+            SELECT 1 FROM table_occupancy
+            WHERE table_id = ANY($1) AND reservation_id IS DISTINCT FROM $2 AND period && ` + period + `
+        )
+    `
+	tableIDs := []int64{tableID}
+	var free bool
+	if err := db.QueryRow(ctx, query, occupancyArgs(tableIDs, excludeReservationID, args)...).Scan(&free); err != nil {
+		return false, fmt.Errorf("не удалось проверить занятость стола: %w", err)
+	}
+
+	return free, nil
+}
+
+func occupancyArgs(tableIDs []int64, excludeReservationID int64, periodArgs []interface{}) []interface{} {
+	args := []interface{}{tableIDs, excludeReservationID}
+	return append(args, periodArgs...)
+}
+
+type OccupancyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOccupancyRepository(db *pgxpool.Pool) *OccupancyRepository {
+	return &OccupancyRepository{db: db}
+}
+
+func (r *OccupancyRepository) BusyTables(ctx context.Context, restaurantID int64, start, end time.Time) (map[int64]bool, error) {
+	query := `
+        SELECT DISTINCT o.table_id
+        FROM table_occupancy o
+        JOIN tables t ON t.id = o.table_id
+        JOIN sections s ON s.id = t.section_id
+        ` + occupancyReservation + `
+        WHERE s.restaurant_id = $1 AND o.period && tstzrange($2, $3) AND ` + occupancyVisit + ` && tstzrange($2, $3)
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить занятые столы: %w", err)
+	}
+	defer rows.Close()
+
+	busy := make(map[int64]bool)
+	for rows.Next() {
+		var tableID int64
+		if err := rows.Scan(&tableID); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании занятого стола: %w", err)
+		}
+		busy[tableID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по занятым столам: %w", err)
+	}
+
+	return busy, nil
+}
+
+func (r *OccupancyRepository) GetByRestaurant(ctx context.Context, restaurantID int64, start, end time.Time) ([]*models.TableOccupancy, error) {
+	query := `
+        SELECT o.table_id, lower(o.period), ` + occupancyEnd + `, o.event_id, o.reservation_id
+        FROM table_occupancy o
+        JOIN tables t ON t.id = o.table_id
+        JOIN sections s ON s.id = t.section_id
+        ` + occupancyReservation + `
+        WHERE s.restaurant_id = $1 AND o.period && tstzrange($2, $3) AND ` + occupancyVisit + ` && tstzrange($2, $3)
+        ORDER BY o.table_id, lower(o.period)
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить занятость столов: %w", err)
+	}
+	defer rows.Close()
+
+	var periods []*models.TableOccupancy
+	for rows.Next() {
+		var occupancy models.TableOccupancy
+		err := rows.Scan(
+			&occupancy.TableID,
+			&occupancy.StartsAt,
+			&occupancy.EndsAt,
+			&occupancy.EventID,
+			&occupancy.ReservationID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании занятости стола: %w", err)
+		}
+		periods = append(periods, &occupancy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по занятости столов: %w", err)
+	}
+
+	return periods, nil
+}
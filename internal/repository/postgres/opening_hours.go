@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+// timeOfDayLayout — формат времени работы в запросах: to_char возвращает
+// его, а ::time принимает обратно.
+const timeOfDayLayout = "HH24:MI"
+
+type OpeningHoursRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOpeningHoursRepository(db *pgxpool.Pool) *OpeningHoursRepository {
+	return &OpeningHoursRepository{db: db}
+}
+
+func (r *OpeningHoursRepository) GetWeekly(ctx context.Context, restaurantID int64) ([]*models.OpeningInterval, error) {
+	query := `
+        SELECT weekday, to_char(opens_at, '` + timeOfDayLayout + `'), to_char(closes_at, '` + timeOfDayLayout + `')
+        FROM restaurant_opening_hours
+        WHERE restaurant_id = $1
+        ORDER BY weekday, opens_at
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить расписание ресторана: %w", err)
+	}
+	defer rows.Close()
+
+	hours := []*models.OpeningInterval{}
+	for rows.Next() {
+		var interval models.OpeningInterval
+		if err := rows.Scan(&interval.Weekday, &interval.OpensAt, &interval.ClosesAt); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании расписания: %w", err)
+		}
+		hours = append(hours, &interval)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по расписанию: %w", err)
+	}
+
+	return hours, nil
+}
+
+// SetWeekly заменяет недельное расписание ресторана целиком.
+func (r *OpeningHoursRepository) SetWeekly(ctx context.Context, restaurantID int64, hours []*models.OpeningInterval) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM restaurant_opening_hours WHERE restaurant_id = $1`, restaurantID); err != nil {
+		return fmt.Errorf("не удалось удалить расписание ресторана: %w", err)
+	}
+
+	query := `
+        INSERT INTO restaurant_opening_hours (restaurant_id, weekday, opens_at, closes_at)
+        VALUES ($1, $2, $3::time, $4::time)
+    `
+	for _, interval := range hours {
+		_, err := tx.Exec(ctx, query, restaurantID, int(interval.Weekday), interval.OpensAt, interval.ClosesAt)
+		if err != nil {
+			if isForeignKeyViolation(err) {
+				return errs.NotFound(i18n.CodeRestaurantNotExist)
+			}
+			return fmt.Errorf("не удалось сохранить расписание ресторана: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось сохранить расписание ресторана: %w", err)
+	}
+
+	return nil
+}
+
+// GetSpecialDays возвращает особые дни в промежутке дат [from, to]
+// включительно, упорядоченные по дате.
+func (r *OpeningHoursRepository) GetSpecialDays(ctx context.Context, restaurantID int64, from, to time.Time) ([]*models.SpecialDay, error) {
+	query := `
+        SELECT day, to_char(opens_at, '` + timeOfDayLayout + `'), to_char(closes_at, '` + timeOfDayLayout + `'), note
+        FROM restaurant_special_days
+        WHERE restaurant_id = $1 AND day BETWEEN $2::date AND $3::date
+        ORDER BY day, opens_at NULLS FIRST
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID, from.Format(time.DateOnly), to.Format(time.DateOnly))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить особые дни ресторана: %w", err)
+	}
+	defer rows.Close()
+
+	days := []*models.SpecialDay{}
+	for rows.Next() {
+		var day time.Time
+		var opensAt, closesAt *string
+		var note string
+		if err := rows.Scan(&day, &opensAt, &closesAt, &note); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании особого дня: %w", err)
+		}
+
+		date := day.Format(time.DateOnly)
+		if len(days) == 0 || days[len(days)-1].Day != date {
+			days = append(days, &models.SpecialDay{
+				RestaurantID: restaurantID,
+				Day:          date,
+				Intervals:    []models.TimeInterval{},
+				Note:         note,
+			})
+		}
+		if opensAt != nil && closesAt != nil {
+			current := days[len(days)-1]
+			current.Intervals = append(current.Intervals, models.TimeInterval{OpensAt: *opensAt, ClosesAt: *closesAt})
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по особым дням: %w", err)
+	}
+
+	return days, nil
+}
+
+// SetSpecialDay заменяет особый день: закрытый день хранится одной строкой
+// без времени, сокращенный — строкой на каждый интервал.
+func (r *OpeningHoursRepository) SetSpecialDay(ctx context.Context, day *models.SpecialDay) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `DELETE FROM restaurant_special_days WHERE restaurant_id = $1 AND day = $2::date`,
+		day.RestaurantID, day.Day)
+	if err != nil {
+		return fmt.Errorf("не удалось удалить особый день: %w", err)
+	}
+
+	query := `
+        INSERT INTO restaurant_special_days (restaurant_id, day, opens_at, closes_at, note)
+        VALUES ($1, $2::date, $3::time, $4::time, $5)
+    `
+	insert := func(opensAt, closesAt *string) error {
+		_, err := tx.Exec(ctx, query, day.RestaurantID, day.Day, opensAt, closesAt, day.Note)
+		if err != nil {
+			if isForeignKeyViolation(err) {
+				return errs.NotFound(i18n.CodeRestaurantNotExist)
+			}
+			return fmt.Errorf("не удалось сохранить особый день: %w", err)
+		}
+		return nil
+	}
+
+	if len(day.Intervals) == 0 {
+		if err := insert(nil, nil); err != nil {
+			return err
+		}
+	}
+	for i := range day.Intervals {
+		if err := insert(&day.Intervals[i].OpensAt, &day.Intervals[i].ClosesAt); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось сохранить особый день: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OpeningHoursRepository) DeleteSpecialDay(ctx context.Context, restaurantID int64, day string) error {
+	query := `DELETE FROM restaurant_special_days WHERE restaurant_id = $1 AND day = $2::date`
+	commandTag, err := r.db.Exec(ctx, query, restaurantID, day)
+	if err != nil {
+		return fmt.Errorf("не удалось удалить особый день: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeSpecialDayNotFound, day)
+	}
+
+	return nil
+}
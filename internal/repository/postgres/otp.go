@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+type OTPRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOTPRepository(db *pgxpool.Pool) *OTPRepository {
+	return &OTPRepository{db: db}
+}
+
+func (r *OTPRepository) Upsert(ctx context.Context, otp *models.OTPCode) error {
+	query := `
+        INSERT INTO otp_codes (phone_number, code_hash, attempts, expires_at, created_at)
+        VALUES ($1, $2, 0, $3, NOW())
+        ON CONFLICT (phone_number) DO UPDATE
+        SET code_hash = EXCLUDED.code_hash, attempts = 0,
+            expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at
+    `
+	_, err := r.db.Exec(ctx, query, otp.PhoneNumber, otp.CodeHash, otp.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("не удалось сохранить код подтверждения: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OTPRepository) GetByPhone(ctx context.Context, phone string) (*models.OTPCode, error) {
+	query := `
+        SELECT phone_number, code_hash, attempts, expires_at, created_at
+        FROM otp_codes
+        WHERE phone_number = $1
+    `
+	var otp models.OTPCode
+	err := r.db.QueryRow(ctx, query, phone).Scan(
+		&otp.PhoneNumber,
+		&otp.CodeHash,
+		&otp.Attempts,
+		&otp.ExpiresAt,
+		&otp.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeOTPNotFound, phone)
+		}
+		return nil, fmt.Errorf("не удалось получить код подтверждения: %w", err)
+	}
+
+	return &otp, nil
+}
+
+func (r *OTPRepository) UseAttempt(ctx context.Context, phone string, maxAttempts int) (*models.OTPCode, error) {
+	query := `
+        UPDATE otp_codes SET attempts = attempts + 1
+        WHERE phone_number = $1 AND attempts < $2
+        RETURNING phone_number, code_hash, attempts, expires_at, created_at
+    `
+	var otp models.OTPCode
+	err := r.db.QueryRow(ctx, query, phone, maxAttempts).Scan(
+		&otp.PhoneNumber,
+		&otp.CodeHash,
+		&otp.Attempts,
+		&otp.ExpiresAt,
+		&otp.CreatedAt,
+	)
+
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("не удалось обновить количество попыток: %w", err)
+		}
+		if _, err := r.GetByPhone(ctx, phone); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	return &otp, nil
+}
+
+func (r *OTPRepository) Delete(ctx context.Context, phone string) error {
+	query := `DELETE FROM otp_codes WHERE phone_number = $1`
+	_, err := r.db.Exec(ctx, query, phone)
+	if err != nil {
+		return fmt.Errorf("не удалось удалить код подтверждения: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,402 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+const reservationColumns = `id, restaurant_id, table_id, combination_id, user_id, party_size, start_time, duration_minutes,
+               status, special_requests, no_show_forgiven_at, created_at, updated_at`
+
+// ReservationRepository хранит бронирования столов. buffer — перерыв после
+// гостей, на который продлевается запись бронирования в журнале занятости.
+type ReservationRepository struct {
+	db     *pgxpool.Pool
+	buffer time.Duration
+}
+
+func NewReservationRepository(db *pgxpool.Pool, buffer time.Duration) *ReservationRepository {
+	return &ReservationRepository{db: db, buffer: buffer}
+}
+
+// Create сохраняет бронирование и занимает его столы в журнале занятости в
+// одной транзакции. Депозит бронирования, если он нужен, сохраняется в той
+// же транзакции.
+func (r *ReservationRepository) Create(ctx context.Context, reservation *models.Reservation) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertReservation(ctx, tx, reservation, r.buffer); err != nil {
+		tx.Rollback(ctx)
+		return 0, reservationCreateError(ctx, r.db, reservation, r.buffer, err)
+	}
+
+	if reservation.Deposit != nil {
+		reservation.Deposit.ReservationID = &reservation.ID
+		if err := insertDeposit(ctx, tx, reservation.Deposit); err != nil {
+			return 0, fmt.Errorf("не удалось сохранить депозит бронирования: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("не удалось создать бронирование: %w", err)
+	}
+
+	return reservation.ID, nil
+}
+
+// AverageTurnTime возвращает среднее время, которое гости ресторана
+// проводят за столом, по завершенным с since бронированиям: от начала до
+// перевода в completed. Если таких бронирований нет, возвращается ноль.
+func (r *ReservationRepository) AverageTurnTime(ctx context.Context, restaurantID int64, since time.Time) (time.Duration, error) {
+	query := `
+        SELECT COALESCE(EXTRACT(EPOCH FROM AVG(updated_at - start_time)), 0)
+        FROM reservations
+        WHERE restaurant_id = $1 AND status = 'completed' AND start_time >= $2 AND updated_at > start_time
+    `
+	var seconds float64
+	if err := r.db.QueryRow(ctx, query, restaurantID, since).Scan(&seconds); err != nil {
+		return 0, fmt.Errorf("не удалось посчитать среднее время посадки: %w", err)
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func (r *ReservationRepository) GetByID(ctx context.Context, id int64) (*models.Reservation, error) {
+	query := `SELECT ` + reservationColumns + ` FROM reservations WHERE id = $1`
+
+	reservation, err := scanReservation(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeReservationNotFound, id)
+		}
+		return nil, fmt.Errorf("не удалось получить бронирование: %w", err)
+	}
+
+	return reservation, nil
+}
+
+func (r *ReservationRepository) GetByRestaurant(ctx context.Context, restaurantID int64, filter models.ReservationFilter) ([]*models.Reservation, error) {
+	conditions := []string{"restaurant_id = $1"}
+	args := []interface{}{restaurantID}
+	where := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.From != nil {
+		where("start_time >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		where("start_time < $%d", *filter.To)
+	}
+	if filter.Status != "" {
+		where("status = $%d", filter.Status)
+	}
+
+	query := `SELECT ` + reservationColumns + ` FROM reservations WHERE ` +
+		strings.Join(conditions, " AND ") + ` ORDER BY start_time, id`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить бронирования ресторана: %w", err)
+	}
+
+	return scanReservations(rows)
+}
+
+func (r *ReservationRepository) GetByUser(ctx context.Context, userID int64) ([]*models.Reservation, error) {
+	query := `SELECT ` + reservationColumns + ` FROM reservations WHERE user_id = $1 ORDER BY start_time DESC, id DESC`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить бронирования пользователя: %w", err)
+	}
+
+	return scanReservations(rows)
+}
+
+// Update меняет бронирование и заново занимает его столы в журнале
+// занятости.
+func (r *ReservationRepository) Update(ctx context.Context, reservation *models.Reservation) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE reservations
+        SET table_id = $1, combination_id = $2, party_size = $3, start_time = $4, duration_minutes = $5,
+            special_requests = $6, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $7
+    `
+	commandTag, err := tx.Exec(ctx, query,
+		reservation.TableID,
+		reservation.CombinationID,
+		reservation.PartySize,
+		reservation.StartTime,
+		reservation.DurationMinutes,
+		reservation.SpecialRequests,
+		reservation.ID,
+	)
+	if err == nil && commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeReservationNotFound, reservation.ID)
+	}
+	if err == nil {
+		err = occupy(ctx, tx, reservation, r.buffer)
+	}
+
+	if err != nil {
+		switch {
+		case isExclusionViolation(err):
+			tx.Rollback(ctx)
+			return reservationConflict(ctx, r.db, reservation, reservation.ID, r.buffer)
+		case isForeignKeyViolation(err):
+			return errs.Invalid("table_id", i18n.CodeTableNotExists)
+		}
+		return fmt.Errorf("не удалось обновить бронирование: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось обновить бронирование: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus меняет статус бронирования, только если он все еще from:
+// иначе параллельные отмена, подтверждение и посадка затирали бы друг друга.
+// Завершенное, отмененное бронирование и неявка освобождают стол в журнале
+// занятости. Неявка учитывается в счетчике гостя только тем запросом,
+// который сменил статус, поэтому повторная отметка не засчитывается дважды.
+func (r *ReservationRepository) UpdateStatus(ctx context.Context, id int64, from, status models.ReservationStatus) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE reservations SET status = $1, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND status = $3
+        RETURNING user_id
+    `
+	var userID int64
+	if err := tx.QueryRow(ctx, query, status, id, from).Scan(&userID); err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("не удалось изменить статус бронирования: %w", err)
+		}
+
+		tx.Rollback(ctx)
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return errs.Conflict(i18n.CodeReservationChanged, id)
+	}
+
+	if !occupiesTable(status) {
+		if _, err := tx.Exec(ctx, `DELETE FROM table_occupancy WHERE reservation_id = $1`, id); err != nil {
+			return fmt.Errorf("не удалось освободить стол: %w", err)
+		}
+
+		query = `UPDATE event_deposits SET status = 'cancelled' WHERE reservation_id = $1 AND status = 'pending'`
+		if _, err := tx.Exec(ctx, query, id); err != nil {
+			return fmt.Errorf("не удалось отменить депозит бронирования: %w", err)
+		}
+	}
+
+	if status == models.ReservationNoShow {
+		query = `UPDATE users SET no_show_count = no_show_count + 1 WHERE id = $1`
+		if _, err := tx.Exec(ctx, query, userID); err != nil {
+			return fmt.Errorf("не удалось учесть неявку гостя: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось изменить статус бронирования: %w", err)
+	}
+
+	return nil
+}
+
+// ForgiveNoShow снимает неявку со счетчика гостя. Неявку можно простить
+// один раз; иначе возвращается конфликт.
+func (r *ReservationRepository) ForgiveNoShow(ctx context.Context, id int64) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE reservations SET no_show_forgiven_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $1 AND status = 'no_show' AND no_show_forgiven_at IS NULL
+        RETURNING user_id
+    `
+	var userID int64
+	if err := tx.QueryRow(ctx, query, id).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errs.Conflict(i18n.CodeNoShowNotForgivable, id)
+		}
+		return fmt.Errorf("не удалось простить неявку: %w", err)
+	}
+
+	query = `UPDATE users SET no_show_count = GREATEST(no_show_count - 1, 0) WHERE id = $1`
+	if _, err := tx.Exec(ctx, query, userID); err != nil {
+		return fmt.Errorf("не удалось обновить счетчик неявок гостя: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось простить неявку: %w", err)
+	}
+
+	return nil
+}
+
+// insertReservation сохраняет бронирование и занимает его столы в журнале
+// занятости внутри транзакции tx.
+func insertReservation(ctx context.Context, tx pgx.Tx, reservation *models.Reservation, buffer time.Duration) error {
+	query := `
+        INSERT INTO reservations (restaurant_id, table_id, combination_id, user_id, party_size, start_time,
+                                  duration_minutes, status, special_requests)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        RETURNING id, created_at, updated_at
+    `
+	err := tx.QueryRow(ctx, query,
+		reservation.RestaurantID,
+		reservation.TableID,
+		reservation.CombinationID,
+		reservation.UserID,
+		reservation.PartySize,
+		reservation.StartTime,
+		reservation.DurationMinutes,
+		reservation.Status,
+		reservation.SpecialRequests,
+	).Scan(&reservation.ID, &reservation.CreatedAt, &reservation.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	return occupy(ctx, tx, reservation, buffer)
+}
+
+// reservationCreateError превращает ошибку insertReservation в ответ
+// клиенту. Вызывается после отката транзакции, чтобы найти мешающую запись
+// журнала.
+func reservationCreateError(ctx context.Context, db queryRower, reservation *models.Reservation,
+	buffer time.Duration, err error) error {
+	switch {
+	case isExclusionViolation(err):
+		return reservationConflict(ctx, db, reservation, 0, buffer)
+	case isForeignKeyViolation(err):
+		return errs.Validation(i18n.CodeReservationRefsNotExist)
+	}
+	return fmt.Errorf("не удалось создать бронирование: %w", err)
+}
+
+// occupy записывает или переносит промежуток действующего бронирования в
+// журнале занятости: по записи на каждый стол. Столы сочетания занимаются
+// одним запросом, поэтому ограничение исключения пропускает либо все, либо
+// ни одного. Запись продлевается на перерыв buffer, чтобы ограничение не
+// пропустило бронирование вплотную к соседнему.
+func occupy(ctx context.Context, tx pgx.Tx, reservation *models.Reservation, buffer time.Duration) error {
+	if !occupiesTable(reservation.Status) {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM table_occupancy WHERE reservation_id = $1`, reservation.ID); err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO table_occupancy (reservation_id, table_id, period)
+        SELECT $1, unnest($2::bigint[]), tstzrange($3, $4)
+    `
+	_, err := tx.Exec(ctx, query, reservation.ID, reservationTables(reservation),
+		reservation.StartTime, reservationEnd(reservation).Add(buffer))
+	return err
+}
+
+func reservationConflict(ctx context.Context, db queryRower, reservation *models.Reservation, excludeID int64,
+	buffer time.Duration) error {
+	return occupancyConflict(ctx, db, reservationTables(reservation), excludeID, occupancyPeriod,
+		reservation.StartTime, reservationEnd(reservation).Add(buffer))
+}
+
+// reservationTables возвращает столы, которые занимает бронирование: все
+// столы сочетания или один стол.
+func reservationTables(reservation *models.Reservation) []int64 {
+	if reservation.CombinationID != nil && len(reservation.TableIDs) > 0 {
+		return reservation.TableIDs
+	}
+	return []int64{reservation.TableID}
+}
+
+func reservationEnd(reservation *models.Reservation) time.Time {
+	return reservation.StartTime.Add(time.Duration(reservation.DurationMinutes) * time.Minute)
+}
+
+func occupiesTable(status models.ReservationStatus) bool {
+	switch status {
+	case models.ReservationPending, models.ReservationConfirmed, models.ReservationSeated:
+		return true
+	}
+	return false
+}
+
+func scanReservation(row pgx.Row) (*models.Reservation, error) {
+	var reservation models.Reservation
+	err := row.Scan(
+		&reservation.ID,
+		&reservation.RestaurantID,
+		&reservation.TableID,
+		&reservation.CombinationID,
+		&reservation.UserID,
+		&reservation.PartySize,
+		&reservation.StartTime,
+		&reservation.DurationMinutes,
+		&reservation.Status,
+		&reservation.SpecialRequests,
+		&reservation.NoShowForgivenAt,
+		&reservation.CreatedAt,
+		&reservation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+func scanReservations(rows pgx.Rows) ([]*models.Reservation, error) {
+	defer rows.Close()
+
+	var reservations []*models.Reservation
+	for rows.Next() {
+		reservation, err := scanReservation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании бронирования: %w", err)
+		}
+		reservations = append(reservations, reservation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по бронированиям: %w", err)
+	}
+
+	return reservations, nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 )
 
@@ -21,8 +23,8 @@ func NewRestaurantRepository(db *pgxpool.Pool) *RestaurantRepository {
 
 func (r *RestaurantRepository) Create(ctx context.Context, restaurant *models.Restaurant) (int64, error) {
 	query := `
-        INSERT INTO restaurants (name, city_id, address_ru, address_kz, is_active, _2gis_map)
-        VALUES ($1, $2, $3, $4, $5, $6)
+        INSERT INTO restaurants (name, city_id, address_ru, address_kz, is_active, _2gis_map, time_zone)
+        VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''))
         RETURNING id
     `
 	var id int64
@@ -33,9 +35,13 @@ func (r *RestaurantRepository) Create(ctx context.Context, restaurant *models.Re
 		restaurant.AddressKZ,
 		restaurant.IsActive,
 		restaurant.Map2GIS,
+		restaurant.TimeZone,
 	).Scan(&id)
 
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, errs.Invalid("city_id", i18n.CodeCityNotExists)
+		}
 		return 0, fmt.Errorf("не удалось создать ресторан: %w", err)
 	}
 
@@ -44,7 +50,7 @@ func (r *RestaurantRepository) Create(ctx context.Context, restaurant *models.Re
 
 func (r *RestaurantRepository) GetByID(ctx context.Context, id int64) (*models.Restaurant, error) {
 	query := `
-        SELECT id, name, city_id, address_ru, address_kz, is_active, _2gis_map
+        SELECT id, name, city_id, address_ru, address_kz, is_active, _2gis_map, COALESCE(time_zone, '')
         FROM restaurants
         WHERE id = $1
     `
@@ -57,11 +63,12 @@ func (r *RestaurantRepository) GetByID(ctx context.Context, id int64) (*models.R
 		&restaurant.AddressKZ,
 		&restaurant.IsActive,
 		&restaurant.Map2GIS,
+		&restaurant.TimeZone,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("ресторан с ID %d не найден", id)
+			return nil, errs.NotFound(i18n.CodeRestaurantNotFound, id)
 		}
 		return nil, fmt.Errorf("не удалось получить ресторан: %w", err)
 	}
@@ -71,7 +78,7 @@ func (r *RestaurantRepository) GetByID(ctx context.Context, id int64) (*models.R
 
 func (r *RestaurantRepository) GetByCity(ctx context.Context, cityID int64) ([]*models.Restaurant, error) {
 	query := `
-        SELECT id, name, city_id, address_ru, address_kz, is_active, _2gis_map
+        SELECT id, name, city_id, address_ru, address_kz, is_active, _2gis_map, COALESCE(time_zone, '')
         FROM restaurants
         WHERE city_id = $1
         ORDER BY name
@@ -93,6 +100,7 @@ func (r *RestaurantRepository) GetByCity(ctx context.Context, cityID int64) ([]*
 			&restaurant.AddressKZ,
 			&restaurant.IsActive,
 			&restaurant.Map2GIS,
+			&restaurant.TimeZone,
 		); err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании ресторана: %w", err)
 		}
@@ -110,8 +118,9 @@ func (r *RestaurantRepository) GetByCity(ctx context.Context, cityID int64) ([]*
 func (r *RestaurantRepository) Update(ctx context.Context, restaurant *models.Restaurant) error {
 	query := `
         UPDATE restaurants
-        SET name = $1, city_id = $2, address_ru = $3, address_kz = $4, is_active = $5, _2gis_map = $6
-        WHERE id = $7
+        SET name = $1, city_id = $2, address_ru = $3, address_kz = $4, is_active = $5, _2gis_map = $6,
+            time_zone = NULLIF($7, '')
+        WHERE id = $8
     `
 	commandTag, err := r.db.Exec(ctx, query,
 		restaurant.Name,
@@ -120,15 +129,19 @@ func (r *RestaurantRepository) Update(ctx context.Context, restaurant *models.Re
 		restaurant.AddressKZ,
 		restaurant.IsActive,
 		restaurant.Map2GIS,
+		restaurant.TimeZone,
 		restaurant.ID,
 	)
 
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.Invalid("city_id", i18n.CodeCityNotExists)
+		}
 		return fmt.Errorf("не удалось обновить ресторан: %w", err)
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("ресторан с ID %d не найден", restaurant.ID)
+		return errs.NotFound(i18n.CodeRestaurantNotFound, restaurant.ID)
 	}
 
 	return nil
@@ -143,7 +156,7 @@ func (r *RestaurantRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("ресторан с ID %d не найден", id)
+		return errs.NotFound(i18n.CodeRestaurantNotFound, id)
 	}
 
 	return nil
@@ -155,14 +168,14 @@ func (r *RestaurantRepository) List(ctx context.Context, active bool) ([]*models
 
 	if active {
 		query = `
-            SELECT id, name, city_id, address_ru, address_kz, is_active, _2gis_map
+            SELECT id, name, city_id, address_ru, address_kz, is_active, _2gis_map, COALESCE(time_zone, '')
             FROM restaurants
             WHERE is_active = true
             ORDER BY name
         `
 	} else {
 		query = `
-            SELECT id, name, city_id, address_ru, address_kz, is_active, _2gis_map
+            SELECT id, name, city_id, address_ru, address_kz, is_active, _2gis_map, COALESCE(time_zone, '')
             FROM restaurants
             ORDER BY name
         `
@@ -185,6 +198,7 @@ func (r *RestaurantRepository) List(ctx context.Context, active bool) ([]*models
 			&restaurant.AddressKZ,
 			&restaurant.IsActive,
 			&restaurant.Map2GIS,
+			&restaurant.TimeZone,
 		); err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании ресторана: %w", err)
 		}
@@ -197,3 +211,23 @@ func (r *RestaurantRepository) List(ctx context.Context, active bool) ([]*models
 
 	return restaurants, nil
 }
+
+// GetTimeZone возвращает часовой пояс ресторана, а если он не задан — пояс
+// его города.
+func (r *RestaurantRepository) GetTimeZone(ctx context.Context, id int64) (string, error) {
+	query := `
+        SELECT COALESCE(r.time_zone, c.time_zone)
+        FROM restaurants r
+        JOIN cities c ON c.id = r.city_id
+        WHERE r.id = $1
+    `
+	var timeZone string
+	if err := r.db.QueryRow(ctx, query, id).Scan(&timeZone); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", errs.NotFound(i18n.CodeRestaurantNotFound, id)
+		}
+		return "", fmt.Errorf("не удалось получить часовой пояс ресторана: %w", err)
+	}
+
+	return timeZone, nil
+}
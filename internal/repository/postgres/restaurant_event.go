@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 )
 
@@ -21,20 +23,26 @@ func NewRestaurantEventRepository(db *pgxpool.Pool) *RestaurantEventRepository {
 
 func (r *RestaurantEventRepository) Create(ctx context.Context, event *models.RestaurantEvent) (int64, error) {
 	query := `
-        INSERT INTO restaurant_events (name, eventtype, "desc", price, img)
-        VALUES ($1, $2, $3, $4, $5)
+        INSERT INTO restaurant_events (restaurant_id, name, eventtype, "desc", price, img, deposit_type, deposit_value)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
         RETURNING id
     `
 	var id int64
 	err := r.db.QueryRow(ctx, query,
+		event.RestaurantID,
 		event.Name,
 		event.EventType,
 		event.Description,
 		event.Price,
 		event.Img,
+		event.DepositType,
+		event.DepositValue,
 	).Scan(&id)
 
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, eventReferenceError(err, event)
+		}
 		return 0, fmt.Errorf("не удалось создать событие ресторана: %w", err)
 	}
 
@@ -43,23 +51,26 @@ func (r *RestaurantEventRepository) Create(ctx context.Context, event *models.Re
 
 func (r *RestaurantEventRepository) GetByID(ctx context.Context, id int64) (*models.RestaurantEvent, error) {
 	query := `
-        SELECT id, name, eventtype, "desc", price, img
+        SELECT id, restaurant_id, name, eventtype, "desc", price, img, deposit_type, deposit_value
         FROM restaurant_events
         WHERE id = $1
     `
 	var event models.RestaurantEvent
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&event.ID,
+		&event.RestaurantID,
 		&event.Name,
 		&event.EventType,
 		&event.Description,
 		&event.Price,
 		&event.Img,
+		&event.DepositType,
+		&event.DepositValue,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("событие ресторана с ID %d не найдено", id)
+			return nil, errs.NotFound(i18n.CodeEventNotFound, id)
 		}
 		return nil, fmt.Errorf("не удалось получить событие ресторана: %w", err)
 	}
@@ -67,9 +78,9 @@ func (r *RestaurantEventRepository) GetByID(ctx context.Context, id int64) (*mod
 	return &event, nil
 }
 
-func (r *RestaurantEventRepository) GetByType(ctx context.Context, eventType models.EventType) ([]*models.RestaurantEvent, error) {
+func (r *RestaurantEventRepository) GetByType(ctx context.Context, eventType string) ([]*models.RestaurantEvent, error) {
 	query := `
-        SELECT id, name, eventtype, "desc", price, img
+        SELECT id, restaurant_id, name, eventtype, "desc", price, img, deposit_type, deposit_value
         FROM restaurant_events
         WHERE eventtype = $1
         ORDER BY name
@@ -85,11 +96,53 @@ func (r *RestaurantEventRepository) GetByType(ctx context.Context, eventType mod
 		var event models.RestaurantEvent
 		if err := rows.Scan(
 			&event.ID,
+			&event.RestaurantID,
+			&event.Name,
+			&event.EventType,
+			&event.Description,
+			&event.Price,
+			&event.Img,
+			&event.DepositType,
+			&event.DepositValue,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании события ресторана: %w", err)
+		}
+		events = append(events, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по событиям ресторана: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *RestaurantEventRepository) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.RestaurantEvent, error) {
+	query := `
+        SELECT id, restaurant_id, name, eventtype, "desc", price, img, deposit_type, deposit_value
+        FROM restaurant_events
+        WHERE restaurant_id = $1
+        ORDER BY name
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить события ресторана: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*models.RestaurantEvent
+	for rows.Next() {
+		var event models.RestaurantEvent
+		if err := rows.Scan(
+			&event.ID,
+			&event.RestaurantID,
 			&event.Name,
 			&event.EventType,
 			&event.Description,
 			&event.Price,
 			&event.Img,
+			&event.DepositType,
+			&event.DepositValue,
 		); err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании события ресторана: %w", err)
 		}
@@ -106,24 +159,31 @@ func (r *RestaurantEventRepository) GetByType(ctx context.Context, eventType mod
 func (r *RestaurantEventRepository) Update(ctx context.Context, event *models.RestaurantEvent) error {
 	query := `
         UPDATE restaurant_events
-        SET name = $1, eventtype = $2, "desc" = $3, price = $4, img = $5
-        WHERE id = $6
+        SET restaurant_id = $1, name = $2, eventtype = $3, "desc" = $4, price = $5, img = $6,
+            deposit_type = $7, deposit_value = $8
+        WHERE id = $9
     `
 	commandTag, err := r.db.Exec(ctx, query,
+		event.RestaurantID,
 		event.Name,
 		event.EventType,
 		event.Description,
 		event.Price,
 		event.Img,
+		event.DepositType,
+		event.DepositValue,
 		event.ID,
 	)
 
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return eventReferenceError(err, event)
+		}
 		return fmt.Errorf("не удалось обновить событие ресторана: %w", err)
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("событие ресторана с ID %d не найдено", event.ID)
+		return errs.NotFound(i18n.CodeEventNotFound, event.ID)
 	}
 
 	return nil
@@ -138,7 +198,7 @@ func (r *RestaurantEventRepository) Delete(ctx context.Context, id int64) error
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("событие ресторана с ID %d не найдено", id)
+		return errs.NotFound(i18n.CodeEventNotFound, id)
 	}
 
 	return nil
@@ -146,7 +206,7 @@ func (r *RestaurantEventRepository) Delete(ctx context.Context, id int64) error
 
 func (r *RestaurantEventRepository) List(ctx context.Context) ([]*models.RestaurantEvent, error) {
 	query := `
-        SELECT id, name, eventtype, "desc", price, img
+        SELECT id, restaurant_id, name, eventtype, "desc", price, img, deposit_type, deposit_value
         FROM restaurant_events
         ORDER BY name
     `
@@ -161,11 +221,14 @@ func (r *RestaurantEventRepository) List(ctx context.Context) ([]*models.Restaur
 		var event models.RestaurantEvent
 		if err := rows.Scan(
 			&event.ID,
+			&event.RestaurantID,
 			&event.Name,
 			&event.EventType,
 			&event.Description,
 			&event.Price,
 			&event.Img,
+			&event.DepositType,
+			&event.DepositValue,
 		); err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании события ресторана: %w", err)
 		}
@@ -178,3 +241,12 @@ func (r *RestaurantEventRepository) List(ctx context.Context) ([]*models.Restaur
 
 	return events, nil
 }
+
+// eventReferenceError различает, на что ссылается нарушенный внешний ключ
+// события: тип мог быть удален после проверки в usecase.
+func eventReferenceError(err error, event *models.RestaurantEvent) error {
+	if violatedConstraint(err) == "restaurant_events_eventtype_fkey" {
+		return errs.Invalid("event_type", i18n.CodeEventTypeUnknown, event.EventType)
+	}
+	return errs.Invalid("restaurant_id", i18n.CodeRestaurantNotExist)
+}
@@ -0,0 +1,295 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+// bookingDayLayout — формат даты бронирования в сообщениях об ошибках.
+const bookingDayLayout = "2006-01-02"
+
+type RestaurantEventTableRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRestaurantEventTableRepository(db *pgxpool.Pool) *RestaurantEventTableRepository {
+	return &RestaurantEventTableRepository{db: db}
+}
+
+// Create бронирует столы и занимает их в журнале на весь день в одной
+// транзакции: столы сочетания либо заняты все, либо ни один. Если стол в
+// этот день уже занят, возвращается конфликт с описанием мешающей записи.
+func (r *RestaurantEventTableRepository) Create(ctx context.Context, bookings []*models.RestaurantEventTable,
+	deposit *models.EventDeposit) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if deposit != nil {
+		if err := insertDeposit(ctx, tx, deposit); err != nil {
+			if isForeignKeyViolation(err) {
+				return errs.Validation(i18n.CodeEventOrTableNotExist)
+			}
+			return fmt.Errorf("не удалось создать депозит: %w", err)
+		}
+		for _, booking := range bookings {
+			booking.DepositID = &deposit.ID
+		}
+	}
+
+	if err := insertEventTables(ctx, tx, r.db, bookings); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось забронировать стол: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RestaurantEventTableRepository) GetByEvent(ctx context.Context, eventID int64) ([]*models.RestaurantEventTable, error) {
+	query := `
+        SELECT event_id, table_id, booking_date, combination_id, status, deposit_id
+        FROM restaurant_event_tables
+        WHERE event_id = $1
+        ORDER BY booking_date, table_id
+    `
+	rows, err := r.db.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить бронирования события: %w", err)
+	}
+
+	return scanEventTables(rows)
+}
+
+func (r *RestaurantEventTableRepository) GetByTable(ctx context.Context, tableID int64) ([]*models.RestaurantEventTable, error) {
+	query := `
+        SELECT event_id, table_id, booking_date, combination_id, status, deposit_id
+        FROM restaurant_event_tables
+        WHERE table_id = $1
+        ORDER BY booking_date, event_id
+    `
+	rows, err := r.db.Query(ctx, query, tableID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить бронирования стола: %w", err)
+	}
+
+	return scanEventTables(rows)
+}
+
+func (r *RestaurantEventTableRepository) GetByRestaurant(ctx context.Context, restaurantID int64,
+	from, to time.Time) ([]*models.RestaurantEventTable, error) {
+	query := `
+        SELECT b.event_id, b.table_id, b.booking_date, b.combination_id, b.status, b.deposit_id
+        FROM restaurant_event_tables b
+        JOIN tables t ON t.id = b.table_id
+        JOIN sections s ON s.id = t.section_id
+        WHERE s.restaurant_id = $1 AND b.booking_date >= $2 AND b.booking_date < $3
+        ORDER BY b.booking_date, b.event_id, b.table_id
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить бронирования ресторана под события: %w", err)
+	}
+
+	return scanEventTables(rows)
+}
+
+// Delete снимает бронирование стола. Если стол забронирован в составе
+// сочетания, снимаются все столы сочетания. Неоплаченный депозит
+// бронирования отменяется, а бронирование без депозита переносится в
+// cancelled_event_bookings.
+func (r *RestaurantEventTableRepository) Delete(ctx context.Context, eventID, tableID int64, date time.Time) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        WITH deleted AS (
+            DELETE FROM restaurant_event_tables
+            WHERE event_id = $1 AND booking_date = $3
+              AND (table_id = $2 OR combination_id = (
+                  SELECT combination_id FROM restaurant_event_tables
+                  WHERE event_id = $1 AND table_id = $2 AND booking_date = $3
+              ))
+            RETURNING event_id, table_id, booking_date, combination_id, deposit_id
+        ), archived AS (
+            INSERT INTO cancelled_event_bookings (event_id, table_id, booking_date, combination_id)
+            SELECT event_id, table_id, booking_date, combination_id FROM deleted WHERE deposit_id IS NULL
+            ON CONFLICT (event_id, table_id, booking_date)
+            DO UPDATE SET combination_id = EXCLUDED.combination_id, cancelled_at = CURRENT_TIMESTAMP
+        )
+        SELECT deposit_id FROM deleted
+    `
+	rows, err := tx.Query(ctx, query, eventID, tableID, date)
+	if err != nil {
+		return fmt.Errorf("не удалось отменить бронирование стола: %w", err)
+	}
+
+	deleted := 0
+	var depositIDs []int64
+	for rows.Next() {
+		var depositID *int64
+		if err := rows.Scan(&depositID); err != nil {
+			rows.Close()
+			return fmt.Errorf("не удалось отменить бронирование стола: %w", err)
+		}
+		deleted++
+		if depositID != nil {
+			depositIDs = append(depositIDs, *depositID)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("не удалось отменить бронирование стола: %w", err)
+	}
+
+	if deleted == 0 {
+		return errs.NotFound(i18n.CodeBookingNotFound, tableID, date.Format(bookingDayLayout))
+	}
+
+	if len(depositIDs) > 0 {
+		query = `UPDATE event_deposits SET status = 'cancelled' WHERE id = ANY($1) AND status = 'pending'`
+		if _, err := tx.Exec(ctx, query, depositIDs); err != nil {
+			return fmt.Errorf("не удалось отменить депозит бронирования: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось отменить бронирование стола: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RestaurantEventTableRepository) GetCancelled(ctx context.Context, restaurantID int64,
+	from, to time.Time) ([]*models.CancelledEventBooking, error) {
+	query := `
+        SELECT c.event_id, c.table_id, c.booking_date, c.combination_id, c.cancelled_at
+        FROM cancelled_event_bookings c
+        JOIN tables t ON t.id = c.table_id
+        JOIN sections s ON s.id = t.section_id
+        WHERE s.restaurant_id = $1 AND c.booking_date >= $2 AND c.booking_date < $3
+        ORDER BY c.booking_date, c.event_id, c.table_id
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить отмененные бронирования ресторана: %w", err)
+	}
+	defer rows.Close()
+
+	var bookings []*models.CancelledEventBooking
+	for rows.Next() {
+		var booking models.CancelledEventBooking
+		if err := rows.Scan(
+			&booking.EventID,
+			&booking.TableID,
+			&booking.BookingDate,
+			&booking.CombinationID,
+			&booking.CancelledAt,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании бронирования: %w", err)
+		}
+		bookings = append(bookings, &booking)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по бронированиям: %w", err)
+	}
+
+	return bookings, nil
+}
+
+// CheckAvailability сообщает, свободен ли стол весь указанный день: ни
+// события, ни бронирования стола в этот день нет.
+func (r *RestaurantEventTableRepository) CheckAvailability(ctx context.Context, tableID int64, date time.Time) (bool, error) {
+	return isTableFree(ctx, r.db, tableID, 0, occupancyPeriod, date, eventDayEnd(date))
+}
+
+// insertEventTables занимает столы в транзакции tx. Если стол уже занят,
+// транзакция откатывается, а мешающая запись журнала читается через db.
+func insertEventTables(ctx context.Context, tx pgx.Tx, db queryRower, bookings []*models.RestaurantEventTable) error {
+	for _, booking := range bookings {
+		if err := insertEventTable(ctx, tx, booking); err != nil {
+			switch {
+			case isUniqueViolation(err):
+				return errs.Conflict(i18n.CodeTableAlreadyBooked, booking.TableID, booking.BookingDate.Format(bookingDayLayout))
+			case isExclusionViolation(err):
+				tx.Rollback(ctx)
+				return occupancyConflict(ctx, db, []int64{booking.TableID}, 0, occupancyPeriod,
+					booking.BookingDate, eventDayEnd(booking.BookingDate))
+			case isForeignKeyViolation(err):
+				return errs.Validation(i18n.CodeEventOrTableNotExist)
+			}
+			return fmt.Errorf("не удалось забронировать стол: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func insertEventTable(ctx context.Context, tx pgx.Tx, booking *models.RestaurantEventTable) error {
+	query := `
+        INSERT INTO restaurant_event_tables (event_id, table_id, booking_date, combination_id, status, deposit_id)
+        VALUES ($1, $2, $3, $4, $5, $6)
+    `
+	_, err := tx.Exec(ctx, query, booking.EventID, booking.TableID, booking.BookingDate, booking.CombinationID,
+		booking.Status, booking.DepositID)
+	if err != nil {
+		return err
+	}
+
+	query = `
+        INSERT INTO table_occupancy (event_id, table_id, booking_date, period)
+        VALUES ($1, $2, $3, ` + occupancyPeriod + `)
+    `
+	_, err = tx.Exec(ctx, query, booking.EventID, booking.TableID, booking.BookingDate,
+		eventDayEnd(booking.BookingDate))
+	return err
+}
+
+// eventDayEnd возвращает следующую полночь по времени ресторана. date должна
+// быть в часовом поясе ресторана, тогда сутки перехода на летнее время
+// получаются нужной длины.
+func eventDayEnd(date time.Time) time.Time {
+	return date.AddDate(0, 0, 1)
+}
+
+func scanEventTables(rows pgx.Rows) ([]*models.RestaurantEventTable, error) {
+	defer rows.Close()
+
+	var bookings []*models.RestaurantEventTable
+	for rows.Next() {
+		var booking models.RestaurantEventTable
+		if err := rows.Scan(
+			&booking.EventID,
+			&booking.TableID,
+			&booking.BookingDate,
+			&booking.CombinationID,
+			&booking.Status,
+			&booking.DepositID,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании бронирования: %w", err)
+		}
+		bookings = append(bookings, &booking)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по бронированиям: %w", err)
+	}
+
+	return bookings, nil
+}
@@ -5,10 +5,11 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 )
 
@@ -22,26 +23,20 @@ func NewSectionRepository(db *pgxpool.Pool) *SectionRepository {
 
 func (r *SectionRepository) Create(ctx context.Context, section *models.Section) (int64, error) {
 	query := `
-        INSERT INTO sections (restaurant_id, name)
-        VALUES ($1, $2)
+        INSERT INTO sections (restaurant_id, name, tags)
+        VALUES ($1, $2, $3)
         RETURNING id
     `
 	var id int64
-	err := r.db.QueryRow(ctx, query, section.RestaurantID, section.Name).Scan(&id)
+	err := r.db.QueryRow(ctx, query, section.RestaurantID, section.Name, tagsOrEmpty(section.Tags)).Scan(&id)
 
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case "23505":
-				return 0, fmt.Errorf("секция с таким именем уже существует в этом ресторане")
-			case "23503":
-				return 0, fmt.Errorf("указанный ресторан с ID %d не существует", section.RestaurantID)
-			default:
-				return 0, fmt.Errorf("ошибка базы данных: %s", pgErr.Message)
-			}
+		switch {
+		case isUniqueViolation(err):
+			return 0, errs.Conflict(i18n.CodeSectionNameTaken, section.Name)
+		case isForeignKeyViolation(err):
+			return 0, errs.Invalid("restaurant_id", i18n.CodeRestaurantNotExist)
 		}
-
 		return 0, fmt.Errorf("не удалось создать секцию: %w", err)
 	}
 
@@ -50,7 +45,7 @@ func (r *SectionRepository) Create(ctx context.Context, section *models.Section)
 
 func (r *SectionRepository) GetByID(ctx context.Context, id int64) (*models.Section, error) {
 	query := `
-        SELECT id, restaurant_id, name
+        SELECT id, restaurant_id, name, tags
         FROM sections
         WHERE id = $1
     `
@@ -59,11 +54,12 @@ func (r *SectionRepository) GetByID(ctx context.Context, id int64) (*models.Sect
 		&section.ID,
 		&section.RestaurantID,
 		&section.Name,
+		&section.Tags,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("секция с ID %d не найдена", id)
+			return nil, errs.NotFound(i18n.CodeSectionNotFound, id)
 		}
 		return nil, fmt.Errorf("не удалось получить секцию: %w", err)
 	}
@@ -73,7 +69,7 @@ func (r *SectionRepository) GetByID(ctx context.Context, id int64) (*models.Sect
 
 func (r *SectionRepository) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.Section, error) {
 	query := `
-        SELECT id, restaurant_id, name
+        SELECT id, restaurant_id, name, tags
         FROM sections
         WHERE restaurant_id = $1
         ORDER BY name
@@ -87,7 +83,7 @@ func (r *SectionRepository) GetByRestaurant(ctx context.Context, restaurantID in
 	var sections []*models.Section
 	for rows.Next() {
 		var section models.Section
-		err := rows.Scan(&section.ID, &section.RestaurantID, &section.Name)
+		err := rows.Scan(&section.ID, &section.RestaurantID, &section.Name, &section.Tags)
 		if err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании секции: %w", err)
 		}
@@ -104,17 +100,23 @@ func (r *SectionRepository) GetByRestaurant(ctx context.Context, restaurantID in
 func (r *SectionRepository) Update(ctx context.Context, section *models.Section) error {
 	query := `
         UPDATE sections
-        SET restaurant_id = $1, name = $2
-        WHERE id = $3
+        SET restaurant_id = $1, name = $2, tags = $3
+        WHERE id = $4
     `
-	commandTag, err := r.db.Exec(ctx, query, section.RestaurantID, section.Name, section.ID)
+	commandTag, err := r.db.Exec(ctx, query, section.RestaurantID, section.Name, tagsOrEmpty(section.Tags), section.ID)
 
 	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return errs.Conflict(i18n.CodeSectionNameTaken, section.Name)
+		case isForeignKeyViolation(err):
+			return errs.Invalid("restaurant_id", i18n.CodeRestaurantNotExist)
+		}
 		return fmt.Errorf("не удалось обновить секцию: %w", err)
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("секция с ID %d не найдена", section.ID)
+		return errs.NotFound(i18n.CodeSectionNotFound, section.ID)
 	}
 
 	return nil
@@ -129,7 +131,7 @@ func (r *SectionRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("секция с ID %d не найдена", id)
+		return errs.NotFound(i18n.CodeSectionNotFound, id)
 	}
 
 	return nil
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+const sectionBlackoutColumns = `b.id, b.section_id, b.starts_at, b.ends_at, b.reason, b.created_at`
+
+type SectionBlackoutRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSectionBlackoutRepository(db *pgxpool.Pool) *SectionBlackoutRepository {
+	return &SectionBlackoutRepository{db: db}
+}
+
+func (r *SectionBlackoutRepository) Create(ctx context.Context, blackout *models.SectionBlackout) (int64, error) {
+	query := `
+        INSERT INTO section_blackouts (section_id, starts_at, ends_at, reason)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, created_at
+    `
+	err := r.db.QueryRow(ctx, query, blackout.SectionID, blackout.StartsAt, blackout.EndsAt, blackout.Reason).
+		Scan(&blackout.ID, &blackout.CreatedAt)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, errs.NotFound(i18n.CodeSectionNotExists)
+		}
+		return 0, fmt.Errorf("не удалось создать закрытие секции: %w", err)
+	}
+
+	return blackout.ID, nil
+}
+
+func (r *SectionBlackoutRepository) GetByID(ctx context.Context, id int64) (*models.SectionBlackout, error) {
+	query := `SELECT ` + sectionBlackoutColumns + ` FROM section_blackouts b WHERE b.id = $1`
+	blackouts, err := r.query(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blackouts) == 0 {
+		return nil, errs.NotFound(i18n.CodeBlackoutNotFound, id)
+	}
+
+	return blackouts[0], nil
+}
+
+func (r *SectionBlackoutRepository) GetBySection(ctx context.Context, sectionID int64) ([]*models.SectionBlackout, error) {
+	query := `
+        SELECT ` + sectionBlackoutColumns + `
+        FROM section_blackouts b
+        WHERE b.section_id = $1
+        ORDER BY b.starts_at
+    `
+	return r.query(ctx, query, sectionID)
+}
+
+func (r *SectionBlackoutRepository) GetByRestaurant(ctx context.Context, restaurantID int64, start, end time.Time) ([]*models.SectionBlackout, error) {
+	query := `
+        SELECT ` + sectionBlackoutColumns + `
+        FROM section_blackouts b
+        JOIN sections s ON s.id = b.section_id
+        WHERE s.restaurant_id = $1 AND b.starts_at < $3 AND b.ends_at > $2
+        ORDER BY b.section_id, b.starts_at
+    `
+	return r.query(ctx, query, restaurantID, start, end)
+}
+
+func (r *SectionBlackoutRepository) Delete(ctx context.Context, id int64) error {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM section_blackouts WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("не удалось удалить закрытие секции: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeBlackoutNotFound, id)
+	}
+
+	return nil
+}
+
+func (r *SectionBlackoutRepository) query(ctx context.Context, query string, args ...interface{}) ([]*models.SectionBlackout, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить закрытия секций: %w", err)
+	}
+	defer rows.Close()
+
+	blackouts := []*models.SectionBlackout{}
+	for rows.Next() {
+		var blackout models.SectionBlackout
+		err := rows.Scan(
+			&blackout.ID,
+			&blackout.SectionID,
+			&blackout.StartsAt,
+			&blackout.EndsAt,
+			&blackout.Reason,
+			&blackout.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании закрытия секции: %w", err)
+		}
+		blackouts = append(blackouts, &blackout)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по закрытиям секций: %w", err)
+	}
+
+	return blackouts, nil
+}
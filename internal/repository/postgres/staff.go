@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+type StaffRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewStaffRepository(db *pgxpool.Pool) *StaffRepository {
+	return &StaffRepository{db: db}
+}
+
+func (r *StaffRepository) Assign(ctx context.Context, assignment *models.StaffAssignment) error {
+	query := `
+        INSERT INTO restaurant_staff (user_id, restaurant_id, role)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (user_id, restaurant_id) DO UPDATE SET role = EXCLUDED.role
+    `
+	_, err := r.db.Exec(ctx, query, assignment.UserID, assignment.RestaurantID, assignment.Role)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.NotFound(i18n.CodeUserOrRestaurantNotExist)
+		}
+		return fmt.Errorf("не удалось назначить сотрудника: %w", err)
+	}
+
+	return nil
+}
+
+func (r *StaffRepository) Remove(ctx context.Context, userID, restaurantID int64) error {
+	query := `DELETE FROM restaurant_staff WHERE user_id = $1 AND restaurant_id = $2`
+	commandTag, err := r.db.Exec(ctx, query, userID, restaurantID)
+
+	if err != nil {
+		return fmt.Errorf("не удалось удалить назначение сотрудника: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeStaffNotFound, userID, restaurantID)
+	}
+
+	return nil
+}
+
+func (r *StaffRepository) Get(ctx context.Context, userID, restaurantID int64) (*models.StaffAssignment, error) {
+	query := `
+        SELECT user_id, restaurant_id, role
+        FROM restaurant_staff
+        WHERE user_id = $1 AND restaurant_id = $2
+    `
+	var assignment models.StaffAssignment
+	err := r.db.QueryRow(ctx, query, userID, restaurantID).Scan(
+		&assignment.UserID,
+		&assignment.RestaurantID,
+		&assignment.Role,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeStaffNotFound, userID, restaurantID)
+		}
+		return nil, fmt.Errorf("не удалось получить назначение сотрудника: %w", err)
+	}
+
+	return &assignment, nil
+}
+
+func (r *StaffRepository) GetByUser(ctx context.Context, userID int64) ([]*models.StaffAssignment, error) {
+	query := `
+        SELECT user_id, restaurant_id, role
+        FROM restaurant_staff
+        WHERE user_id = $1
+        ORDER BY restaurant_id
+    `
+	return r.list(ctx, query, userID)
+}
+
+func (r *StaffRepository) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.StaffAssignment, error) {
+	query := `
+        SELECT user_id, restaurant_id, role
+        FROM restaurant_staff
+        WHERE restaurant_id = $1
+        ORDER BY role, user_id
+    `
+	return r.list(ctx, query, restaurantID)
+}
+
+func (r *StaffRepository) list(ctx context.Context, query string, args ...interface{}) ([]*models.StaffAssignment, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить список сотрудников: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []*models.StaffAssignment
+	for rows.Next() {
+		var assignment models.StaffAssignment
+		if err := rows.Scan(
+			&assignment.UserID,
+			&assignment.RestaurantID,
+			&assignment.Role,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании сотрудника: %w", err)
+		}
+		assignments = append(assignments, &assignment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по сотрудникам: %w", err)
+	}
+
+	return assignments, nil
+}
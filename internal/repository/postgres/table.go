@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 )
 
@@ -21,14 +23,28 @@ func NewTableRepository(db *pgxpool.Pool) *TableRepository {
 
 func (r *TableRepository) Create(ctx context.Context, table *models.Table) (int64, error) {
 	query := `
-        INSERT INTO tables (number_of_table, section_id, qr)
-        VALUES ($1, $2, $3)
+        INSERT INTO tables (number_of_table, section_id, qr, min_seats, max_seats, shape, tags)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
         RETURNING id
     `
 	var id int64
-	err := r.db.QueryRow(ctx, query, table.NumberOfTable, table.SectionID, table.QR).Scan(&id)
+	err := r.db.QueryRow(ctx, query,
+		table.NumberOfTable,
+		table.SectionID,
+		table.QR,
+		table.MinSeats,
+		table.MaxSeats,
+		table.Shape,
+		tagsOrEmpty(table.Tags),
+	).Scan(&id)
 
 	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return 0, errs.Conflict(i18n.CodeTableNumberTaken, table.NumberOfTable)
+		case isForeignKeyViolation(err):
+			return 0, errs.Invalid("section_id", i18n.CodeSectionNotExists)
+		}
 		return 0, fmt.Errorf("не удалось создать столик: %w", err)
 	}
 
@@ -37,7 +53,7 @@ func (r *TableRepository) Create(ctx context.Context, table *models.Table) (int6
 
 func (r *TableRepository) GetByID(ctx context.Context, id int64) (*models.Table, error) {
 	query := `
-        SELECT id, number_of_table, section_id, qr
+        SELECT id, number_of_table, section_id, qr, min_seats, max_seats, shape, tags
         FROM tables
         WHERE id = $1
     `
@@ -47,11 +63,15 @@ func (r *TableRepository) GetByID(ctx context.Context, id int64) (*models.Table,
 		&table.NumberOfTable,
 		&table.SectionID,
 		&table.QR,
+		&table.MinSeats,
+		&table.MaxSeats,
+		&table.Shape,
+		&table.Tags,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("столик с ID %d не найден", id)
+			return nil, errs.NotFound(i18n.CodeTableNotFound, id)
 		}
 		return nil, fmt.Errorf("не удалось получить столик: %w", err)
 	}
@@ -61,7 +81,7 @@ func (r *TableRepository) GetByID(ctx context.Context, id int64) (*models.Table,
 
 func (r *TableRepository) GetBySection(ctx context.Context, sectionID int64) ([]*models.Table, error) {
 	query := `
-        SELECT id, number_of_table, section_id, qr
+        SELECT id, number_of_table, section_id, qr, min_seats, max_seats, shape, tags
         FROM tables
         WHERE section_id = $1
         ORDER BY number_of_table
@@ -80,6 +100,55 @@ func (r *TableRepository) GetBySection(ctx context.Context, sectionID int64) ([]
 			&table.NumberOfTable,
 			&table.SectionID,
 			&table.QR,
+			&table.MinSeats,
+			&table.MaxSeats,
+			&table.Shape,
+			&table.Tags,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании столика: %w", err)
+		}
+		tables = append(tables, &table)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по столикам: %w", err)
+	}
+
+	return tables, nil
+}
+
+// GetByRestaurant возвращает все столы ресторана одним запросом. Теги
+// секции объединяются с тегами стола.
+func (r *TableRepository) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.RestaurantTable, error) {
+	query := `
+        SELECT t.id, t.number_of_table, t.section_id, t.qr, t.min_seats, t.max_seats, t.shape,
+               ARRAY(SELECT DISTINCT unnest(t.tags || s.tags) ORDER BY 1),
+               s.restaurant_id, s.name
+        FROM tables t
+        JOIN sections s ON s.id = t.section_id
+        WHERE s.restaurant_id = $1
+        ORDER BY s.id, t.number_of_table
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить столики ресторана: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []*models.RestaurantTable
+	for rows.Next() {
+		var table models.RestaurantTable
+		if err := rows.Scan(
+			&table.ID,
+			&table.NumberOfTable,
+			&table.SectionID,
+			&table.QR,
+			&table.MinSeats,
+			&table.MaxSeats,
+			&table.Shape,
+			&table.Tags,
+			&table.RestaurantID,
+			&table.SectionName,
 		); err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании столика: %w", err)
 		}
@@ -96,17 +165,33 @@ func (r *TableRepository) GetBySection(ctx context.Context, sectionID int64) ([]
 func (r *TableRepository) Update(ctx context.Context, table *models.Table) error {
 	query := `
         UPDATE tables
-        SET number_of_table = $1, section_id = $2, qr = $3
-        WHERE id = $4
+        SET number_of_table = $1, section_id = $2, qr = $3, min_seats = $4, max_seats = $5,
+            shape = $6, tags = $7
+        WHERE id = $8
     `
-	commandTag, err := r.db.Exec(ctx, query, table.NumberOfTable, table.SectionID, table.QR, table.ID)
+	commandTag, err := r.db.Exec(ctx, query,
+		table.NumberOfTable,
+		table.SectionID,
+		table.QR,
+		table.MinSeats,
+		table.MaxSeats,
+		table.Shape,
+		tagsOrEmpty(table.Tags),
+		table.ID,
+	)
 
 	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return errs.Conflict(i18n.CodeTableNumberTaken, table.NumberOfTable)
+		case isForeignKeyViolation(err):
+			return errs.Invalid("section_id", i18n.CodeSectionNotExists)
+		}
 		return fmt.Errorf("не удалось обновить столик: %w", err)
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("столик с ID %d не найден", table.ID)
+		return errs.NotFound(i18n.CodeTableNotFound, table.ID)
 	}
 
 	return nil
@@ -121,7 +206,7 @@ func (r *TableRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("столик с ID %d не найден", id)
+		return errs.NotFound(i18n.CodeTableNotFound, id)
 	}
 
 	return nil
@@ -138,3 +223,11 @@ func (r *TableRepository) GenerateQR(ctx context.Context, tableID int64) (string
 
 	return qrCode, nil
 }
+
+// tagsOrEmpty подставляет пустой массив вместо nil: колонки тегов NOT NULL.
+func tagsOrEmpty(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
@@ -0,0 +1,221 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+// Столы сочетания упорядочены по номеру: первый из них — основной стол
+// бронирования.
+const tableCombinationColumns = `c.id, c.section_id, c.name, c.min_seats, c.max_seats,
+               ARRAY(SELECT m.table_id::bigint
+                     FROM table_combination_members m
+                     JOIN tables t ON t.id = m.table_id
+                     WHERE m.combination_id = c.id
+                     ORDER BY t.number_of_table) AS table_ids`
+
+type TableCombinationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTableCombinationRepository(db *pgxpool.Pool) *TableCombinationRepository {
+	return &TableCombinationRepository{db: db}
+}
+
+// Create сохраняет сочетание вместе со столами в одной транзакции.
+func (r *TableCombinationRepository) Create(ctx context.Context, combination *models.TableCombination) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO table_combinations (section_id, name, min_seats, max_seats)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id
+    `
+	err = tx.QueryRow(ctx, query,
+		combination.SectionID,
+		combination.Name,
+		combination.MinSeats,
+		combination.MaxSeats,
+	).Scan(&combination.ID)
+	if err == nil {
+		err = insertCombinationMembers(ctx, tx, combination)
+	}
+
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, errs.Invalid("table_ids", i18n.CodeTableNotExists)
+		}
+		return 0, fmt.Errorf("не удалось создать сочетание столов: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("не удалось создать сочетание столов: %w", err)
+	}
+
+	return combination.ID, nil
+}
+
+func (r *TableCombinationRepository) GetByID(ctx context.Context, id int64) (*models.TableCombination, error) {
+	query := `SELECT ` + tableCombinationColumns + ` FROM table_combinations c WHERE c.id = $1`
+	combinations, err := r.query(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(combinations) == 0 {
+		return nil, errs.NotFound(i18n.CodeCombinationNotFound, id)
+	}
+
+	return combinations[0], nil
+}
+
+func (r *TableCombinationRepository) GetBySection(ctx context.Context, sectionID int64) ([]*models.TableCombination, error) {
+	query := `
+        SELECT ` + tableCombinationColumns + `
+        FROM table_combinations c
+        WHERE c.section_id = $1
+        ORDER BY c.max_seats, c.id
+    `
+	return r.query(ctx, query, sectionID)
+}
+
+func (r *TableCombinationRepository) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.TableCombination, error) {
+	query := `
+        SELECT ` + tableCombinationColumns + `
+        FROM table_combinations c
+        JOIN sections s ON s.id = c.section_id
+        WHERE s.restaurant_id = $1
+        ORDER BY c.max_seats, c.id
+    `
+	return r.query(ctx, query, restaurantID)
+}
+
+// Update меняет сочетание и заменяет его столы.
+func (r *TableCombinationRepository) Update(ctx context.Context, combination *models.TableCombination) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE table_combinations
+        SET name = $1, min_seats = $2, max_seats = $3
+        WHERE id = $4
+    `
+	commandTag, err := tx.Exec(ctx, query,
+		combination.Name,
+		combination.MinSeats,
+		combination.MaxSeats,
+		combination.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("не удалось обновить сочетание столов: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeCombinationNotFound, combination.ID)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM table_combination_members WHERE combination_id = $1`, combination.ID)
+	if err == nil {
+		err = insertCombinationMembers(ctx, tx, combination)
+	}
+
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.Invalid("table_ids", i18n.CodeTableNotExists)
+		}
+		return fmt.Errorf("не удалось обновить столы сочетания: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("не удалось обновить сочетание столов: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TableCombinationRepository) Delete(ctx context.Context, id int64) error {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM table_combinations WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("не удалось удалить сочетание столов: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeCombinationNotFound, id)
+	}
+
+	return nil
+}
+
+// InUse ищет в журнале занятости будущие записи бронирований и событий,
+// сделанных на сочетание.
+func (r *TableCombinationRepository) InUse(ctx context.Context, id int64) (bool, error) {
+	query := `
+        SELECT EXISTS (
+            SELECT 1
+            FROM table_occupancy o
+            LEFT JOIN reservations r ON r.id = o.reservation_id
+            LEFT JOIN restaurant_event_tables b
+                ON b.event_id = o.event_id AND b.table_id = o.table_id AND b.booking_date = o.booking_date
+            WHERE (r.combination_id = $1 OR b.combination_id = $1) AND upper(o.period) > CURRENT_TIMESTAMP
+        )
+    `
+	var inUse bool
+	if err := r.db.QueryRow(ctx, query, id).Scan(&inUse); err != nil {
+		return false, fmt.Errorf("не удалось проверить бронирования сочетания: %w", err)
+	}
+
+	return inUse, nil
+}
+
+func (r *TableCombinationRepository) query(ctx context.Context, query string, args ...interface{}) ([]*models.TableCombination, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить сочетания столов: %w", err)
+	}
+	defer rows.Close()
+
+	var combinations []*models.TableCombination
+	for rows.Next() {
+		var combination models.TableCombination
+		if err := rows.Scan(
+			&combination.ID,
+			&combination.SectionID,
+			&combination.Name,
+			&combination.MinSeats,
+			&combination.MaxSeats,
+			&combination.TableIDs,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании сочетания столов: %w", err)
+		}
+		combinations = append(combinations, &combination)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по сочетаниям столов: %w", err)
+	}
+
+	return combinations, nil
+}
+
+func insertCombinationMembers(ctx context.Context, tx pgx.Tx, combination *models.TableCombination) error {
+	query := `
+        INSERT INTO table_combination_members (combination_id, table_id)
+        SELECT $1, unnest($2::bigint[])
+    `
+	_, err := tx.Exec(ctx, query, combination.ID, combination.TableIDs)
+	return err
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 )
 
@@ -21,15 +23,18 @@ func NewUserRepository(db *pgxpool.Pool) *UserRepository {
 
 func (r *UserRepository) Create(ctx context.Context, user *models.User) (int64, error) {
 	query := `
-        INSERT INTO users (phone_number, name, last_name, language, is_active)
-        VALUES ($1, $2, $3, $4, $5)
+        INSERT INTO users (phone_number, name, last_name, language, is_active, role)
+        VALUES ($1, $2, $3, $4, $5, $6)
         RETURNING id
     `
 	var id int64
 	err := r.db.QueryRow(ctx, query, user.PhoneNumber, user.Name, user.LastName,
-		user.Language, user.IsActive).Scan(&id)
+		user.Language, user.IsActive, user.Role).Scan(&id)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, errs.Conflict(i18n.CodeUserPhoneExists, user.PhoneNumber)
+		}
 		return 0, fmt.Errorf("не удалось создать пользователя: %w", err)
 	}
 
@@ -38,7 +43,7 @@ func (r *UserRepository) Create(ctx context.Context, user *models.User) (int64,
 
 func (r *UserRepository) GetByID(ctx context.Context, id int64) (*models.User, error) {
 	query := `
-        SELECT id, phone_number, name, last_name, language, is_active
+        SELECT id, phone_number, name, last_name, language, is_active, role, no_show_count, deleted_at, anonymized_at
         FROM users
         WHERE id = $1
     `
@@ -50,11 +55,15 @@ func (r *UserRepository) GetByID(ctx context.Context, id int64) (*models.User, e
 		&user.LastName,
 		&user.Language,
 		&user.IsActive,
+		&user.Role,
+		&user.NoShowCount,
+		&user.DeletedAt,
+		&user.AnonymizedAt,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("пользователь с ID %d не найден", id)
+			return nil, errs.NotFound(i18n.CodeUserNotFound, id)
 		}
 		return nil, fmt.Errorf("не удалось получить пользователя: %w", err)
 	}
@@ -64,9 +73,9 @@ func (r *UserRepository) GetByID(ctx context.Context, id int64) (*models.User, e
 
 func (r *UserRepository) GetByPhone(ctx context.Context, phone string) (*models.User, error) {
 	query := `
-        SELECT id, phone_number, name, last_name, language, is_active
+        SELECT id, phone_number, name, last_name, language, is_active, role, no_show_count, deleted_at, anonymized_at
         FROM users
-        WHERE phone_number = $1
+        WHERE phone_number = $1 AND deleted_at IS NULL
     `
 	var user models.User
 	err := r.db.QueryRow(ctx, query, phone).Scan(
@@ -76,11 +85,15 @@ func (r *UserRepository) GetByPhone(ctx context.Context, phone string) (*models.
 		&user.LastName,
 		&user.Language,
 		&user.IsActive,
+		&user.Role,
+		&user.NoShowCount,
+		&user.DeletedAt,
+		&user.AnonymizedAt,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("пользователь с номером телефона %s не найден", phone)
+			return nil, errs.NotFound(i18n.CodeUserPhoneNotFound, phone)
 		}
 		return nil, fmt.Errorf("не удалось получить пользователя: %w", err)
 	}
@@ -91,8 +104,8 @@ func (r *UserRepository) GetByPhone(ctx context.Context, phone string) (*models.
 func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
 	query := `
         UPDATE users
-        SET phone_number = $1, name = $2, last_name = $3, language = $4, is_active = $5
-        WHERE id = $6
+        SET phone_number = $1, name = $2, last_name = $3, language = $4, is_active = $5, role = $6
+        WHERE id = $7 AND deleted_at IS NULL
     `
 	commandTag, err := r.db.Exec(ctx, query,
 		user.PhoneNumber,
@@ -100,22 +113,26 @@ func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
 		user.LastName,
 		user.Language,
 		user.IsActive,
+		user.Role,
 		user.ID,
 	)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return errs.Conflict(i18n.CodeUserPhoneExists, user.PhoneNumber)
+		}
 		return fmt.Errorf("не удалось обновить пользователя: %w", err)
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("пользователь с ID %d не найден", user.ID)
+		return errs.NotFound(i18n.CodeUserNotFound, user.ID)
 	}
 
 	return nil
 }
 
 func (r *UserRepository) Delete(ctx context.Context, id int64) error {
-	query := `DELETE FROM users WHERE id = $1`
+	query := `UPDATE users SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL`
 	commandTag, err := r.db.Exec(ctx, query, id)
 
 	if err != nil {
@@ -123,7 +140,55 @@ func (r *UserRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("пользователь с ID %d не найден", id)
+		return errs.NotFound(i18n.CodeUserNotFound, id)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) Restore(ctx context.Context, id int64) error {
+	query := `
+        UPDATE users
+        SET deleted_at = NULL
+        WHERE id = $1 AND deleted_at IS NOT NULL AND anonymized_at IS NULL
+    `
+	commandTag, err := r.db.Exec(ctx, query, id)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return errs.Conflict(i18n.CodeUserPhoneTaken, id)
+		}
+		return fmt.Errorf("не удалось восстановить пользователя: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeDeletedUserNotFound, id)
+	}
+
+	return nil
+}
+
+// Anonymize стирает персональные данные пользователя, сохраняя саму запись,
+// чтобы ссылки на нее из истории оставались валидными.
+func (r *UserRepository) Anonymize(ctx context.Context, id int64) error {
+	query := `
+        UPDATE users
+        SET phone_number = 'anon-' || id,
+            name = 'Анонимный пользователь',
+            last_name = '',
+            is_active = FALSE,
+            deleted_at = COALESCE(deleted_at, CURRENT_TIMESTAMP),
+            anonymized_at = CURRENT_TIMESTAMP
+        WHERE id = $1 AND anonymized_at IS NULL
+    `
+	commandTag, err := r.db.Exec(ctx, query, id)
+
+	if err != nil {
+		return fmt.Errorf("не удалось анонимизировать пользователя: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.NotFound(i18n.CodeUserNotFoundOrAnonymized, id)
 	}
 
 	return nil
@@ -131,8 +196,9 @@ func (r *UserRepository) Delete(ctx context.Context, id int64) error {
 
 func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*models.User, error) {
 	query := `
-        SELECT id, phone_number, name, last_name, language, is_active
+        SELECT id, phone_number, name, last_name, language, is_active, role, no_show_count, deleted_at, anonymized_at
         FROM users
+        WHERE deleted_at IS NULL
         ORDER BY id
         LIMIT $1 OFFSET $2
     `
@@ -152,6 +218,10 @@ func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*models
 			&user.LastName,
 			&user.Language,
 			&user.IsActive,
+			&user.Role,
+			&user.NoShowCount,
+			&user.DeletedAt,
+			&user.AnonymizedAt,
 		); err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании пользователя: %w", err)
 		}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+const waitlistColumns = `id, restaurant_id, user_id, party_size, note, status, quoted_wait_minutes,
+               reservation_id, created_at, notified_at, updated_at`
+
+// WaitlistRepository хранит очередь гостей без брони. buffer — перерыв
+// после гостей для записи в журнале занятости, как у ReservationRepository.
+type WaitlistRepository struct {
+	db     *pgxpool.Pool
+	buffer time.Duration
+}
+
+func NewWaitlistRepository(db *pgxpool.Pool, buffer time.Duration) *WaitlistRepository {
+	return &WaitlistRepository{db: db, buffer: buffer}
+}
+
+func (r *WaitlistRepository) Create(ctx context.Context, entry *models.WaitlistEntry) (int64, error) {
+	query := `
+        INSERT INTO waitlist_entries (restaurant_id, user_id, party_size, note, status, quoted_wait_minutes)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING id, created_at, updated_at
+    `
+	err := r.db.QueryRow(ctx, query,
+		entry.RestaurantID,
+		entry.UserID,
+		entry.PartySize,
+		entry.Note,
+		entry.Status,
+		entry.QuotedWaitMinutes,
+	).Scan(&entry.ID, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, errs.Validation(i18n.CodeUserOrRestaurantNotExist)
+		}
+		return 0, fmt.Errorf("не удалось добавить гостей в очередь: %w", err)
+	}
+
+	return entry.ID, nil
+}
+
+func (r *WaitlistRepository) GetByID(ctx context.Context, id int64) (*models.WaitlistEntry, error) {
+	query := `SELECT ` + waitlistColumns + ` FROM waitlist_entries WHERE id = $1`
+
+	entry, err := scanWaitlistEntry(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NotFound(i18n.CodeWaitlistEntryNotFound, id)
+		}
+		return nil, fmt.Errorf("не удалось получить запись очереди: %w", err)
+	}
+
+	return entry, nil
+}
+
+// GetActive возвращает ожидающих и оповещенных гостей ресторана в порядке
+// записи в очередь.
+func (r *WaitlistRepository) GetActive(ctx context.Context, restaurantID int64) ([]*models.WaitlistEntry, error) {
+	query := `
+        SELECT ` + waitlistColumns + `
+        FROM waitlist_entries
+        WHERE restaurant_id = $1 AND status IN ('waiting', 'notified')
+        ORDER BY created_at, id
+    `
+	rows, err := r.db.Query(ctx, query, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить очередь ресторана: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.WaitlistEntry
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании записи очереди: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по очереди: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GetByUser возвращает все записи очереди гостя, начиная с последних.
+func (r *WaitlistRepository) GetByUser(ctx context.Context, userID int64) ([]*models.WaitlistEntry, error) {
+	query := `
+        SELECT ` + waitlistColumns + `
+        FROM waitlist_entries
+        WHERE user_id = $1
+        ORDER BY created_at DESC, id DESC
+    `
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить записи очереди гостя: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.WaitlistEntry
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании записи очереди: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при итерации по очереди: %w", err)
+	}
+
+	return entries, nil
+}
+
+// UpdateStatus меняет статус записи, пока гости еще в очереди. Оповещение
+// запоминает время последнего сообщения.
+func (r *WaitlistRepository) UpdateStatus(ctx context.Context, id int64, status models.WaitlistStatus) error {
+	query := `
+        UPDATE waitlist_entries
+        SET status = $1,
+            notified_at = CASE WHEN $1 = 'notified' THEN CURRENT_TIMESTAMP ELSE notified_at END,
+            updated_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND status IN ('waiting', 'notified')
+    `
+	commandTag, err := r.db.Exec(ctx, query, status, id)
+	if err != nil {
+		return fmt.Errorf("не удалось изменить статус записи очереди: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errs.Conflict(i18n.CodeWaitlistEntryClosed, id)
+	}
+
+	return nil
+}
+
+// Seat создает бронирование для гостей из очереди и закрывает запись в
+// одной транзакции: либо гости сели за стол и ушли из очереди, либо ничего
+// не изменилось.
+func (r *WaitlistRepository) Seat(ctx context.Context, entryID int64, reservation *models.Reservation) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось начать транзакцию: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertReservation(ctx, tx, reservation, r.buffer); err != nil {
+		tx.Rollback(ctx)
+		return 0, reservationCreateError(ctx, r.db, reservation, r.buffer, err)
+	}
+
+	query := `
+        UPDATE waitlist_entries
+        SET status = 'seated', reservation_id = $1, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND status IN ('waiting', 'notified')
+    `
+	commandTag, err := tx.Exec(ctx, query, reservation.ID, entryID)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось закрыть запись очереди: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return 0, errs.Conflict(i18n.CodeWaitlistEntryClosed, entryID)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("не удалось посадить гостей из очереди: %w", err)
+	}
+
+	return reservation.ID, nil
+}
+
+func scanWaitlistEntry(row pgx.Row) (*models.WaitlistEntry, error) {
+	var entry models.WaitlistEntry
+	err := row.Scan(
+		&entry.ID,
+		&entry.RestaurantID,
+		&entry.UserID,
+		&entry.PartySize,
+		&entry.Note,
+		&entry.Status,
+		&entry.QuotedWaitMinutes,
+		&entry.ReservationID,
+		&entry.CreatedAt,
+		&entry.NotifiedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
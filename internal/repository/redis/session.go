@@ -0,0 +1,200 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+)
+
+// rotateScript атомарно заменяет хеш refresh-токена, только если клиент
+// предъявил текущий токен. Возвращает 1 — ротация выполнена, 0 — токен
+// уже использовался, -1 — сессии не существует.
+var rotateScript = goredis.NewScript(`
+local current = redis.call("HGET", KEYS[1], "refresh_hash")
+if not current then
+    return -1
+end
+if current ~= ARGV[1] then
+    return 0
+end
+redis.call("HSET", KEYS[1], "refresh_hash", ARGV[2], "last_used_at", ARGV[3])
+return 1
+`)
+
+type SessionRepository struct {
+	client *goredis.Client
+}
+
+func NewSessionRepository(client *goredis.Client) *SessionRepository {
+	return &SessionRepository{client: client}
+}
+
+func sessionKey(id string) string {
+	return "session:" + id
+}
+
+func userSessionsKey(userID int64) string {
+	return fmt.Sprintf("user_sessions:%d", userID)
+}
+
+func (r *SessionRepository) Create(ctx context.Context, session *models.Session, refreshHash string) error {
+	key := sessionKey(session.ID)
+	ttl := time.Until(session.ExpiresAt)
+
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			"user_id", session.UserID,
+			"user_agent", session.UserAgent,
+			"ip", session.IP,
+			"created_at", session.CreatedAt.Unix(),
+			"last_used_at", session.LastUsedAt.Unix(),
+			"expires_at", session.ExpiresAt.Unix(),
+			"refresh_hash", refreshHash,
+		)
+		pipe.Expire(ctx, key, ttl)
+		pipe.SAdd(ctx, userSessionsKey(session.UserID), session.ID)
+		pipe.Expire(ctx, userSessionsKey(session.UserID), ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("не удалось сохранить сессию: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) Get(ctx context.Context, id string) (*models.Session, error) {
+	values, err := r.client.HGetAll(ctx, sessionKey(id)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить сессию: %w", err)
+	}
+
+	if len(values) == 0 {
+		return nil, errs.NotFound(i18n.CodeSessionNotFound, id)
+	}
+
+	return parseSession(id, values)
+}
+
+func (r *SessionRepository) Rotate(ctx context.Context, id, oldHash, newHash string) (repository.SessionRotateResult, error) {
+	result, err := rotateScript.Run(ctx, r.client, []string{sessionKey(id)},
+		oldHash, newHash, time.Now().Unix()).Int()
+	if err != nil {
+		return repository.SessionNotFound, fmt.Errorf("не удалось обновить сессию: %w", err)
+	}
+
+	switch result {
+	case 1:
+		return repository.SessionRotated, nil
+	case 0:
+		return repository.SessionTokenReused, nil
+	default:
+		return repository.SessionNotFound, nil
+	}
+}
+
+func (r *SessionRepository) Delete(ctx context.Context, id string) error {
+	userID, err := r.client.HGet(ctx, sessionKey(id), "user_id").Int64()
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return fmt.Errorf("не удалось получить сессию: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, sessionKey(id))
+		if userID > 0 {
+			pipe.SRem(ctx, userSessionsKey(userID), id)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("не удалось удалить сессию: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	ids, err := r.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("не удалось получить сессии пользователя: %w", err)
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, sessionKey(id))
+	}
+	keys = append(keys, userSessionsKey(userID))
+
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("не удалось удалить сессии пользователя: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) ListByUser(ctx context.Context, userID int64) ([]*models.Session, error) {
+	ids, err := r.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить сессии пользователя: %w", err)
+	}
+
+	var sessions []*models.Session
+	var stale []interface{}
+	for _, id := range ids {
+		values, err := r.client.HGetAll(ctx, sessionKey(id)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("не удалось получить сессию: %w", err)
+		}
+
+		if len(values) == 0 {
+			stale = append(stale, id)
+			continue
+		}
+
+		session, err := parseSession(id, values)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	if len(stale) > 0 {
+		_ = r.client.SRem(ctx, userSessionsKey(userID), stale...).Err()
+	}
+
+	return sessions, nil
+}
+
+func parseSession(id string, values map[string]string) (*models.Session, error) {
+	userID, err := strconv.ParseInt(values["user_id"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("повреждены данные сессии %s", id)
+	}
+
+	return &models.Session{
+		ID:         id,
+		UserID:     userID,
+		UserAgent:  values["user_agent"],
+		IP:         values["ip"],
+		CreatedAt:  parseUnix(values["created_at"]),
+		LastUsedAt: parseUnix(values["last_used_at"]),
+		ExpiresAt:  parseUnix(values["expires_at"]),
+	}, nil
+}
+
+func parseUnix(value string) time.Time {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
@@ -13,6 +13,8 @@ type UserRepository interface {
 	GetByPhone(ctx context.Context, phone string) (*models.User, error)
 	Update(ctx context.Context, user *models.User) error
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) error
+	Anonymize(ctx context.Context, id int64) error
 	List(ctx context.Context, limit, offset int) ([]*models.User, error)
 }
 
@@ -32,6 +34,7 @@ type RestaurantRepository interface {
 	Update(ctx context.Context, restaurant *models.Restaurant) error
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context, active bool) ([]*models.Restaurant, error)
+	GetTimeZone(ctx context.Context, id int64) (string, error)
 }
 
 type SectionRepository interface {
@@ -46,11 +49,26 @@ type TableRepository interface {
 	Create(ctx context.Context, table *models.Table) (int64, error)
 	GetByID(ctx context.Context, id int64) (*models.Table, error)
 	GetBySection(ctx context.Context, sectionID int64) ([]*models.Table, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.RestaurantTable, error)
 	Update(ctx context.Context, table *models.Table) error
 	Delete(ctx context.Context, id int64) error
 	GenerateQR(ctx context.Context, tableID int64) (string, error)
 }
 
+// TableCombinationRepository хранит сочетания столов. Столы сочетания
+// возвращаются по возрастанию номера, первый из них считается основным.
+type TableCombinationRepository interface {
+	Create(ctx context.Context, combination *models.TableCombination) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.TableCombination, error)
+	GetBySection(ctx context.Context, sectionID int64) ([]*models.TableCombination, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.TableCombination, error)
+	Update(ctx context.Context, combination *models.TableCombination) error
+	Delete(ctx context.Context, id int64) error
+	// InUse сообщает, держат ли сочетание бронирования, которые еще не
+	// закончились.
+	InUse(ctx context.Context, id int64) (bool, error)
+}
+
 type MenuTypeRepository interface {
 	Create(ctx context.Context, menuType *models.MenuType) (int64, error)
 	GetByID(ctx context.Context, id int64) (*models.MenuType, error)
@@ -59,6 +77,17 @@ type MenuTypeRepository interface {
 	List(ctx context.Context) ([]*models.MenuType, error)
 }
 
+// EventTypeRepository хранит справочник типов событий. List без
+// includeInactive возвращает только активные типы.
+type EventTypeRepository interface {
+	Create(ctx context.Context, eventType *models.EventType) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.EventType, error)
+	GetByCode(ctx context.Context, code string) (*models.EventType, error)
+	Update(ctx context.Context, eventType *models.EventType) error
+	Delete(ctx context.Context, id int64) error
+	List(ctx context.Context, includeInactive bool) ([]*models.EventType, error)
+}
+
 type MenuRepository interface {
 	Create(ctx context.Context, menu *models.Menu) (int64, error)
 	GetByID(ctx context.Context, id int64) (*models.Menu, error)
@@ -70,28 +99,245 @@ type MenuRepository interface {
 type RestaurantEventRepository interface {
 	Create(ctx context.Context, event *models.RestaurantEvent) (int64, error)
 	GetByID(ctx context.Context, id int64) (*models.RestaurantEvent, error)
-	GetByType(ctx context.Context, eventType models.EventType) ([]*models.RestaurantEvent, error)
+	GetByType(ctx context.Context, eventType string) ([]*models.RestaurantEvent, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.RestaurantEvent, error)
 	Update(ctx context.Context, event *models.RestaurantEvent) error
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context) ([]*models.RestaurantEvent, error)
 }
 
+// EventPackageRepository хранит пакеты банкетов. GetByEvent без
+// includeInactive возвращает только активные пакеты.
+type EventPackageRepository interface {
+	Create(ctx context.Context, pkg *models.EventPackage) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.EventPackage, error)
+	GetByEvent(ctx context.Context, eventID int64, includeInactive bool) ([]*models.EventPackage, error)
+	Update(ctx context.Context, pkg *models.EventPackage) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// EventPricingRepository хранит надбавки ресторанов к банкетам.
+// GetByRestaurant возвращает nil, если надбавки не заданы.
+type EventPricingRepository interface {
+	Save(ctx context.Context, pricing *models.EventPricing) error
+	GetByRestaurant(ctx context.Context, restaurantID int64) (*models.EventPricing, error)
+}
+
+// RestaurantEventTableRepository хранит бронирования столов под события.
+// Даты бронирований — полночь дня в часовом поясе ресторана: сутки события
+// отсчитываются от нее.
 type RestaurantEventTableRepository interface {
-	Create(ctx context.Context, eventTable *models.RestaurantEventTable) error
+	// Create бронирует столы в одной транзакции: либо заняты все, либо ни
+	// один. Если deposit задан, он сохраняется в той же транзакции и
+	// привязывается к бронированиям.
+	Create(ctx context.Context, bookings []*models.RestaurantEventTable, deposit *models.EventDeposit) error
 	GetByEvent(ctx context.Context, eventID int64) ([]*models.RestaurantEventTable, error)
 	GetByTable(ctx context.Context, tableID int64) ([]*models.RestaurantEventTable, error)
+	// GetByRestaurant возвращает бронирования столов ресторана на дни в
+	// промежутке [from, to).
+	GetByRestaurant(ctx context.Context, restaurantID int64, from, to time.Time) ([]*models.RestaurantEventTable, error)
+	// Delete снимает бронирование и освобождает столы. Бронирование без
+	// депозита остается в списке отмененных.
 	Delete(ctx context.Context, eventID, tableID int64, date time.Time) error
+	// GetCancelled возвращает отмененные бронирования без депозита столов
+	// ресторана на дни в промежутке [from, to).
+	GetCancelled(ctx context.Context, restaurantID int64, from, to time.Time) ([]*models.CancelledEventBooking, error)
 	CheckAvailability(ctx context.Context, tableID int64, date time.Time) (bool, error)
 }
 
+// EventDepositRepository хранит депозиты за бронирования под события.
+type EventDepositRepository interface {
+	GetByID(ctx context.Context, id int64) (*models.EventDeposit, error)
+	GetByPaymentID(ctx context.Context, paymentID string) (*models.EventDeposit, error)
+	SetPayment(ctx context.Context, id int64, paymentID, paymentURL string) error
+	// MarkPaid отмечает депозит оплаченным и подтверждает его бронирования
+	// и заявку, по которой он выставлен. Повторная отметка оплаченного депозита ничего не меняет; просроченный
+	// или отмененный депозит дает конфликт.
+	MarkPaid(ctx context.Context, id int64) error
+	// Release переводит неоплаченный депозит в status и снимает его
+	// бронирования.
+	Release(ctx context.Context, id int64, status models.DepositStatus) error
+	// ExpireOverdue снимает бронирования депозитов, не оплаченных в срок, и
+	// возвращает число просроченных депозитов.
+	ExpireOverdue(ctx context.Context) (int64, error)
+	// GetReleased возвращает просроченные и отмененные депозиты событий
+	// ресторана на дни в промежутке [from, to): их бронирования уже сняты.
+	GetReleased(ctx context.Context, restaurantID int64, from, to time.Time) ([]*models.EventDeposit, error)
+	// GetByUser возвращает депозиты за бронирования столиков пользователя.
+	GetByUser(ctx context.Context, userID int64) ([]*models.EventDeposit, error)
+}
+
+// CalendarFeedRepository хранит ссылки на календари ресторанов. Save
+// заменяет прежнюю ссылку ресторана.
+type CalendarFeedRepository interface {
+	Save(ctx context.Context, feed *models.CalendarFeed) error
+	GetByRestaurant(ctx context.Context, restaurantID int64) (*models.CalendarFeed, error)
+	Delete(ctx context.Context, restaurantID int64) error
+}
+
+// OccupancyRepository читает журнал занятости столов.
+type OccupancyRepository interface {
+	// BusyTables возвращает столы ресторана, занятые хотя бы частично в
+	// промежутке [start, end).
+	BusyTables(ctx context.Context, restaurantID int64, start, end time.Time) (map[int64]bool, error)
+	// GetByRestaurant возвращает записи журнала по столам ресторана,
+	// пересекающиеся с [start, end), упорядоченные по столу и началу.
+	GetByRestaurant(ctx context.Context, restaurantID int64, start, end time.Time) ([]*models.TableOccupancy, error)
+}
+
+// OpeningHoursRepository хранит недельное расписание ресторанов и особые
+// дни. Даты особых дней передаются в формате ГГГГ-ММ-ДД.
+type OpeningHoursRepository interface {
+	GetWeekly(ctx context.Context, restaurantID int64) ([]*models.OpeningInterval, error)
+	SetWeekly(ctx context.Context, restaurantID int64, hours []*models.OpeningInterval) error
+	GetSpecialDays(ctx context.Context, restaurantID int64, from, to time.Time) ([]*models.SpecialDay, error)
+	SetSpecialDay(ctx context.Context, day *models.SpecialDay) error
+	DeleteSpecialDay(ctx context.Context, restaurantID int64, day string) error
+}
+
+type SectionBlackoutRepository interface {
+	Create(ctx context.Context, blackout *models.SectionBlackout) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.SectionBlackout, error)
+	GetBySection(ctx context.Context, sectionID int64) ([]*models.SectionBlackout, error)
+	// GetByRestaurant возвращает закрытия секций ресторана, пересекающиеся
+	// с [start, end).
+	GetByRestaurant(ctx context.Context, restaurantID int64, start, end time.Time) ([]*models.SectionBlackout, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type ReservationRepository interface {
+	Create(ctx context.Context, reservation *models.Reservation) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.Reservation, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64, filter models.ReservationFilter) ([]*models.Reservation, error)
+	GetByUser(ctx context.Context, userID int64) ([]*models.Reservation, error)
+	Update(ctx context.Context, reservation *models.Reservation) error
+	// UpdateStatus переводит бронирование из статуса from в to. Если статус
+	// уже не from, возвращает конфликт и ничего не меняет.
+	UpdateStatus(ctx context.Context, id int64, from, to models.ReservationStatus) error
+	ForgiveNoShow(ctx context.Context, id int64) error
+	AverageTurnTime(ctx context.Context, restaurantID int64, since time.Time) (time.Duration, error)
+}
+
+// NoShowPolicyRepository хранит правила ресторанов для гостей с неявками.
+// GetByRestaurant возвращает nil, если правила не заданы.
+type NoShowPolicyRepository interface {
+	Save(ctx context.Context, policy *models.NoShowPolicy) error
+	GetByRestaurant(ctx context.Context, restaurantID int64) (*models.NoShowPolicy, error)
+}
+
+// WaitlistRepository хранит очередь гостей без брони. Изменять можно только
+// записи ожидающих и оповещенных гостей, иначе возвращается конфликт.
+type WaitlistRepository interface {
+	Create(ctx context.Context, entry *models.WaitlistEntry) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.WaitlistEntry, error)
+	GetActive(ctx context.Context, restaurantID int64) ([]*models.WaitlistEntry, error)
+	GetByUser(ctx context.Context, userID int64) ([]*models.WaitlistEntry, error)
+	UpdateStatus(ctx context.Context, id int64, status models.WaitlistStatus) error
+	// Seat создает бронирование и закрывает запись очереди в одной транзакции.
+	Seat(ctx context.Context, entryID int64, reservation *models.Reservation) (int64, error)
+}
+
+// EventInquiryRepository хранит заявки на банкеты и их журнал. Каждое
+// изменение заявки записывает activity в той же транзакции. Менять можно
+// только заявку в ожидаемом статусе, иначе возвращается конфликт.
+type EventInquiryRepository interface {
+	Create(ctx context.Context, inquiry *models.EventInquiry, activity *models.InquiryActivity) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.EventInquiry, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64, filter models.InquiryFilter) ([]*models.EventInquiry, error)
+	// Update меняет данные открытой заявки, но не статус и не назначение.
+	Update(ctx context.Context, inquiry *models.EventInquiry, activity *models.InquiryActivity) error
+	UpdateStatus(ctx context.Context, id int64, from, to models.InquiryStatus, activity *models.InquiryActivity) error
+	Assign(ctx context.Context, id int64, userID *int64, activity *models.InquiryActivity) error
+	AddActivity(ctx context.Context, activity *models.InquiryActivity) error
+	GetActivities(ctx context.Context, inquiryID int64) ([]*models.InquiryActivity, error)
+	// Convert занимает столы под событие в одной транзакции: либо заняты все
+	// столы, либо ничего не изменилось. Без депозита заявка подтверждается
+	// сразу; депозит сохраняется и привязывается к бронированиям и заявке,
+	// а подтверждает ее оплата.
+	Convert(ctx context.Context, id int64, from models.InquiryStatus, eventID int64,
+		bookings []*models.RestaurantEventTable, deposit *models.EventDeposit, activity *models.InquiryActivity) error
+}
+
+type OTPRepository interface {
+	Upsert(ctx context.Context, otp *models.OTPCode) error
+	GetByPhone(ctx context.Context, phone string) (*models.OTPCode, error)
+	// UseAttempt засчитывает попытку ввода кода и возвращает код, если
+	// попытки еще остались. Проверка и счетчик меняются одним запросом,
+	// поэтому параллельные попытки не обходят лимит. Если попыток не
+	// осталось, возвращает nil без ошибки.
+	UseAttempt(ctx context.Context, phone string, maxAttempts int) (*models.OTPCode, error)
+	Delete(ctx context.Context, phone string) error
+}
+
+type StaffRepository interface {
+	Assign(ctx context.Context, assignment *models.StaffAssignment) error
+	Remove(ctx context.Context, userID, restaurantID int64) error
+	Get(ctx context.Context, userID, restaurantID int64) (*models.StaffAssignment, error)
+	GetByUser(ctx context.Context, userID int64) ([]*models.StaffAssignment, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.StaffAssignment, error)
+}
+
+type AuditRepository interface {
+	Create(ctx context.Context, entry *models.AuditEntry) error
+	List(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error)
+	// ScrubUser удаляет снимки пользователя и его бронирований, записей в
+	// очереди и депозитов: в них остаются имя и телефон гостя.
+	ScrubUser(ctx context.Context, userID int64) error
+	DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
+}
+
+type SessionRotateResult int
+
+const (
+	SessionRotated SessionRotateResult = iota
+	SessionTokenReused
+	SessionNotFound
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *models.Session, refreshHash string) error
+	Get(ctx context.Context, id string) (*models.Session, error)
+	Rotate(ctx context.Context, id, oldHash, newHash string) (SessionRotateResult, error)
+	Delete(ctx context.Context, id string) error
+	DeleteByUser(ctx context.Context, userID int64) error
+	ListByUser(ctx context.Context, userID int64) ([]*models.Session, error)
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *models.APIKey) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	List(ctx context.Context) ([]*models.APIKey, error)
+	Revoke(ctx context.Context, id int64) error
+	TouchLastUsed(ctx context.Context, id int64) error
+}
+
 type Repository struct {
 	User                 UserRepository
 	City                 CityRepository
 	Restaurant           RestaurantRepository
 	Section              SectionRepository
 	Table                TableRepository
+	TableCombination     TableCombinationRepository
 	MenuType             MenuTypeRepository
+	EventType            EventTypeRepository
 	Menu                 MenuRepository
 	RestaurantEvent      RestaurantEventRepository
+	EventPackage         EventPackageRepository
+	EventPricing         EventPricingRepository
 	RestaurantEventTable RestaurantEventTableRepository
+	EventDeposit         EventDepositRepository
+	CalendarFeed         CalendarFeedRepository
+	NoShowPolicy         NoShowPolicyRepository
+	Reservation          ReservationRepository
+	Occupancy            OccupancyRepository
+	Waitlist             WaitlistRepository
+	EventInquiry         EventInquiryRepository
+	OpeningHours         OpeningHoursRepository
+	SectionBlackout      SectionBlackoutRepository
+	OTP                  OTPRepository
+	Staff                StaffRepository
+	Session              SessionRepository
+	APIKey               APIKeyRepository
+	Audit                AuditRepository
 }
@@ -0,0 +1,23 @@
+// Package requestmeta передает сведения о HTTP-запросе (адрес клиента,
+// идентификатор запроса) из middleware в usecase через context.
+package requestmeta
+
+import "context"
+
+type Meta struct {
+	IP        string
+	RequestID string
+}
+
+type metaKey struct{}
+
+func WithMeta(ctx context.Context, meta Meta) context.Context {
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+// FromContext возвращает пустые сведения, если запрос пришел не по HTTP,
+// например при фоновой обработке.
+func FromContext(ctx context.Context) Meta {
+	meta, _ := ctx.Value(metaKey{}).(Meta)
+	return meta
+}
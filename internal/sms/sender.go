@@ -0,0 +1,23 @@
+package sms
+
+import (
+	"context"
+	"log"
+)
+
+type Sender interface {
+	Send(ctx context.Context, phone, message string) error
+}
+
+// LogSender не отправляет сообщения, а только пишет их в лог.
+// Используется в разработке, пока не подключен реальный SMS-провайдер.
+type LogSender struct{}
+
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+func (s *LogSender) Send(ctx context.Context, phone, message string) error {
+	log.Printf("SMS на номер %s: %s", phone, message)
+	return nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+)
+
+var ErrForbidden = errs.Forbidden(i18n.CodeForbidden)
+
+// AccessControl проверяет права текущего пользователя из контекста запроса.
+// Администратор платформы имеет доступ ко всем ресторанам, менеджеры и
+// официанты — только к ресторанам, к которым они назначены. API-ключи
+// проходят проверку областей доступа в middleware, здесь для них проверяется
+// только ограничение по ресторану.
+type AccessControl struct {
+	staffRepo repository.StaffRepository
+}
+
+func NewAccessControl(staffRepo repository.StaffRepository) *AccessControl {
+	return &AccessControl{
+		staffRepo: staffRepo,
+	}
+}
+
+func (a *AccessControl) RequireAdmin(ctx context.Context) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || !principal.IsAdmin() {
+		return a.deny(ctx, "требуется роль администратора")
+	}
+	return nil
+}
+
+func (a *AccessControl) RequireSelfOrAdmin(ctx context.Context, userID int64) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || (!principal.IsAdmin() && principal.UserID != userID) {
+		return a.deny(ctx, "доступ к данным другого пользователя")
+	}
+	return nil
+}
+
+func (a *AccessControl) RequireRestaurantManager(ctx context.Context, restaurantID int64) error {
+	return a.requireRestaurantRole(ctx, restaurantID, models.RoleManager)
+}
+
+func (a *AccessControl) RequireRestaurantStaff(ctx context.Context, restaurantID int64) error {
+	return a.requireRestaurantRole(ctx, restaurantID, models.RoleManager, models.RoleWaiter)
+}
+
+// RequireRestaurantAccess проверяет чтение данных ресторана. Пользователям
+// чтение открыто, API-ключ может быть ограничен одним рестораном.
+func (a *AccessControl) RequireRestaurantAccess(ctx context.Context, restaurantID int64) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if ok && principal.IsAPIKey() && !principal.CanAccessRestaurant(restaurantID) {
+		return a.deny(ctx, fmt.Sprintf("ключ не имеет доступа к ресторану %d", restaurantID))
+	}
+	return nil
+}
+
+func (a *AccessControl) requireRestaurantRole(ctx context.Context, restaurantID int64, roles ...models.UserRole) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return a.deny(ctx, "пользователь не авторизован")
+	}
+
+	if principal.IsAdmin() {
+		return nil
+	}
+
+	if principal.IsAPIKey() {
+		if !principal.CanAccessRestaurant(restaurantID) {
+			return a.deny(ctx, fmt.Sprintf("ключ не имеет доступа к ресторану %d", restaurantID))
+		}
+		return nil
+	}
+
+	assignment, err := a.staffRepo.Get(ctx, principal.UserID, restaurantID)
+	if err != nil {
+		// Сбой базы — не отказ в доступе: клиент должен получить 5xx, а не 403.
+		if !errs.IsNotFound(err) {
+			return err
+		}
+		return a.deny(ctx, fmt.Sprintf("пользователь не назначен в ресторан %d", restaurantID))
+	}
+
+	for _, role := range roles {
+		if assignment.Role == role {
+			return nil
+		}
+	}
+
+	return a.deny(ctx, fmt.Sprintf("недостаточная роль в ресторане %d", restaurantID))
+}
+
+func (a *AccessControl) deny(ctx context.Context, reason string) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if ok && principal.IsAPIKey() {
+		log.Printf("Отказано в доступе: API-ключ %d: %s", principal.APIKeyID, reason)
+	} else if ok {
+		log.Printf("Отказано в доступе: пользователь %d (роль %s): %s", principal.UserID, principal.Role, reason)
+	} else {
+		log.Printf("Отказано в доступе: анонимный запрос: %s", reason)
+	}
+	return ErrForbidden
+}
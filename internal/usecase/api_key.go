@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+)
+
+const apiKeyPrefix = "rmk_"
+
+type APIKeyUC struct {
+	apiKeyRepo repository.APIKeyRepository
+	access     *AccessControl
+	audit      *AuditUC
+}
+
+func NewAPIKeyUseCase(apiKeyRepo repository.APIKeyRepository, access *AccessControl, audit *AuditUC) *APIKeyUC {
+	return &APIKeyUC{
+		apiKeyRepo: apiKeyRepo,
+		access:     access,
+		audit:      audit,
+	}
+}
+
+func (uc *APIKeyUC) Create(ctx context.Context, req *models.APIKeyCreateRequest) (*models.APIKeyCreated, error) {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, errs.Invalid("name", i18n.CodeAPIKeyNameRequired)
+	}
+
+	if len(req.Scopes) == 0 {
+		return nil, errs.Invalid("scopes", i18n.CodeAPIKeyScopesRequired)
+	}
+
+	for _, scope := range req.Scopes {
+		if !auth.ValidScope(scope) {
+			return nil, errs.Invalid("scopes", i18n.CodeAPIKeyScopeUnknown, scope)
+		}
+	}
+
+	rawKey := apiKeyPrefix + generateToken(24)
+	key := &models.APIKey{
+		Name:         req.Name,
+		Prefix:       rawKey[:len(apiKeyPrefix)+8],
+		KeyHash:      hashAPIKey(rawKey),
+		Scopes:       req.Scopes,
+		RestaurantID: req.RestaurantID,
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		key.CreatedBy = &principal.UserID
+	}
+
+	if _, err := uc.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, err
+	}
+
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityAPIKey, key.ID, nil, key)
+
+	return &models.APIKeyCreated{
+		APIKey: key,
+		Key:    rawKey,
+	}, nil
+}
+
+func (uc *APIKeyUC) List(ctx context.Context) ([]*models.APIKey, error) {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.apiKeyRepo.List(ctx)
+}
+
+func (uc *APIKeyUC) Revoke(ctx context.Context, id int64) error {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	key, err := uc.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.apiKeyRepo.Revoke(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityAPIKey, id, key, nil)
+	return nil
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+// PreviewAssignment показывает, какой стол получат гости, не бронируя его.
+// Если ни один свободный стол не вмещает гостей, предлагается сочетание
+// столов, а если подходящего сочетания нет — набор столов одной секции.
+func (uc *ReservationUC) PreviewAssignment(ctx context.Context, req *models.AssignmentRequest) (*models.TableAssignment, error) {
+	if err := uc.access.RequireRestaurantAccess(ctx, req.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	if err := validateAssignmentRequest(req); err != nil {
+		return nil, err
+	}
+
+	end := req.StartTime.Add(time.Duration(req.DurationMinutes) * time.Minute)
+	if err := uc.schedule.requireOpen(ctx, req.RestaurantID, req.StartTime, end); err != nil {
+		return nil, err
+	}
+
+	options, err := uc.assignTables(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(options) == 0 {
+		return nil, errs.Conflict(i18n.CodeNoTableAvailable, req.PartySize)
+	}
+
+	return options[0], nil
+}
+
+// assignTables возвращает варианты рассадки от лучшего к худшему. Сначала
+// идут отдельные столы, вмещающие гостей: чем меньше пустых мест и лишних
+// тегов, тем лучше. Если отдельного стола нет, предлагаются настроенные
+// сочетания, все столы которых свободны, а без них — произвольный набор
+// столов. Столы закрытых секций не рассматриваются.
+func (uc *ReservationUC) assignTables(ctx context.Context, req *models.AssignmentRequest) ([]*models.TableAssignment, error) {
+	tables, err := uc.tableRepo.GetByRestaurant(ctx, req.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Перерыв между гостями расширяет промежуток в обе стороны.
+	start := req.StartTime.Add(-uc.cfg.Buffer)
+	end := req.StartTime.Add(time.Duration(req.DurationMinutes)*time.Minute + uc.cfg.Buffer)
+	busy, err := uc.occupancyRepo.BusyTables(ctx, req.RestaurantID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	requestedEnd := req.StartTime.Add(time.Duration(req.DurationMinutes) * time.Minute)
+	blackouts, err := uc.schedule.blackedOutSections(ctx, req.RestaurantID, req.StartTime, requestedEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	var free []*models.RestaurantTable
+	for _, table := range tables {
+		if !busy[table.ID] && len(blackouts[table.SectionID]) == 0 && tableMatches(table, req) {
+			free = append(free, table)
+		}
+	}
+
+	var singles []*models.RestaurantTable
+	for _, table := range free {
+		if seatsParty(&table.Table, req.PartySize) {
+			singles = append(singles, table)
+		}
+	}
+
+	if len(singles) > 0 {
+		sortBySeatFit(singles)
+
+		options := make([]*models.TableAssignment, 0, len(singles))
+		for _, table := range singles {
+			options = append(options, &models.TableAssignment{
+				Tables:    []*models.RestaurantTable{table},
+				SectionID: table.SectionID,
+				Capacity:  table.MaxSeats,
+			})
+		}
+		return options, nil
+	}
+
+	options, err := uc.assignCombinations(ctx, req.RestaurantID, free, req.PartySize)
+	if err != nil {
+		return nil, err
+	}
+	if len(options) > 0 {
+		return options, nil
+	}
+
+	if combined := combineTables(free, req.PartySize); combined != nil {
+		return []*models.TableAssignment{combined}, nil
+	}
+
+	return nil, nil
+}
+
+// assignCombinations возвращает сочетания, вмещающие гостей, все столы
+// которых есть среди свободных free, от меньшего к большему.
+func (uc *ReservationUC) assignCombinations(ctx context.Context, restaurantID int64, free []*models.RestaurantTable,
+	partySize int) ([]*models.TableAssignment, error) {
+	combinations, err := uc.combinationRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil || len(combinations) == 0 {
+		return nil, err
+	}
+
+	freeByID := make(map[int64]*models.RestaurantTable, len(free))
+	for _, table := range free {
+		freeByID[table.ID] = table
+	}
+
+	var options []*models.TableAssignment
+	for _, combination := range combinations {
+		if !combinationSeats(combination, partySize) {
+			continue
+		}
+
+		option := &models.TableAssignment{
+			SectionID:     combination.SectionID,
+			Capacity:      combination.MaxSeats,
+			Combined:      true,
+			CombinationID: &combination.ID,
+		}
+		for _, id := range combination.TableIDs {
+			table, ok := freeByID[id]
+			if !ok {
+				option = nil
+				break
+			}
+			option.Tables = append(option.Tables, table)
+		}
+		if option != nil && len(option.Tables) > 0 {
+			options = append(options, option)
+		}
+	}
+
+	sort.SliceStable(options, func(i, j int) bool {
+		if options[i].Capacity != options[j].Capacity {
+			return options[i].Capacity < options[j].Capacity
+		}
+		return len(options[i].Tables) < len(options[j].Tables)
+	})
+	return options, nil
+}
+
+// sortBySeatFit упорядочивает подходящие гостям столы: сначала с меньшим
+// числом пустых мест, затем с меньшим числом тегов (лишние теги вроде vip
+// лучше приберечь для тех, кто о них просил), затем по номеру.
+func sortBySeatFit(tables []*models.RestaurantTable) {
+	sort.SliceStable(tables, func(i, j int) bool {
+		a, b := tables[i], tables[j]
+		if a.MaxSeats != b.MaxSeats {
+			return a.MaxSeats < b.MaxSeats
+		}
+		if len(a.Tags) != len(b.Tags) {
+			return len(a.Tags) < len(b.Tags)
+		}
+		return a.NumberOfTable < b.NumberOfTable
+	})
+}
+
+// combineTables набирает столы внутри каждой секции, начиная с самых
+// больших, пока гости не поместятся, и выбирает секцию, где понадобилось
+// меньше всего столов, а при равенстве — меньше пустых мест.
+func combineTables(tables []*models.RestaurantTable, partySize int) *models.TableAssignment {
+	bySection := make(map[int64][]*models.RestaurantTable)
+	var sectionIDs []int64
+	for _, table := range tables {
+		if _, ok := bySection[table.SectionID]; !ok {
+			sectionIDs = append(sectionIDs, table.SectionID)
+		}
+		bySection[table.SectionID] = append(bySection[table.SectionID], table)
+	}
+	sort.Slice(sectionIDs, func(i, j int) bool { return sectionIDs[i] < sectionIDs[j] })
+
+	var best *models.TableAssignment
+	for _, sectionID := range sectionIDs {
+		candidates := bySection[sectionID]
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].MaxSeats != candidates[j].MaxSeats {
+				return candidates[i].MaxSeats > candidates[j].MaxSeats
+			}
+			return candidates[i].NumberOfTable < candidates[j].NumberOfTable
+		})
+
+		option := &models.TableAssignment{SectionID: sectionID, Combined: true}
+		for _, table := range candidates {
+			if option.Capacity >= partySize {
+				break
+			}
+			option.Tables = append(option.Tables, table)
+			option.Capacity += table.MaxSeats
+		}
+
+		if option.Capacity < partySize {
+			continue
+		}
+		if best == nil || len(option.Tables) < len(best.Tables) ||
+			len(option.Tables) == len(best.Tables) && option.Capacity < best.Capacity {
+			best = option
+		}
+	}
+
+	return best
+}
+
+func seatsParty(table *models.Table, partySize int) bool {
+	return table.MinSeats <= partySize && partySize <= table.MaxSeats
+}
+
+func combinationSeats(combination *models.TableCombination, partySize int) bool {
+	return combination.MinSeats <= partySize && partySize <= combination.MaxSeats
+}
+
+// tableMatches проверяет секцию, форму и теги стола. Стол подходит, если у
+// него есть все запрошенные теги, с учетом тегов секции.
+func tableMatches(table *models.RestaurantTable, req *models.AssignmentRequest) bool {
+	if req.SectionID != 0 && table.SectionID != req.SectionID {
+		return false
+	}
+
+	if req.Shape != "" && table.Shape != req.Shape {
+		return false
+	}
+
+	for _, tag := range req.Tags {
+		if !hasTag(table.Tags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAssignmentRequest(req *models.AssignmentRequest) error {
+	var fields errs.Fields
+
+	if req.PartySize < 1 || req.PartySize > maxPartySize {
+		fields.Add("party_size", i18n.CodePartySizeInvalid, maxPartySize)
+	}
+
+	if req.StartTime.IsZero() {
+		fields.Add("start_time", i18n.CodeReservationStartRequired)
+	} else if !req.StartTime.After(time.Now()) {
+		fields.Add("start_time", i18n.CodeReservationStartInPast)
+	}
+
+	if req.DurationMinutes == 0 {
+		req.DurationMinutes = defaultReservationDuration
+	}
+	if req.DurationMinutes < minReservationDuration || req.DurationMinutes > maxReservationDuration {
+		fields.Add("duration_minutes", i18n.CodeReservationDurationBounds, minReservationDuration, maxReservationDuration)
+	}
+
+	if req.Shape != "" && !validTableShape(req.Shape) {
+		fields.Add("shape", i18n.CodeTableShapeUnknown, req.Shape)
+	}
+
+	req.Tags = validateTags(req.Tags, &fields)
+
+	return fields.Err()
+}
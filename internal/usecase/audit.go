@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+	"restaurant-management/internal/requestmeta"
+)
+
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 500
+)
+
+// AuditUC ведет журнал изменений. Usecase'ы вызывают record после каждой
+// успешной операции создания, изменения или удаления.
+type AuditUC struct {
+	auditRepo repository.AuditRepository
+	access    *AccessControl
+	retention time.Duration
+}
+
+func NewAuditUseCase(auditRepo repository.AuditRepository, access *AccessControl, retention time.Duration) *AuditUC {
+	return &AuditUC{
+		auditRepo: auditRepo,
+		access:    access,
+		retention: retention,
+	}
+}
+
+func (uc *AuditUC) List(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLimit
+	}
+	if filter.Limit > maxAuditLimit {
+		filter.Limit = maxAuditLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	return uc.auditRepo.List(ctx, filter)
+}
+
+// PurgeExpired удаляет записи старше срока хранения. Нулевой срок означает,
+// что записи хранятся бессрочно.
+func (uc *AuditUC) PurgeExpired(ctx context.Context) (int64, error) {
+	if uc.retention <= 0 {
+		return 0, nil
+	}
+	return uc.auditRepo.DeleteOlderThan(ctx, time.Now().Add(-uc.retention))
+}
+
+// record сохраняет запись аудита. Ошибка записи не отменяет уже выполненную
+// операцию и только пишется в лог.
+func (uc *AuditUC) record(ctx context.Context, action models.AuditAction, entityType string, entityID int64, before, after interface{}) {
+	meta := requestmeta.FromContext(ctx)
+	entry := &models.AuditEntry{
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Before:     auditSnapshot(before),
+		After:      auditSnapshot(after),
+		IP:         meta.IP,
+		RequestID:  meta.RequestID,
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		if principal.IsAPIKey() {
+			entry.ActorAPIKeyID = &principal.APIKeyID
+		} else {
+			entry.ActorUserID = &principal.UserID
+		}
+	}
+
+	if err := uc.auditRepo.Create(ctx, entry); err != nil {
+		log.Printf("Ошибка записи аудита (%s %s %d): %v", action, entityType, entityID, err)
+	}
+}
+
+// scrubUser удаляет из журнала персональные данные анонимизированного
+// пользователя: его снимки и снимки его бронирований, записей в очереди и
+// депозитов.
+func (uc *AuditUC) scrubUser(ctx context.Context, userID int64) error {
+	return uc.auditRepo.ScrubUser(ctx, userID)
+}
+
+func auditSnapshot(value interface{}) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.Printf("Не удалось сериализовать снимок для аудита: %v", err)
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,361 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/config"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+	"restaurant-management/internal/sms"
+)
+
+const apiKeyTouchInterval = time.Minute
+
+type AuthUC struct {
+	otpRepo     repository.OTPRepository
+	sessionRepo repository.SessionRepository
+	apiKeyRepo  repository.APIKeyRepository
+	userUC      UserUseCase
+	smsSender   sms.Sender
+	tokens      *auth.TokenManager
+	access      *AccessControl
+	cfg         config.AuthConfig
+}
+
+func NewAuthUseCase(otpRepo repository.OTPRepository, sessionRepo repository.SessionRepository,
+	apiKeyRepo repository.APIKeyRepository, userUC UserUseCase, smsSender sms.Sender, tokens *auth.TokenManager,
+	access *AccessControl, cfg config.AuthConfig) *AuthUC {
+	return &AuthUC{
+		otpRepo:     otpRepo,
+		sessionRepo: sessionRepo,
+		apiKeyRepo:  apiKeyRepo,
+		userUC:      userUC,
+		smsSender:   smsSender,
+		tokens:      tokens,
+		access:      access,
+		cfg:         cfg,
+	}
+}
+
+func (uc *AuthUC) RequestOTP(ctx context.Context, phoneNumber string) error {
+	phone, err := normalizePhone(phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	existing, err := uc.otpRepo.GetByPhone(ctx, phone)
+	if err == nil && existing != nil && time.Since(existing.CreatedAt) < uc.cfg.OTPResendInterval {
+		return errs.TooManyRequests(i18n.CodeOTPResendTooEarly,
+			(uc.cfg.OTPResendInterval - time.Since(existing.CreatedAt)).Round(time.Second))
+	}
+
+	code, err := generateOTPCode(uc.cfg.OTPLength)
+	if err != nil {
+		return fmt.Errorf("не удалось сгенерировать код подтверждения: %w", err)
+	}
+
+	otp := &models.OTPCode{
+		PhoneNumber: phone,
+		CodeHash:    hashOTPCode(phone, code),
+		ExpiresAt:   time.Now().Add(uc.cfg.OTPTTL),
+	}
+	if err := uc.otpRepo.Upsert(ctx, otp); err != nil {
+		return err
+	}
+
+	message := i18n.Translate(i18n.LangFromContext(ctx), i18n.MsgOTPSMS, code)
+	if err := uc.smsSender.Send(ctx, phone, message); err != nil {
+		return errs.Wrap(err, errs.KindUnavailable, i18n.CodeSMSSendFailed)
+	}
+
+	return nil
+}
+
+func (uc *AuthUC) VerifyOTP(ctx context.Context, req *models.OTPVerifyRequest, device models.DeviceInfo) (*models.AuthTokens, error) {
+	phone := strings.TrimSpace(req.PhoneNumber)
+	code := strings.TrimSpace(req.Code)
+	if phone == "" || code == "" {
+		return nil, errs.Validation(i18n.CodeOTPPhoneAndCode)
+	}
+
+	phone, err := normalizePhone(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	// Попытка засчитывается до сравнения кода: иначе параллельные подборы
+	// успели бы пройти проверку лимита раньше, чем вырастет счетчик.
+	otp, err := uc.otpRepo.UseAttempt(ctx, phone, uc.cfg.OTPMaxAttempts)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return nil, errs.Unauthorized(i18n.CodeOTPNotRequested)
+		}
+		return nil, err
+	}
+
+	if otp == nil {
+		_ = uc.otpRepo.Delete(ctx, phone)
+		return nil, errs.TooManyRequests(i18n.CodeOTPAttemptsExceeded)
+	}
+
+	if time.Now().After(otp.ExpiresAt) {
+		_ = uc.otpRepo.Delete(ctx, phone)
+		return nil, errs.Unauthorized(i18n.CodeOTPExpired)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(otp.CodeHash), []byte(hashOTPCode(phone, code))) != 1 {
+		return nil, errs.Unauthorized(i18n.CodeOTPInvalid)
+	}
+
+	if err := uc.otpRepo.Delete(ctx, phone); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.findOrCreateUser(ctx, phone, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsActive {
+		return nil, errs.Forbidden(i18n.CodeUserBlocked)
+	}
+
+	now := time.Now()
+	session := &models.Session{
+		ID:         generateToken(16),
+		UserID:     user.ID,
+		UserAgent:  device.UserAgent,
+		IP:         device.IP,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(uc.cfg.RefreshTokenTTL),
+	}
+
+	secret := generateToken(32)
+	if err := uc.sessionRepo.Create(ctx, session, hashRefreshSecret(secret)); err != nil {
+		return nil, err
+	}
+
+	return uc.issueTokens(user, session, secret)
+}
+
+// Refresh обменивает refresh-токен на новую пару токенов. Каждый refresh-токен
+// одноразовый: повторное предъявление уже использованного токена считается
+// признаком кражи, и сессия завершается целиком.
+func (uc *AuthUC) Refresh(ctx context.Context, refreshToken string) (*models.AuthTokens, error) {
+	sessionID, secret, ok := strings.Cut(strings.TrimSpace(refreshToken), ".")
+	if !ok || sessionID == "" || secret == "" {
+		return nil, errs.Unauthorized(i18n.CodeRefreshTokenInvalid)
+	}
+
+	session, err := uc.sessionRepo.Get(ctx, sessionID)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return nil, errs.Unauthorized(i18n.CodeSessionExpired)
+		}
+		return nil, err
+	}
+
+	newSecret := generateToken(32)
+	result, err := uc.sessionRepo.Rotate(ctx, sessionID, hashRefreshSecret(secret), hashRefreshSecret(newSecret))
+	if err != nil {
+		return nil, err
+	}
+
+	switch result {
+	case repository.SessionNotFound:
+		return nil, errs.Unauthorized(i18n.CodeSessionExpired)
+	case repository.SessionTokenReused:
+		log.Printf("Повторное использование refresh-токена: пользователь %d, сессия %s завершена", session.UserID, sessionID)
+		if err := uc.sessionRepo.Delete(ctx, sessionID); err != nil {
+			return nil, err
+		}
+		return nil, errs.Unauthorized(i18n.CodeRefreshTokenReused)
+	}
+
+	user, err := uc.userUC.GetByID(ctx, session.UserID)
+	if err != nil || !user.IsActive || user.DeletedAt != nil {
+		_ = uc.sessionRepo.Delete(ctx, sessionID)
+		return nil, errs.Unauthorized(i18n.CodeUserBlockedOrDeleted)
+	}
+
+	return uc.issueTokens(user, session, newSecret)
+}
+
+func (uc *AuthUC) Logout(ctx context.Context) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return errs.Unauthorized(i18n.CodeNotAuthenticated)
+	}
+
+	return uc.sessionRepo.Delete(ctx, principal.SessionID)
+}
+
+func (uc *AuthUC) LogoutAll(ctx context.Context) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return errs.Unauthorized(i18n.CodeNotAuthenticated)
+	}
+
+	return uc.sessionRepo.DeleteByUser(ctx, principal.UserID)
+}
+
+func (uc *AuthUC) ListSessions(ctx context.Context, userID int64) ([]*models.Session, error) {
+	if err := uc.access.RequireSelfOrAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	sessions, err := uc.sessionRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		for _, session := range sessions {
+			session.Current = session.ID == principal.SessionID
+		}
+	}
+
+	return sessions, nil
+}
+
+func (uc *AuthUC) Authenticate(ctx context.Context, accessToken string) (*auth.Principal, error) {
+	claims, err := uc.tokens.ParseAccessToken(accessToken)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.KindUnauthorized, i18n.CodeInvalidToken)
+	}
+
+	session, err := uc.sessionRepo.Get(ctx, claims.Id)
+	if err != nil && !errs.IsNotFound(err) {
+		return nil, err
+	}
+	if err != nil || session.UserID != claims.UserID {
+		return nil, errs.Unauthorized(i18n.CodeSessionEnded)
+	}
+
+	return &auth.Principal{
+		Kind:        auth.PrincipalUser,
+		UserID:      claims.UserID,
+		PhoneNumber: claims.PhoneNumber,
+		Role:        claims.Role,
+		SessionID:   session.ID,
+		Language:    claims.Language,
+	}, nil
+}
+
+// AuthenticateAPIKey проверяет ключ машинного клиента. Время последнего
+// использования обновляется не чаще раза в минуту, чтобы не писать в базу
+// на каждый запрос.
+func (uc *AuthUC) AuthenticateAPIKey(ctx context.Context, rawKey string) (*auth.Principal, error) {
+	key, err := uc.apiKeyRepo.GetByHash(ctx, hashAPIKey(rawKey))
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return nil, errs.Unauthorized(i18n.CodeAPIKeyNotValid)
+		}
+		return nil, err
+	}
+
+	if key.RevokedAt != nil {
+		return nil, errs.Unauthorized(i18n.CodeAPIKeyRevoked)
+	}
+
+	if key.LastUsedAt == nil || time.Since(*key.LastUsedAt) > apiKeyTouchInterval {
+		if err := uc.apiKeyRepo.TouchLastUsed(ctx, key.ID); err != nil {
+			log.Printf("Ошибка при обновлении API-ключа %d: %v", key.ID, err)
+		}
+	}
+
+	return &auth.Principal{
+		Kind:         auth.PrincipalAPIKey,
+		APIKeyID:     key.ID,
+		Scopes:       key.Scopes,
+		RestaurantID: key.RestaurantID,
+	}, nil
+}
+
+func (uc *AuthUC) issueTokens(user *models.User, session *models.Session, secret string) (*models.AuthTokens, error) {
+	accessToken, expiresAt, err := uc.tokens.GenerateAccessToken(user, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AuthTokens{
+		AccessToken:      accessToken,
+		TokenType:        "Bearer",
+		ExpiresAt:        expiresAt,
+		RefreshToken:     session.ID + "." + secret,
+		RefreshExpiresAt: session.ExpiresAt,
+		User:             user,
+	}, nil
+}
+
+func (uc *AuthUC) findOrCreateUser(ctx context.Context, phone string, req *models.OTPVerifyRequest) (*models.User, error) {
+	user, err := uc.userUC.GetByPhone(ctx, phone)
+	if err == nil && user != nil {
+		return user, nil
+	}
+	if err != nil && !errs.IsNotFound(err) {
+		return nil, err
+	}
+
+	user = &models.User{
+		PhoneNumber: phone,
+		Name:        strings.TrimSpace(req.Name),
+		LastName:    strings.TrimSpace(req.LastName),
+		Language:    req.Language,
+		IsActive:    true,
+	}
+	if user.Name == "" {
+		user.Name = defaultGuestName
+	}
+
+	id, err := uc.userUC.Create(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось зарегистрировать пользователя: %w", err)
+	}
+	user.ID = id
+
+	return user, nil
+}
+
+func generateOTPCode(length int) (string, error) {
+	var sb strings.Builder
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(byte('0' + n.Int64()))
+	}
+	return sb.String(), nil
+}
+
+func hashOTPCode(phone, code string) string {
+	sum := sha256.Sum256([]byte(phone + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateToken(size int) string {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		panic(fmt.Sprintf("не удалось сгенерировать случайные данные: %v", err))
+	}
+	return hex.EncodeToString(buf)
+}
+
+func hashRefreshSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+// Availability возвращает время на дату, когда ресторан работает и свободен
+// хотя бы один стол или сочетание столов, вмещающие гостей, вместе со всеми
+// такими столами и сочетаниями. Сочетание свободно, если свободны все его
+// столы. Столы, сочетания, занятость, расписание и закрытия секций всего
+// ресторана читаются
+// несколькими запросами, остальное считается в памяти, поэтому число
+// запросов не зависит от количества столов.
+func (uc *ReservationUC) Availability(ctx context.Context, req *models.AvailabilityRequest) ([]*models.AvailabilitySlot, error) {
+	if err := uc.access.RequireRestaurantAccess(ctx, req.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	loc, err := uc.schedule.location(ctx, req.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Дата — календарный день по часам ресторана, слоты возвращаются с его
+	// смещением.
+	dayStart := localDay(req.Date, loc)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	if err := validateAvailabilityRequest(req, dayEnd); err != nil {
+		return nil, err
+	}
+
+	tables, err := uc.tableRepo.GetByRestaurant(ctx, req.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*models.RestaurantTable
+	for _, table := range tables {
+		if (req.SectionID == 0 || table.SectionID == req.SectionID) && seatsParty(&table.Table, req.PartySize) {
+			candidates = append(candidates, table)
+		}
+	}
+	sortBySeatFit(candidates)
+
+	combinations, err := uc.combinationRepo.GetByRestaurant(ctx, req.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidateCombinations []*models.TableCombination
+	for _, combination := range combinations {
+		if (req.SectionID == 0 || combination.SectionID == req.SectionID) && combinationSeats(combination, req.PartySize) {
+			candidateCombinations = append(candidateCombinations, combination)
+		}
+	}
+
+	if len(candidates) == 0 && len(candidateCombinations) == 0 {
+		return []*models.AvailabilitySlot{}, nil
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	periods, err := uc.occupancyRepo.GetByRestaurant(ctx, req.RestaurantID,
+		dayStart.Add(-uc.cfg.Buffer), dayEnd.Add(duration+uc.cfg.Buffer))
+	if err != nil {
+		return nil, err
+	}
+
+	busy := make(map[int64][]*models.TableOccupancy)
+	for _, period := range periods {
+		busy[period.TableID] = append(busy[period.TableID], period)
+	}
+
+	schedule, err := uc.schedule.load(ctx, req.RestaurantID, dayStart, dayEnd.Add(duration))
+	if err != nil {
+		return nil, err
+	}
+
+	blackouts, err := uc.schedule.blackedOutSections(ctx, req.RestaurantID, dayStart, dayEnd.Add(duration))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	slots := []*models.AvailabilitySlot{}
+	for start := dayStart; start.Before(dayEnd); start = start.Add(uc.cfg.SlotStep) {
+		if !start.After(now) {
+			continue
+		}
+
+		end := start.Add(duration)
+		if !schedule.covers(start, end) {
+			continue
+		}
+
+		slot := &models.AvailabilitySlot{StartTime: start, EndTime: end, Tables: []*models.RestaurantTable{}}
+		for _, table := range candidates {
+			if uc.freeBetween(busy[table.ID], start, end) && !blackedOut(blackouts[table.SectionID], start, end) {
+				slot.Tables = append(slot.Tables, table)
+			}
+		}
+
+		for _, combination := range candidateCombinations {
+			if blackedOut(blackouts[combination.SectionID], start, end) {
+				continue
+			}
+			free := true
+			for _, tableID := range combination.TableIDs {
+				if !uc.freeBetween(busy[tableID], start, end) {
+					free = false
+					break
+				}
+			}
+			if free {
+				slot.Combinations = append(slot.Combinations, combination)
+			}
+		}
+
+		if len(slot.Tables) > 0 || len(slot.Combinations) > 0 {
+			slots = append(slots, slot)
+		}
+	}
+
+	return slots, nil
+}
+
+// freeBetween сообщает, что стол свободен в [start, end) с учетом перерыва
+// между гостями до и после.
+func (uc *ReservationUC) freeBetween(periods []*models.TableOccupancy, start, end time.Time) bool {
+	for _, period := range periods {
+		if period.StartsAt.Before(end.Add(uc.cfg.Buffer)) && start.Add(-uc.cfg.Buffer).Before(period.EndsAt) {
+			return false
+		}
+	}
+	return true
+}
+
+func blackedOut(blackouts []*models.SectionBlackout, start, end time.Time) bool {
+	for _, blackout := range blackouts {
+		if blackout.StartsAt.Before(end) && start.Before(blackout.EndsAt) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAvailabilityRequest(req *models.AvailabilityRequest, dayEnd time.Time) error {
+	var fields errs.Fields
+
+	if req.Date.IsZero() {
+		fields.Add("date", i18n.CodeBookingDateRequired)
+	} else if !dayEnd.After(time.Now()) {
+		fields.Add("date", i18n.CodeBookingDateInPast)
+	}
+
+	if req.PartySize < 1 || req.PartySize > maxPartySize {
+		fields.Add("party_size", i18n.CodePartySizeInvalid, maxPartySize)
+	}
+
+	if req.DurationMinutes == 0 {
+		req.DurationMinutes = defaultReservationDuration
+	}
+	if req.DurationMinutes < minReservationDuration || req.DurationMinutes > maxReservationDuration {
+		fields.Add("duration_minutes", i18n.CodeReservationDurationBounds, minReservationDuration, maxReservationDuration)
+	}
+
+	return fields.Err()
+}
@@ -0,0 +1,398 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"sort"
+	"time"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+)
+
+const (
+	// calendarPast и calendarAhead ограничивают ленту: прошедший месяц,
+	// чтобы отмены успели дойти до календарей, и год вперед.
+	calendarPast  = 30 * 24 * time.Hour
+	calendarAhead = 366 * 24 * time.Hour
+
+	calendarUIDDomain = "restaurant-management"
+	calendarDayLayout = "20060102"
+)
+
+// CalendarUC отдает бронирования ресторана для календарей: ленту по ссылке
+// с токеном для подписки и файл отдельного бронирования для гостя.
+type CalendarUC struct {
+	feedRepo        repository.CalendarFeedRepository
+	restaurantRepo  repository.RestaurantRepository
+	reservationRepo repository.ReservationRepository
+	bookingRepo     repository.RestaurantEventTableRepository
+	depositRepo     repository.EventDepositRepository
+	eventRepo       repository.RestaurantEventRepository
+	tableRepo       repository.TableRepository
+	combinationRepo repository.TableCombinationRepository
+	reservations    *ReservationUC
+	schedule        *ScheduleUC
+	access          *AccessControl
+	audit           *AuditUC
+}
+
+func NewCalendarUseCase(feedRepo repository.CalendarFeedRepository, restaurantRepo repository.RestaurantRepository,
+	reservationRepo repository.ReservationRepository, bookingRepo repository.RestaurantEventTableRepository,
+	depositRepo repository.EventDepositRepository, eventRepo repository.RestaurantEventRepository,
+	tableRepo repository.TableRepository, combinationRepo repository.TableCombinationRepository,
+	reservations *ReservationUC, schedule *ScheduleUC, access *AccessControl, audit *AuditUC) *CalendarUC {
+	return &CalendarUC{
+		feedRepo:        feedRepo,
+		restaurantRepo:  restaurantRepo,
+		reservationRepo: reservationRepo,
+		bookingRepo:     bookingRepo,
+		depositRepo:     depositRepo,
+		eventRepo:       eventRepo,
+		tableRepo:       tableRepo,
+		combinationRepo: combinationRepo,
+		reservations:    reservations,
+		schedule:        schedule,
+		access:          access,
+		audit:           audit,
+	}
+}
+
+// IssueFeed выпускает ссылку на календарь ресторана. Прежняя ссылка
+// перестает работать.
+func (uc *CalendarUC) IssueFeed(ctx context.Context, restaurantID int64) (*models.CalendarFeedCreated, error) {
+	if err := uc.access.RequireRestaurantManager(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.restaurantRepo.GetByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	token := generateToken(24)
+	feed := &models.CalendarFeed{
+		RestaurantID: restaurantID,
+		TokenHash:    hashCalendarToken(token),
+	}
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && !principal.IsAPIKey() {
+		feed.CreatedBy = &principal.UserID
+	}
+
+	if err := uc.feedRepo.Save(ctx, feed); err != nil {
+		return nil, err
+	}
+
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityCalendarFeed, restaurantID, nil, feed)
+	return &models.CalendarFeedCreated{Feed: feed, Token: token}, nil
+}
+
+func (uc *CalendarUC) RevokeFeed(ctx context.Context, restaurantID int64) error {
+	if err := uc.access.RequireRestaurantManager(ctx, restaurantID); err != nil {
+		return err
+	}
+
+	feed, err := uc.feedRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.feedRepo.Delete(ctx, restaurantID); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityCalendarFeed, restaurantID, feed, nil)
+	return nil
+}
+
+// Feed проверяет токен ссылки и возвращает бронирования ресторана за
+// прошедший месяц и год вперед, включая отмененные: календари убирают их
+// по статусу.
+func (uc *CalendarUC) Feed(ctx context.Context, restaurantID int64, token string) (*models.Calendar, error) {
+	feed, err := uc.feedRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return nil, errs.Unauthorized(i18n.CodeCalendarTokenInvalid)
+		}
+		return nil, err
+	}
+
+	if token == "" || subtle.ConstantTimeCompare([]byte(hashCalendarToken(token)), []byte(feed.TokenHash)) != 1 {
+		return nil, errs.Unauthorized(i18n.CodeCalendarTokenInvalid)
+	}
+
+	calendar, layout, err := uc.newCalendar(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from, to := now.Add(-calendarPast), now.Add(calendarAhead)
+
+	reservations, err := uc.reservationRepo.GetByRestaurant(ctx, restaurantID, models.ReservationFilter{From: &from, To: &to})
+	if err != nil {
+		return nil, err
+	}
+	for _, reservation := range reservations {
+		calendar.Entries = append(calendar.Entries, layout.reservationEntry(reservation, calendar.Location))
+	}
+
+	bookingEntries, err := uc.eventBookingEntries(ctx, restaurantID, calendar.Location, layout, from, to)
+	if err != nil {
+		return nil, err
+	}
+	calendar.Entries = append(calendar.Entries, bookingEntries...)
+
+	sort.SliceStable(calendar.Entries, func(i, j int) bool {
+		return calendar.Entries[i].Start.Before(calendar.Entries[j].Start)
+	})
+	return calendar, nil
+}
+
+// ReservationCalendar возвращает календарь с одним бронированием. Доступен
+// гостю, который забронировал стол, и сотрудникам ресторана.
+func (uc *CalendarUC) ReservationCalendar(ctx context.Context, id int64) (*models.Calendar, error) {
+	reservation, err := uc.reservations.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, layout, err := uc.newCalendar(ctx, reservation.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar.Entries = []*models.CalendarEntry{layout.reservationEntry(reservation, calendar.Location)}
+	return calendar, nil
+}
+
+func (uc *CalendarUC) newCalendar(ctx context.Context, restaurantID int64) (*models.Calendar, *calendarLayout, error) {
+	restaurant, err := uc.restaurantRepo.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loc, err := uc.schedule.location(ctx, restaurantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tables, err := uc.tableRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	combinations, err := uc.combinationRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	layout := &calendarLayout{
+		tables:       make(map[int64]*models.RestaurantTable, len(tables)),
+		combinations: make(map[int64]*models.TableCombination, len(combinations)),
+	}
+	for _, table := range tables {
+		layout.tables[table.ID] = table
+	}
+	for _, combination := range combinations {
+		layout.combinations[combination.ID] = combination
+	}
+
+	return &models.Calendar{
+		RestaurantName: restaurant.Name,
+		AddressRU:      restaurant.AddressRU,
+		AddressKZ:      restaurant.AddressKZ,
+		Location:       loc,
+	}, layout, nil
+}
+
+// eventBookingEntries собирает бронирования под события: стол, все столы
+// сочетания или все столы одного депозита — одна запись на день. Снятые
+// депозиты и отмененные бронирования без депозита попадают в ленту
+// отмененными, чтобы календари убрали их. Если стол забронирован заново
+// под то же событие, действующее бронирование заменяет отмену.
+func (uc *CalendarUC) eventBookingEntries(ctx context.Context, restaurantID int64, loc *time.Location,
+	layout *calendarLayout, from, to time.Time) ([]*models.CalendarEntry, error) {
+	events, err := uc.eventRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	eventNames := make(map[int64]string, len(events))
+	for _, event := range events {
+		eventNames[event.ID] = event.Name
+	}
+
+	bookings, err := uc.bookingRepo.GetByRestaurant(ctx, restaurantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*models.CalendarEntry
+	byUID := make(map[string]*models.CalendarEntry)
+	for _, booking := range bookings {
+		day := localDay(booking.BookingDate, loc)
+		uid := eventBookingUID(booking, day)
+
+		entry, ok := byUID[uid]
+		if !ok {
+			entry = &models.CalendarEntry{
+				UID:       uid,
+				Kind:      models.CalendarEventBooking,
+				Start:     day,
+				End:       day.AddDate(0, 0, 1),
+				Status:    models.CalendarConfirmed,
+				EventName: eventNames[booking.EventID],
+			}
+			byUID[uid] = entry
+			entries = append(entries, entry)
+		}
+
+		if booking.Status == models.EventBookingPendingPayment {
+			entry.Status = models.CalendarTentative
+		}
+		layout.addTables(entry, []int64{booking.TableID})
+	}
+
+	released, err := uc.depositRepo.GetReleased(ctx, restaurantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, deposit := range released {
+		day := localDay(deposit.BookingDate, loc)
+		entries = append(entries, &models.CalendarEntry{
+			UID:       depositUID(deposit.ID),
+			Kind:      models.CalendarEventBooking,
+			Start:     day,
+			End:       day.AddDate(0, 0, 1),
+			Status:    models.CalendarCancelled,
+			PartySize: deposit.Guests,
+			EventName: eventNames[*deposit.EventID],
+		})
+	}
+
+	cancelled, err := uc.bookingRepo.GetCancelled(ctx, restaurantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	cancelledByUID := make(map[string]*models.CalendarEntry)
+	for _, booking := range cancelled {
+		day := localDay(booking.BookingDate, loc)
+		uid := eventBookingUID(&models.RestaurantEventTable{
+			EventID:       booking.EventID,
+			TableID:       booking.TableID,
+			CombinationID: booking.CombinationID,
+		}, day)
+		if _, ok := byUID[uid]; ok {
+			continue
+		}
+
+		entry, ok := cancelledByUID[uid]
+		if !ok {
+			entry = &models.CalendarEntry{
+				UID:       uid,
+				Kind:      models.CalendarEventBooking,
+				Start:     day,
+				End:       day.AddDate(0, 0, 1),
+				Status:    models.CalendarCancelled,
+				EventName: eventNames[booking.EventID],
+			}
+			cancelledByUID[uid] = entry
+			entries = append(entries, entry)
+		}
+
+		if booking.CancelledAt.After(entry.UpdatedAt) {
+			entry.UpdatedAt = booking.CancelledAt
+		}
+		layout.addTables(entry, []int64{booking.TableID})
+	}
+
+	return entries, nil
+}
+
+// calendarLayout — столы и сочетания ресторана для подписей в календаре.
+type calendarLayout struct {
+	tables       map[int64]*models.RestaurantTable
+	combinations map[int64]*models.TableCombination
+}
+
+func (l *calendarLayout) reservationEntry(reservation *models.Reservation, loc *time.Location) *models.CalendarEntry {
+	start := reservation.StartTime.In(loc)
+	entry := &models.CalendarEntry{
+		UID:       fmt.Sprintf("reservation-%d@%s", reservation.ID, calendarUIDDomain),
+		Kind:      models.CalendarReservation,
+		Start:     start,
+		End:       start.Add(time.Duration(reservation.DurationMinutes) * time.Minute),
+		Status:    reservationCalendarStatus(reservation.Status),
+		PartySize: reservation.PartySize,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+
+	tableIDs := []int64{reservation.TableID}
+	if reservation.CombinationID != nil {
+		if combination, ok := l.combinations[*reservation.CombinationID]; ok {
+			tableIDs = combination.TableIDs
+		}
+	}
+	l.addTables(entry, tableIDs)
+
+	return entry
+}
+
+// addTables добавляет к записи номера столов и названия их секций без
+// повторов.
+func (l *calendarLayout) addTables(entry *models.CalendarEntry, tableIDs []int64) {
+	for _, id := range tableIDs {
+		table, ok := l.tables[id]
+		if !ok {
+			continue
+		}
+
+		entry.Tables = append(entry.Tables, table.NumberOfTable)
+
+		known := false
+		for _, name := range entry.Sections {
+			if name == table.SectionName {
+				known = true
+				break
+			}
+		}
+		if !known {
+			entry.Sections = append(entry.Sections, table.SectionName)
+		}
+	}
+}
+
+func reservationCalendarStatus(status models.ReservationStatus) models.CalendarStatus {
+	switch status {
+	case models.ReservationPending:
+		return models.CalendarTentative
+	case models.ReservationCancelled, models.ReservationNoShow:
+		return models.CalendarCancelled
+	}
+	return models.CalendarConfirmed
+}
+
+// eventBookingUID не меняется, пока бронирование ждет оплаты и после нее:
+// календарь обновляет ту же запись.
+func eventBookingUID(booking *models.RestaurantEventTable, day time.Time) string {
+	switch {
+	case booking.DepositID != nil:
+		return depositUID(*booking.DepositID)
+	case booking.CombinationID != nil:
+		return fmt.Sprintf("event-%d-%s-combination-%d@%s", booking.EventID, day.Format(calendarDayLayout),
+			*booking.CombinationID, calendarUIDDomain)
+	}
+	return fmt.Sprintf("event-%d-%s-table-%d@%s", booking.EventID, day.Format(calendarDayLayout),
+		booking.TableID, calendarUIDDomain)
+}
+
+func depositUID(depositID int64) string {
+	return fmt.Sprintf("event-deposit-%d@%s", depositID, calendarUIDDomain)
+}
+
+func hashCalendarToken(token string) string {
+	return hashAPIKey(token)
+}
@@ -4,33 +4,62 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/repository"
 )
 
+// defaultTimeZone — часовой пояс города, если он не указан.
+const defaultTimeZone = "Asia/Almaty"
+
 type CityUC struct {
 	cityRepo repository.CityRepository
+	access   *AccessControl
+	audit    *AuditUC
 }
 
-func NewCityUseCase(cityRepo repository.CityRepository) *CityUC {
+func NewCityUseCase(cityRepo repository.CityRepository, access *AccessControl, audit *AuditUC) *CityUC {
 	return &CityUC{
 		cityRepo: cityRepo,
+		access:   access,
+		audit:    audit,
 	}
 }
 
 func (uc *CityUC) Create(ctx context.Context, city *models.City) (int64, error) {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return 0, err
+	}
+
 	if err := validateCity(city); err != nil {
 		return 0, err
 	}
 
+	city.TimeZone = strings.TrimSpace(city.TimeZone)
+	if city.TimeZone == "" {
+		city.TimeZone = defaultTimeZone
+	}
+	if err := validateTimeZone(city.TimeZone); err != nil {
+		return 0, err
+	}
+
 	city.Name = strings.TrimSpace(city.Name)
 	existingCity, err := uc.cityRepo.GetByName(ctx, city.Name)
 	if err == nil && existingCity != nil {
-		return 0, fmt.Errorf("город с названием '%s' уже существует", city.Name)
+		return 0, errs.Conflict(i18n.CodeCityExists, city.Name)
+	}
+
+	id, err := uc.cityRepo.Create(ctx, city)
+	if err != nil {
+		return 0, err
 	}
 
-	return uc.cityRepo.Create(ctx, city)
+	city.ID = id
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityCity, id, nil, city)
+	return id, nil
 }
 
 func (uc *CityUC) GetByID(ctx context.Context, id int64) (*models.City, error) {
@@ -42,6 +71,10 @@ func (uc *CityUC) GetByID(ctx context.Context, id int64) (*models.City, error) {
 }
 
 func (uc *CityUC) Update(ctx context.Context, city *models.City) error {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
 	if err := validateCity(city); err != nil {
 		return err
 	}
@@ -51,24 +84,46 @@ func (uc *CityUC) Update(ctx context.Context, city *models.City) error {
 		return fmt.Errorf("не удалось найти город для обновления: %w", err)
 	}
 
+	city.TimeZone = strings.TrimSpace(city.TimeZone)
+	if city.TimeZone == "" {
+		city.TimeZone = existingCity.TimeZone
+	}
+	if err := validateTimeZone(city.TimeZone); err != nil {
+		return err
+	}
+
 	city.Name = strings.TrimSpace(city.Name)
 	if existingCity.Name != city.Name {
 		dupCity, err := uc.cityRepo.GetByName(ctx, city.Name)
 		if err == nil && dupCity != nil && dupCity.ID != city.ID {
-			return fmt.Errorf("город с названием '%s' уже существует", city.Name)
+			return errs.Conflict(i18n.CodeCityExists, city.Name)
 		}
 	}
 
-	return uc.cityRepo.Update(ctx, city)
+	if err := uc.cityRepo.Update(ctx, city); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityCity, city.ID, existingCity, city)
+	return nil
 }
 
 func (uc *CityUC) Delete(ctx context.Context, id int64) error {
-	_, err := uc.cityRepo.GetByID(ctx, id)
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	city, err := uc.cityRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("не удалось найти город для удаления: %w", err)
 	}
 
-	return uc.cityRepo.Delete(ctx, id)
+	if err := uc.cityRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityCity, id, city, nil)
+	return nil
 }
 
 func (uc *CityUC) List(ctx context.Context) ([]*models.City, error) {
@@ -78,12 +133,24 @@ func (uc *CityUC) List(ctx context.Context) ([]*models.City, error) {
 func validateCity(city *models.City) error {
 	city.Name = strings.TrimSpace(city.Name)
 	if city.Name == "" {
-		return fmt.Errorf("название города не может быть пустым")
+		return errs.Invalid("name", i18n.CodeCityNameRequired)
 	}
 
 	if len(city.Name) < 2 {
-		return fmt.Errorf("название города должно быть не менее 2 символов")
+		return errs.Invalid("name", i18n.CodeCityNameTooShort)
 	}
 
 	return nil
 }
+
+// validateTimeZone проверяет, что zone — название часового пояса из базы
+// IANA. Local и пустая строка не допускаются: они зависят от сервера.
+func validateTimeZone(zone string) error {
+	if zone == "" || zone == "Local" {
+		return errs.Invalid("time_zone", i18n.CodeTimeZoneUnknown, zone)
+	}
+	if _, err := time.LoadLocation(zone); err != nil {
+		return errs.Invalid("time_zone", i18n.CodeTimeZoneUnknown, zone)
+	}
+	return nil
+}
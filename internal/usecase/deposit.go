@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/payment"
+	"restaurant-management/internal/repository"
+)
+
+// maxEventGuests ограничивает число гостей, от которого считается депозит.
+const maxEventGuests = 1000
+
+// newDeposit считает депозит за бронирование под событие. Возвращает nil,
+// если событие не требует депозита или сумма получилась нулевой.
+func (uc *RestaurantEventTableUC) newDeposit(event *models.RestaurantEvent, day time.Time,
+	guests int) (*models.EventDeposit, error) {
+	var amount float64
+	switch event.DepositType {
+	case models.DepositFixed:
+		amount = event.DepositValue
+	case models.DepositPercent:
+		if guests == 0 {
+			return nil, errs.Invalid("guests", i18n.CodeEventGuestsRequired)
+		}
+		amount = event.Price * float64(guests) * event.DepositValue / 100
+	}
+
+	amount = math.Round(amount*100) / 100
+	if amount <= 0 {
+		return nil, nil
+	}
+
+	return &models.EventDeposit{
+		EventID:     &event.ID,
+		BookingDate: day,
+		Guests:      guests,
+		Amount:      amount,
+		Status:      models.DepositPending,
+		ExpiresAt:   time.Now().Add(uc.cfg.DepositTTL),
+	}, nil
+}
+
+// requestPayment создает платеж у провайдера. Если провайдер недоступен,
+// бронирование снимается: иначе столы держал бы депозит, который нельзя
+// оплатить.
+func requestPayment(ctx context.Context, payments payment.Provider, depositRepo repository.EventDepositRepository,
+	audit *AuditUC, deposit *models.EventDeposit, description string) error {
+	checkout, err := payments.CreatePayment(ctx, payment.Request{
+		OrderID:     strconv.FormatInt(deposit.ID, 10),
+		Amount:      deposit.Amount,
+		Description: description,
+	})
+	if err == nil {
+		err = depositRepo.SetPayment(ctx, deposit.ID, checkout.PaymentID, checkout.URL)
+	}
+
+	if err != nil {
+		if releaseErr := depositRepo.Release(ctx, deposit.ID, models.DepositCancelled); releaseErr != nil {
+			log.Printf("Не удалось снять бронирование депозита %d: %v", deposit.ID, releaseErr)
+		}
+		return errs.Wrap(err, errs.KindUnavailable, i18n.CodePaymentUnavailable)
+	}
+
+	deposit.PaymentID = checkout.PaymentID
+	deposit.PaymentURL = checkout.URL
+	audit.record(ctx, models.AuditActionCreate, models.AuditEntityDeposit, deposit.ID, nil, deposit)
+	return nil
+}
+
+// ConfirmPayment принимает уведомление провайдера о платеже. Успешная
+// оплата подтверждает бронирования депозита; повторное уведомление об уже
+// принятой оплате ничего не меняет. Неуспешная оплата отменяет депозит и
+// сразу освобождает столы: платеж у провайдера закрыт, и оплатить его еще
+// раз гость не сможет.
+func (uc *RestaurantEventTableUC) ConfirmPayment(ctx context.Context, body []byte, signature string) error {
+	callback, err := uc.payments.ParseCallback(body, signature)
+	if err != nil {
+		if errors.Is(err, payment.ErrInvalidSignature) {
+			return errs.Unauthorized(i18n.CodePaymentSignature)
+		}
+		return errs.Wrap(err, errs.KindValidation, i18n.CodeInvalidPaymentData)
+	}
+
+	deposit, err := uc.depositRepo.GetByPaymentID(ctx, callback.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	switch callback.Status {
+	case payment.StatusSucceeded:
+	case payment.StatusFailed:
+		return uc.cancelDeposit(ctx, deposit)
+	default:
+		return nil
+	}
+
+	if math.Round(callback.Amount*100) != math.Round(deposit.Amount*100) {
+		return errs.Invalid("amount", i18n.CodePaymentAmount, deposit.Amount)
+	}
+
+	if deposit.Status == models.DepositPaid {
+		return nil
+	}
+
+	if err := uc.depositRepo.MarkPaid(ctx, deposit.ID); err != nil {
+		return err
+	}
+
+	paid := *deposit
+	paid.Status = models.DepositPaid
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityDeposit, deposit.ID, deposit, &paid)
+	return nil
+}
+
+// cancelDeposit снимает бронирования депозита после неуспешной оплаты. Уже
+// снятый или оплаченный депозит не меняется.
+func (uc *RestaurantEventTableUC) cancelDeposit(ctx context.Context, deposit *models.EventDeposit) error {
+	if deposit.Status != models.DepositPending {
+		return nil
+	}
+
+	if err := uc.depositRepo.Release(ctx, deposit.ID, models.DepositCancelled); err != nil {
+		if errs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	cancelled := *deposit
+	cancelled.Status = models.DepositCancelled
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityDeposit, deposit.ID, deposit, &cancelled)
+	return nil
+}
+
+// ExpireDeposits освобождает столы бронирований, депозит которых не оплачен
+// в срок.
+func (uc *RestaurantEventTableUC) ExpireDeposits(ctx context.Context) (int64, error) {
+	return uc.depositRepo.ExpireOverdue(ctx)
+}
@@ -0,0 +1,388 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/config"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/payment"
+	"restaurant-management/internal/repository"
+)
+
+// Тесты проверяют поведение RestaurantEventTableUC в потоке депозита.
+// Репозитории заменены фейками, которые записывают вызовы и возвращают
+// заданные результаты, поэтому запросы EventDepositRepository (снятие
+// просроченных депозитов, отказ MarkPaid после срока, подтверждение заявки)
+// здесь не проверяются.
+
+const (
+	testRestaurantID = 1
+	testSectionID    = 10
+	testTableID      = 100
+	testEventID      = 1000
+	testDepositID    = 7
+	testWebhookKey   = "test-webhook-secret"
+)
+
+type fakeBookings struct {
+	repository.RestaurantEventTableRepository
+
+	created []*models.RestaurantEventTable
+	deposit *models.EventDeposit
+}
+
+func (f *fakeBookings) Create(ctx context.Context, bookings []*models.RestaurantEventTable,
+	deposit *models.EventDeposit) error {
+	if deposit != nil {
+		deposit.ID = testDepositID
+	}
+	f.created = append(f.created, bookings...)
+	f.deposit = deposit
+	return nil
+}
+
+type releaseCall struct {
+	id     int64
+	status models.DepositStatus
+}
+
+type fakeDeposits struct {
+	repository.EventDepositRepository
+
+	// deposit возвращает GetByPaymentID, markPaidErr — MarkPaid.
+	deposit     *models.EventDeposit
+	markPaidErr error
+
+	lookups  []string
+	payments []string
+	paid     []int64
+	released []releaseCall
+}
+
+func (f *fakeDeposits) GetByPaymentID(ctx context.Context, paymentID string) (*models.EventDeposit, error) {
+	f.lookups = append(f.lookups, paymentID)
+	if f.deposit == nil || f.deposit.PaymentID != paymentID {
+		return nil, errs.NotFound(i18n.CodeDepositNotFound, paymentID)
+	}
+	found := *f.deposit
+	return &found, nil
+}
+
+func (f *fakeDeposits) SetPayment(ctx context.Context, id int64, paymentID, paymentURL string) error {
+	f.payments = append(f.payments, paymentID)
+	return nil
+}
+
+func (f *fakeDeposits) MarkPaid(ctx context.Context, id int64) error {
+	f.paid = append(f.paid, id)
+	return f.markPaidErr
+}
+
+func (f *fakeDeposits) Release(ctx context.Context, id int64, status models.DepositStatus) error {
+	f.released = append(f.released, releaseCall{id: id, status: status})
+	return nil
+}
+
+// Ресторан с одним столом и событием с фиксированным депозитом. Ресторан
+// открыт круглосуточно, закрытых секций нет.
+type fixtureEvents struct {
+	repository.RestaurantEventRepository
+	event *models.RestaurantEvent
+}
+
+func (f fixtureEvents) GetByID(ctx context.Context, id int64) (*models.RestaurantEvent, error) {
+	if id != f.event.ID {
+		return nil, errs.NotFound(i18n.CodeEventNotFound, id)
+	}
+	return f.event, nil
+}
+
+type fixtureTables struct{ repository.TableRepository }
+
+func (fixtureTables) GetByID(ctx context.Context, id int64) (*models.Table, error) {
+	if id != testTableID {
+		return nil, errs.NotFound(i18n.CodeTableNotFound, id)
+	}
+	return &models.Table{ID: testTableID, SectionID: testSectionID}, nil
+}
+
+type fixtureSections struct{ repository.SectionRepository }
+
+func (fixtureSections) GetByID(ctx context.Context, id int64) (*models.Section, error) {
+	if id != testSectionID {
+		return nil, errs.NotFound(i18n.CodeSectionNotFound, id)
+	}
+	return &models.Section{ID: testSectionID, RestaurantID: testRestaurantID}, nil
+}
+
+type fixtureRestaurants struct {
+	repository.RestaurantRepository
+}
+
+func (fixtureRestaurants) GetTimeZone(ctx context.Context, id int64) (string, error) {
+	return "Asia/Almaty", nil
+}
+
+type fixtureHours struct {
+	repository.OpeningHoursRepository
+}
+
+func (fixtureHours) GetWeekly(ctx context.Context, restaurantID int64) ([]*models.OpeningInterval, error) {
+	return nil, nil
+}
+
+func (fixtureHours) GetSpecialDays(ctx context.Context, restaurantID int64,
+	from, to time.Time) ([]*models.SpecialDay, error) {
+	return nil, nil
+}
+
+type fixtureBlackouts struct {
+	repository.SectionBlackoutRepository
+}
+
+func (fixtureBlackouts) GetByRestaurant(ctx context.Context, restaurantID int64,
+	start, end time.Time) ([]*models.SectionBlackout, error) {
+	return nil, nil
+}
+
+type fixtureAudit struct{ repository.AuditRepository }
+
+func (fixtureAudit) Create(ctx context.Context, entry *models.AuditEntry) error {
+	return nil
+}
+
+// unavailableProvider отказывает в создании любого платежа.
+type unavailableProvider struct{ payment.Provider }
+
+func (unavailableProvider) CreatePayment(ctx context.Context, req payment.Request) (*payment.Checkout, error) {
+	return nil, errors.New("провайдер недоступен")
+}
+
+type depositTest struct {
+	uc       *RestaurantEventTableUC
+	bookings *fakeBookings
+	deposits *fakeDeposits
+	provider *payment.FakeProvider
+	ctx      context.Context
+}
+
+func newDepositTest(t *testing.T, provider payment.Provider) *depositTest {
+	t.Helper()
+
+	fake := payment.NewFakeProvider(testWebhookKey, "https://pay.example.com/checkout")
+	if provider == nil {
+		provider = fake
+	}
+
+	events := fixtureEvents{event: &models.RestaurantEvent{
+		ID:           testEventID,
+		RestaurantID: testRestaurantID,
+		Name:         "Банкет",
+		Price:        10000,
+		DepositType:  models.DepositFixed,
+		DepositValue: 5000,
+	}}
+	bookings := &fakeBookings{}
+	deposits := &fakeDeposits{}
+
+	access := NewAccessControl(nil)
+	audit := NewAuditUseCase(fixtureAudit{}, access, 0)
+	schedule := NewScheduleUseCase(fixtureHours{}, fixtureBlackouts{}, fixtureRestaurants{}, fixtureSections{},
+		access, audit)
+	uc := NewRestaurantEventTableUseCase(bookings, deposits, events, fixtureTables{}, nil, fixtureSections{},
+		provider, schedule, access, audit, config.PaymentConfig{DepositTTL: 30 * time.Minute})
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{
+		Kind:   auth.PrincipalUser,
+		UserID: 1,
+		Role:   models.RoleAdmin,
+	})
+	return &depositTest{uc: uc, bookings: bookings, deposits: deposits, provider: fake, ctx: ctx}
+}
+
+func (dt *depositTest) book() (*models.EventDeposit, error) {
+	return dt.uc.Book(dt.ctx, testEventID, &models.EventBookingRequest{
+		TableID:     testTableID,
+		BookingDate: time.Now().AddDate(0, 0, 7),
+		Guests:      4,
+	})
+}
+
+// storedDeposit задает депозит в статусе status, который GetByPaymentID
+// находит по платежу fake_payment.
+func (dt *depositTest) storedDeposit(status models.DepositStatus) *models.EventDeposit {
+	dt.deposits.deposit = &models.EventDeposit{
+		ID:        testDepositID,
+		Amount:    5000,
+		Status:    status,
+		PaymentID: "fake_payment",
+		ExpiresAt: time.Now().Add(30 * time.Minute),
+	}
+	return dt.deposits.deposit
+}
+
+func (dt *depositTest) callback(t *testing.T, status payment.Status, amount float64) ([]byte, string) {
+	t.Helper()
+
+	body, signature, err := dt.provider.SignCallback(payment.Callback{
+		PaymentID: dt.deposits.deposit.PaymentID,
+		Status:    status,
+		Amount:    amount,
+	})
+	if err != nil {
+		t.Fatalf("SignCallback: %v", err)
+	}
+	return body, signature
+}
+
+func TestBookWithDepositAwaitsPayment(t *testing.T) {
+	dt := newDepositTest(t, nil)
+
+	deposit, err := dt.book()
+	if err != nil {
+		t.Fatalf("Book: %v", err)
+	}
+	if deposit == nil || deposit != dt.bookings.deposit {
+		t.Fatal("Book не вернул депозит, сохраненный вместе с бронированием")
+	}
+
+	if deposit.Amount != 5000 || deposit.Status != models.DepositPending {
+		t.Errorf("депозит %v в статусе %q, ожидалось 5000 в статусе %q",
+			deposit.Amount, deposit.Status, models.DepositPending)
+	}
+	if len(dt.bookings.created) != 1 || dt.bookings.created[0].Status != models.EventBookingPendingPayment {
+		t.Fatalf("бронирования %+v, ожидалось одно в статусе %q", dt.bookings.created, models.EventBookingPendingPayment)
+	}
+
+	if len(dt.deposits.payments) != 1 || dt.deposits.payments[0] != deposit.PaymentID {
+		t.Errorf("платеж депозита сохранен %v раз, ожидался один платеж %q", dt.deposits.payments, deposit.PaymentID)
+	}
+	if !strings.HasPrefix(deposit.PaymentURL, "https://pay.example.com/checkout/") {
+		t.Errorf("ссылка на оплату %q", deposit.PaymentURL)
+	}
+}
+
+func TestBookReleasesDepositWhenProviderUnavailable(t *testing.T) {
+	dt := newDepositTest(t, unavailableProvider{})
+
+	_, err := dt.book()
+	if errs.KindOf(err) != errs.KindUnavailable {
+		t.Fatalf("ошибка %v, ожидалась недоступность провайдера", err)
+	}
+
+	want := releaseCall{id: testDepositID, status: models.DepositCancelled}
+	if len(dt.deposits.released) != 1 || dt.deposits.released[0] != want {
+		t.Errorf("снятия депозита %v, ожидалось %v", dt.deposits.released, want)
+	}
+}
+
+func TestConfirmPaymentMarksDepositPaid(t *testing.T) {
+	dt := newDepositTest(t, nil)
+	deposit := dt.storedDeposit(models.DepositPending)
+
+	body, signature := dt.callback(t, payment.StatusSucceeded, deposit.Amount)
+	if err := dt.uc.ConfirmPayment(context.Background(), body, signature); err != nil {
+		t.Fatalf("ConfirmPayment: %v", err)
+	}
+
+	if len(dt.deposits.paid) != 1 || dt.deposits.paid[0] != deposit.ID {
+		t.Errorf("оплата отмечена для %v, ожидалось для депозита %d", dt.deposits.paid, deposit.ID)
+	}
+}
+
+func TestConfirmPaymentIgnoresRepeatedCallback(t *testing.T) {
+	dt := newDepositTest(t, nil)
+	deposit := dt.storedDeposit(models.DepositPaid)
+
+	body, signature := dt.callback(t, payment.StatusSucceeded, deposit.Amount)
+	if err := dt.uc.ConfirmPayment(context.Background(), body, signature); err != nil {
+		t.Fatalf("ConfirmPayment: %v", err)
+	}
+
+	if len(dt.deposits.paid) != 0 {
+		t.Errorf("оплаченный депозит отмечен повторно: %v", dt.deposits.paid)
+	}
+}
+
+func TestConfirmPaymentRejectsLateCallback(t *testing.T) {
+	dt := newDepositTest(t, nil)
+	deposit := dt.storedDeposit(models.DepositPending)
+	dt.deposits.markPaidErr = errs.Conflict(i18n.CodeDepositNotPending, models.DepositExpired)
+
+	body, signature := dt.callback(t, payment.StatusSucceeded, deposit.Amount)
+	err := dt.uc.ConfirmPayment(context.Background(), body, signature)
+	if errs.KindOf(err) != errs.KindConflict {
+		t.Errorf("ошибка %v, ожидался конфликт", err)
+	}
+}
+
+func TestConfirmPaymentRejectsAmountMismatch(t *testing.T) {
+	dt := newDepositTest(t, nil)
+	deposit := dt.storedDeposit(models.DepositPending)
+
+	body, signature := dt.callback(t, payment.StatusSucceeded, deposit.Amount-1)
+	err := dt.uc.ConfirmPayment(context.Background(), body, signature)
+	if errs.KindOf(err) != errs.KindValidation {
+		t.Errorf("ошибка %v, ожидалась ошибка проверки суммы", err)
+	}
+
+	if len(dt.deposits.paid) != 0 {
+		t.Errorf("оплата с другой суммой отмечена: %v", dt.deposits.paid)
+	}
+}
+
+func TestConfirmPaymentFailedReleasesDeposit(t *testing.T) {
+	dt := newDepositTest(t, nil)
+	deposit := dt.storedDeposit(models.DepositPending)
+
+	body, signature := dt.callback(t, payment.StatusFailed, deposit.Amount)
+	if err := dt.uc.ConfirmPayment(context.Background(), body, signature); err != nil {
+		t.Fatalf("ConfirmPayment: %v", err)
+	}
+
+	want := releaseCall{id: deposit.ID, status: models.DepositCancelled}
+	if len(dt.deposits.released) != 1 || dt.deposits.released[0] != want {
+		t.Errorf("снятия депозита %v, ожидалось %v", dt.deposits.released, want)
+	}
+	if len(dt.deposits.paid) != 0 {
+		t.Errorf("неуспешная оплата отмечена как успешная: %v", dt.deposits.paid)
+	}
+}
+
+func TestConfirmPaymentRejectsBadSignature(t *testing.T) {
+	dt := newDepositTest(t, nil)
+	deposit := dt.storedDeposit(models.DepositPending)
+
+	body, _ := dt.callback(t, payment.StatusSucceeded, deposit.Amount)
+	_, forged, err := payment.NewFakeProvider("другой секрет", "").SignCallback(payment.Callback{
+		PaymentID: deposit.PaymentID,
+		Status:    payment.StatusSucceeded,
+		Amount:    deposit.Amount,
+	})
+	if err != nil {
+		t.Fatalf("SignCallback: %v", err)
+	}
+
+	for name, signature := range map[string]string{
+		"чужой секрет": forged,
+		"не hex":       "not-a-signature",
+		"пустая":       "",
+	} {
+		err := dt.uc.ConfirmPayment(context.Background(), body, signature)
+		if errs.KindOf(err) != errs.KindUnauthorized {
+			t.Errorf("%s: ошибка %v, ожидался отказ в авторизации", name, err)
+		}
+	}
+
+	if len(dt.deposits.lookups) != 0 || len(dt.deposits.paid) != 0 {
+		t.Errorf("уведомление с неверной подписью обработано: поиски %v, оплаты %v",
+			dt.deposits.lookups, dt.deposits.paid)
+	}
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+)
+
+// referenceError превращает отсутствие сущности, на которую ссылается поле
+// запроса, в ошибку валидации этого поля. Остальные ошибки, например сбои
+// базы данных, возвращаются без изменений.
+func referenceError(err error, field string, code i18n.Code) error {
+	if errs.IsNotFound(err) {
+		return errs.Invalid(field, code)
+	}
+	return err
+}
@@ -0,0 +1,548 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+	"restaurant-management/pkg/phone"
+)
+
+const (
+	maxInquiryContactName = 100
+	maxInquiryNote        = 2000
+)
+
+// inquiryTransitions перечисляет статусы, в которые заявку переводят
+// вручную. Этапы можно пропускать, но не возвращаться к предыдущим.
+// deposit_paid и confirmed ставятся только переводом заявки в бронирование
+// и оплатой депозита; confirmed и lost — конечные статусы.
+var inquiryTransitions = map[models.InquiryStatus][]models.InquiryStatus{
+	models.InquiryNew:       {models.InquiryContacted, models.InquiryQuoted, models.InquiryLost},
+	models.InquiryContacted: {models.InquiryQuoted, models.InquiryLost},
+	models.InquiryQuoted:    {models.InquiryLost},
+}
+
+type EventInquiryUC struct {
+	inquiryRepo    repository.EventInquiryRepository
+	eventRepo      repository.RestaurantEventRepository
+	eventTypeRepo  repository.EventTypeRepository
+	restaurantRepo repository.RestaurantRepository
+	tableRepo      repository.TableRepository
+	sectionRepo    repository.SectionRepository
+	staffRepo      repository.StaffRepository
+	depositRepo    repository.EventDepositRepository
+	bookings       *RestaurantEventTableUC
+	schedule       *ScheduleUC
+	access         *AccessControl
+	audit          *AuditUC
+}
+
+func NewEventInquiryUseCase(inquiryRepo repository.EventInquiryRepository,
+	eventRepo repository.RestaurantEventRepository, eventTypeRepo repository.EventTypeRepository,
+	restaurantRepo repository.RestaurantRepository, tableRepo repository.TableRepository,
+	sectionRepo repository.SectionRepository, staffRepo repository.StaffRepository,
+	depositRepo repository.EventDepositRepository, bookings *RestaurantEventTableUC, schedule *ScheduleUC, access *AccessControl, audit *AuditUC) *EventInquiryUC {
+	return &EventInquiryUC{
+		inquiryRepo:    inquiryRepo,
+		eventRepo:      eventRepo,
+		eventTypeRepo:  eventTypeRepo,
+		restaurantRepo: restaurantRepo,
+		tableRepo:      tableRepo,
+		sectionRepo:    sectionRepo,
+		staffRepo:      staffRepo,
+		depositRepo:    depositRepo,
+		bookings:       bookings,
+		schedule:       schedule,
+		access:         access,
+		audit:          audit,
+	}
+}
+
+// Create записывает новую заявку ресторана, например после звонка гостя.
+func (uc *EventInquiryUC) Create(ctx context.Context, inquiry *models.EventInquiry) (int64, error) {
+	if err := uc.access.RequireRestaurantStaff(ctx, inquiry.RestaurantID); err != nil {
+		return 0, err
+	}
+
+	if _, err := uc.restaurantRepo.GetByID(ctx, inquiry.RestaurantID); err != nil {
+		return 0, err
+	}
+
+	if err := uc.validateInquiry(ctx, inquiry, ""); err != nil {
+		return 0, err
+	}
+
+	inquiry.Status = models.InquiryNew
+	inquiry.AssignedTo = nil
+	inquiry.EventID = nil
+	inquiry.DepositID = nil
+
+	id, err := uc.inquiryRepo.Create(ctx, inquiry, newInquiryActivity(ctx, 0, models.InquiryActivityCreated))
+	if err != nil {
+		return 0, err
+	}
+
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityInquiry, id, nil, inquiry)
+	return id, nil
+}
+
+func (uc *EventInquiryUC) GetByID(ctx context.Context, id int64) (*models.EventInquiry, error) {
+	inquiry, err := uc.inquiryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantStaff(ctx, inquiry.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	return inquiry, nil
+}
+
+func (uc *EventInquiryUC) GetByRestaurant(ctx context.Context, restaurantID int64,
+	filter models.InquiryFilter) ([]*models.EventInquiry, error) {
+	if err := uc.access.RequireRestaurantStaff(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	if filter.Status != "" && !validInquiryStatus(filter.Status) {
+		return nil, errs.Invalid("status", i18n.CodeInquiryStatusUnknown, filter.Status)
+	}
+
+	if _, err := uc.restaurantRepo.GetByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return uc.inquiryRepo.GetByRestaurant(ctx, restaurantID, filter)
+}
+
+// Update меняет контакт, дату, число гостей, тип события и заметки открытой
+// заявки. Статус и менеджер меняются отдельными действиями.
+func (uc *EventInquiryUC) Update(ctx context.Context, inquiry *models.EventInquiry) error {
+	existing, err := uc.GetByID(ctx, inquiry.ID)
+	if err != nil {
+		return fmt.Errorf("не удалось найти заявку для обновления: %w", err)
+	}
+
+	if isInquiryClosed(existing.Status) {
+		return errs.Conflict(i18n.CodeInquiryClosed, existing.ID)
+	}
+
+	// Дата и число гостей уже заложены в бронирование, которое ждет оплаты.
+	if err := uc.requireNoPendingDeposit(ctx, existing); err != nil {
+		return err
+	}
+
+	inquiry.RestaurantID = existing.RestaurantID
+	if err := uc.validateInquiry(ctx, inquiry, existing.EventType); err != nil {
+		return err
+	}
+
+	activity := newInquiryActivity(ctx, inquiry.ID, models.InquiryActivityUpdated)
+	if err := uc.inquiryRepo.Update(ctx, inquiry, activity); err != nil {
+		return err
+	}
+
+	inquiry.Status = existing.Status
+	inquiry.AssignedTo = existing.AssignedTo
+	inquiry.EventID = existing.EventID
+	inquiry.DepositID = existing.DepositID
+	inquiry.CreatedAt = existing.CreatedAt
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityInquiry, inquiry.ID, existing, inquiry)
+	return nil
+}
+
+// UpdateStatus двигает заявку по воронке. Заметка попадает в журнал,
+// например причина, по которой заявка потеряна.
+func (uc *EventInquiryUC) UpdateStatus(ctx context.Context, id int64, req *models.InquiryStatusRequest) error {
+	inquiry, err := uc.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case req.Status == models.InquiryConfirmed:
+		return errs.Invalid("status", i18n.CodeInquiryConfirmByConvert)
+	case req.Status == models.InquiryDepositPaid:
+		return errs.Invalid("status", i18n.CodeInquiryDepositByPayment)
+	case !validInquiryStatus(req.Status):
+		return errs.Invalid("status", i18n.CodeInquiryStatusUnknown, req.Status)
+	case !slices.Contains(inquiryTransitions[inquiry.Status], req.Status):
+		return errs.Conflict(i18n.CodeInquiryTransition, inquiry.Status, req.Status)
+	}
+
+	note, err := inquiryNote(req.Note, false)
+	if err != nil {
+		return err
+	}
+
+	// Потерянная заявка не должна подтвердиться оплатой позже.
+	if err := uc.requireNoPendingDeposit(ctx, inquiry); err != nil {
+		return err
+	}
+
+	activity := newInquiryActivity(ctx, id, models.InquiryActivityStatus)
+	activity.Status = &req.Status
+	activity.Note = note
+	if err := uc.inquiryRepo.UpdateStatus(ctx, id, inquiry.Status, req.Status, activity); err != nil {
+		return err
+	}
+
+	updated := *inquiry
+	updated.Status = req.Status
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityInquiry, id, inquiry, &updated)
+	return nil
+}
+
+// Assign назначает открытой заявке менеджера ресторана или снимает
+// назначение, если userID nil.
+func (uc *EventInquiryUC) Assign(ctx context.Context, id int64, userID *int64) error {
+	inquiry, err := uc.inquiryRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, inquiry.RestaurantID); err != nil {
+		return err
+	}
+
+	if isInquiryClosed(inquiry.Status) {
+		return errs.Conflict(i18n.CodeInquiryClosed, id)
+	}
+
+	if userID != nil {
+		assignment, err := uc.staffRepo.Get(ctx, *userID, inquiry.RestaurantID)
+		if err != nil && !errs.IsNotFound(err) {
+			return err
+		}
+		if assignment == nil || assignment.Role != models.RoleManager {
+			return errs.Invalid("user_id", i18n.CodeInquiryAssigneeInvalid, *userID, inquiry.RestaurantID)
+		}
+	}
+
+	activity := newInquiryActivity(ctx, id, models.InquiryActivityAssigned)
+	activity.AssignedTo = userID
+	if err := uc.inquiryRepo.Assign(ctx, id, userID, activity); err != nil {
+		return err
+	}
+
+	updated := *inquiry
+	updated.AssignedTo = userID
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityInquiry, id, inquiry, &updated)
+	return nil
+}
+
+// AddNote записывает в журнал заявки заметку, например итог звонка. Заметки
+// можно добавлять и к закрытой заявке.
+func (uc *EventInquiryUC) AddNote(ctx context.Context, id int64, note string) (*models.InquiryActivity, error) {
+	if _, err := uc.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	note, err := inquiryNote(note, true)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := newInquiryActivity(ctx, id, models.InquiryActivityNote)
+	activity.Note = note
+	if err := uc.inquiryRepo.AddActivity(ctx, activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (uc *EventInquiryUC) GetActivities(ctx context.Context, id int64) ([]*models.InquiryActivity, error) {
+	if _, err := uc.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return uc.inquiryRepo.GetActivities(ctx, id)
+}
+
+// Convert переводит заявку в бронирование события на желаемую дату:
+// занимает выбранные столы или все столы секции. Без депозита заявка сразу
+// подтверждается. Если событие требует депозит, бронирование, как и обычное,
+// ждет оплаты в статусе pending_payment, а заявку подтверждает уведомление
+// об оплате; истекший депозит снимает бронирование, и заявку можно
+// перевести заново.
+func (uc *EventInquiryUC) Convert(ctx context.Context, id int64,
+	req *models.InquiryConversion) (*models.InquiryConversionResult, error) {
+	inquiry, err := uc.inquiryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, inquiry.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	if (len(req.TableIDs) == 0) == (req.SectionID == nil) {
+		return nil, errs.Invalid("table_ids", i18n.CodeInquiryTablesOrSection)
+	}
+
+	note, err := inquiryNote(req.Note, false)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := uc.eventRepo.GetByID(ctx, req.EventID)
+	if err != nil {
+		return nil, referenceError(err, "event_id", i18n.CodeEventNotExists)
+	}
+	if event.RestaurantID != inquiry.RestaurantID {
+		return nil, errs.Invalid("event_id", i18n.CodeInquiryEventOutside, event.ID, inquiry.RestaurantID)
+	}
+
+	if inquiry.Status != models.InquiryQuoted {
+		return nil, errs.Conflict(i18n.CodeInquiryNotConvertible, inquiry.Status)
+	}
+	if err := uc.requireNoPendingDeposit(ctx, inquiry); err != nil {
+		return nil, err
+	}
+
+	tableIDs, err := uc.conversionTables(ctx, inquiry.RestaurantID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := uc.desiredDay(ctx, inquiry.RestaurantID, inquiry.DesiredDate)
+	if err != nil {
+		return nil, err
+	}
+
+	bookings, deposit, err := uc.bookings.tableBookings(ctx, event, day, inquiry.Guests, tableIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := newInquiryActivity(ctx, id, models.InquiryActivityConverted)
+	activity.Note = note
+	if deposit == nil {
+		confirmed := models.InquiryConfirmed
+		activity.Status = &confirmed
+	}
+	if err := uc.inquiryRepo.Convert(ctx, id, inquiry.Status, event.ID, bookings, deposit, activity); err != nil {
+		return nil, uc.schedule.localizeConflict(ctx, inquiry.RestaurantID, err)
+	}
+
+	updated := *inquiry
+	updated.EventID = &event.ID
+	updated.DepositID = nil
+	if deposit == nil {
+		updated.Status = models.InquiryConfirmed
+	} else {
+		updated.DepositID = &deposit.ID
+	}
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityInquiry, id, inquiry, &updated)
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityBooking, event.ID, nil, bookings)
+
+	if deposit != nil {
+		err := requestPayment(ctx, uc.bookings.payments, uc.depositRepo, uc.audit, deposit, event.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &models.InquiryConversionResult{Bookings: bookings, Deposit: deposit}, nil
+}
+
+// requireNoPendingDeposit отклоняет действие, пока депозит заявки ждет
+// оплаты: оплата подтвердит заявку. Просроченный депозит снимается сразу,
+// не дожидаясь ExpireDeposits: иначе его бронирования держали бы столы
+// нового перевода заявки.
+func (uc *EventInquiryUC) requireNoPendingDeposit(ctx context.Context, inquiry *models.EventInquiry) error {
+	if inquiry.DepositID == nil {
+		return nil
+	}
+
+	deposit, err := uc.depositRepo.GetByID(ctx, *inquiry.DepositID)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if deposit.Status != models.DepositPending {
+		return nil
+	}
+	if deposit.ExpiresAt.After(time.Now()) {
+		return errs.Conflict(i18n.CodeInquiryPaymentPending, deposit.ID)
+	}
+
+	// Депозит мог снять ExpireDeposits между чтением и снятием.
+	if err := uc.depositRepo.Release(ctx, deposit.ID, models.DepositExpired); err != nil && !errs.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// conversionTables возвращает столы, которые займет бронирование: выбранные
+// или все столы секции ресторана.
+func (uc *EventInquiryUC) conversionTables(ctx context.Context, restaurantID int64,
+	req *models.InquiryConversion) ([]int64, error) {
+	if req.SectionID == nil {
+		tableIDs := make([]int64, 0, len(req.TableIDs))
+		for _, tableID := range req.TableIDs {
+			if !slices.Contains(tableIDs, tableID) {
+				tableIDs = append(tableIDs, tableID)
+			}
+		}
+		return tableIDs, nil
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, *req.SectionID)
+	if err != nil {
+		return nil, referenceError(err, "section_id", i18n.CodeSectionNotExists)
+	}
+	if section.RestaurantID != restaurantID {
+		return nil, errs.Invalid("section_id", i18n.CodeSectionOutside, section.ID, restaurantID)
+	}
+
+	tables, err := uc.tableRepo.GetBySection(ctx, section.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return nil, errs.Invalid("section_id", i18n.CodeSectionHasNoTables, section.ID)
+	}
+
+	tableIDs := make([]int64, 0, len(tables))
+	for _, table := range tables {
+		tableIDs = append(tableIDs, table.ID)
+	}
+	return tableIDs, nil
+}
+
+// desiredDay возвращает полночь желаемой даты заявки по часам ресторана.
+func (uc *EventInquiryUC) desiredDay(ctx context.Context, restaurantID int64, date string) (time.Time, error) {
+	parsed, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, errs.Invalid("desired_date", i18n.CodeInquiryDateRequired)
+	}
+
+	loc, err := uc.schedule.location(ctx, restaurantID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	day := localDay(parsed, loc)
+	if day.Before(localDay(time.Now().In(loc), loc)) {
+		return time.Time{}, errs.Invalid("desired_date", i18n.CodeInquiryDateInPast)
+	}
+	return day, nil
+}
+
+// validateInquiry проверяет данные заявки. Тип события необязателен;
+// отключенный тип нельзя выбрать, но заявка, у которой он уже стоит, его
+// сохраняет.
+func (uc *EventInquiryUC) validateInquiry(ctx context.Context, inquiry *models.EventInquiry, currentType string) error {
+	var fields errs.Fields
+
+	inquiry.ContactName = strings.TrimSpace(inquiry.ContactName)
+	if utf8.RuneCountInString(inquiry.ContactName) > maxInquiryContactName {
+		fields.Add("contact_name", i18n.CodeInquiryContactTooLong, maxInquiryContactName)
+	}
+
+	normalized, err := phone.Normalize(inquiry.ContactPhone)
+	switch {
+	case errors.Is(err, phone.ErrEmpty):
+		fields.Add("contact_phone", i18n.CodePhoneRequired)
+	case err != nil:
+		fields.Add("contact_phone", i18n.CodePhoneInvalid)
+	default:
+		inquiry.ContactPhone = normalized
+	}
+
+	inquiry.DesiredDate = strings.TrimSpace(inquiry.DesiredDate)
+	if _, err := time.Parse(time.DateOnly, inquiry.DesiredDate); err != nil {
+		fields.Add("desired_date", i18n.CodeInquiryDateRequired)
+	}
+
+	if inquiry.Guests < 1 || inquiry.Guests > maxEventGuests {
+		fields.Add("guests", i18n.CodeInquiryGuestsInvalid, maxEventGuests)
+	}
+
+	inquiry.Notes = strings.TrimSpace(inquiry.Notes)
+	if utf8.RuneCountInString(inquiry.Notes) > maxInquiryNote {
+		fields.Add("notes", i18n.CodeInquiryNoteTooLong, maxInquiryNote)
+	}
+
+	if err := fields.Err(); err != nil {
+		return err
+	}
+
+	if _, err := uc.desiredDay(ctx, inquiry.RestaurantID, inquiry.DesiredDate); err != nil {
+		return err
+	}
+
+	inquiry.EventType = strings.TrimSpace(inquiry.EventType)
+	if inquiry.EventType == "" || inquiry.EventType == currentType {
+		return nil
+	}
+
+	eventType, err := uc.eventTypeRepo.GetByCode(ctx, inquiry.EventType)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return errs.Invalid("event_type", i18n.CodeEventTypeUnknown, inquiry.EventType)
+		}
+		return err
+	}
+	if !eventType.IsActive {
+		return errs.Invalid("event_type", i18n.CodeEventTypeInactive, inquiry.EventType)
+	}
+	return nil
+}
+
+// newInquiryActivity создает запись журнала от имени текущего пользователя
+// или API-ключа.
+func newInquiryActivity(ctx context.Context, inquiryID int64, kind models.InquiryActivityKind) *models.InquiryActivity {
+	activity := &models.InquiryActivity{
+		InquiryID: inquiryID,
+		Kind:      kind,
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		if principal.IsAPIKey() {
+			activity.ActorAPIKeyID = &principal.APIKeyID
+		} else {
+			activity.ActorUserID = &principal.UserID
+		}
+	}
+	return activity
+}
+
+func inquiryNote(note string, required bool) (string, error) {
+	note = strings.TrimSpace(note)
+	if required && note == "" {
+		return "", errs.Invalid("note", i18n.CodeInquiryNoteRequired)
+	}
+	if utf8.RuneCountInString(note) > maxInquiryNote {
+		return "", errs.Invalid("note", i18n.CodeInquiryNoteTooLong, maxInquiryNote)
+	}
+	return note, nil
+}
+
+func validInquiryStatus(status models.InquiryStatus) bool {
+	switch status {
+	case models.InquiryNew, models.InquiryContacted, models.InquiryQuoted, models.InquiryDepositPaid,
+		models.InquiryConfirmed, models.InquiryLost:
+		return true
+	}
+	return false
+}
+
+func isInquiryClosed(status models.InquiryStatus) bool {
+	return status == models.InquiryConfirmed || status == models.InquiryLost
+}
@@ -0,0 +1,356 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+)
+
+// defaultWeekendDays — выходные, пока ресторан не задал свои.
+var defaultWeekendDays = []time.Weekday{time.Sunday, time.Saturday}
+
+type EventPackageUC struct {
+	packageRepo    repository.EventPackageRepository
+	pricingRepo    repository.EventPricingRepository
+	eventRepo      repository.RestaurantEventRepository
+	restaurantRepo repository.RestaurantRepository
+	schedule       *ScheduleUC
+	access         *AccessControl
+	audit          *AuditUC
+}
+
+func NewEventPackageUseCase(packageRepo repository.EventPackageRepository,
+	pricingRepo repository.EventPricingRepository, eventRepo repository.RestaurantEventRepository,
+	restaurantRepo repository.RestaurantRepository, schedule *ScheduleUC,
+	access *AccessControl, audit *AuditUC) *EventPackageUC {
+	return &EventPackageUC{
+		packageRepo:    packageRepo,
+		pricingRepo:    pricingRepo,
+		eventRepo:      eventRepo,
+		restaurantRepo: restaurantRepo,
+		schedule:       schedule,
+		access:         access,
+		audit:          audit,
+	}
+}
+
+func (uc *EventPackageUC) Create(ctx context.Context, pkg *models.EventPackage) (int64, error) {
+	if err := validateEventPackage(pkg); err != nil {
+		return 0, err
+	}
+
+	event, err := uc.eventRepo.GetByID(ctx, pkg.EventID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, event.RestaurantID); err != nil {
+		return 0, err
+	}
+
+	id, err := uc.packageRepo.Create(ctx, pkg)
+	if err != nil {
+		return 0, err
+	}
+
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityPackage, id, nil, pkg)
+	return id, nil
+}
+
+func (uc *EventPackageUC) GetByID(ctx context.Context, eventID, id int64) (*models.EventPackage, error) {
+	pkg, event, err := uc.eventPackage(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, event.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	return pkg, nil
+}
+
+// GetByEvent возвращает активные пакеты события; отключенные видят
+// менеджеры ресторана.
+func (uc *EventPackageUC) GetByEvent(ctx context.Context, eventID int64, includeInactive bool) ([]*models.EventPackage, error) {
+	event, err := uc.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if includeInactive {
+		err = uc.access.RequireRestaurantManager(ctx, event.RestaurantID)
+	} else {
+		err = uc.access.RequireRestaurantAccess(ctx, event.RestaurantID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.packageRepo.GetByEvent(ctx, eventID, includeInactive)
+}
+
+func (uc *EventPackageUC) Update(ctx context.Context, pkg *models.EventPackage) error {
+	if err := validateEventPackage(pkg); err != nil {
+		return err
+	}
+
+	existing, event, err := uc.eventPackage(ctx, pkg.EventID, pkg.ID)
+	if err != nil {
+		return fmt.Errorf("не удалось найти пакет события для обновления: %w", err)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, event.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.packageRepo.Update(ctx, pkg); err != nil {
+		return err
+	}
+
+	pkg.CreatedAt = existing.CreatedAt
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityPackage, pkg.ID, existing, pkg)
+	return nil
+}
+
+func (uc *EventPackageUC) Delete(ctx context.Context, eventID, id int64) error {
+	pkg, event, err := uc.eventPackage(ctx, eventID, id)
+	if err != nil {
+		return fmt.Errorf("не удалось найти пакет события для удаления: %w", err)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, event.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.packageRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityPackage, id, pkg, nil)
+	return nil
+}
+
+// Quote считает стоимость пакета на guests гостей в день date: цена за
+// гостя, умноженная на число гостей, и надбавка ресторана, если день
+// выходной. Дата берется по календарю ресторана как есть.
+func (uc *EventPackageUC) Quote(ctx context.Context, eventID, packageID int64, guests int,
+	date time.Time) (*models.EventQuote, error) {
+	var fields errs.Fields
+	if guests <= 0 {
+		fields.Add("guests", i18n.CodeQuoteGuestsRequired)
+	}
+	if date.IsZero() {
+		fields.Add("date", i18n.CodeQuoteDateRequired)
+	}
+	if err := fields.Err(); err != nil {
+		return nil, err
+	}
+
+	pkg, event, err := uc.eventPackage(ctx, eventID, packageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, event.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	if !pkg.IsActive {
+		return nil, errs.Conflict(i18n.CodePackageInactive, pkg.ID)
+	}
+
+	if guests < pkg.MinGuests {
+		return nil, errs.Invalid("guests", i18n.CodeQuoteGuestsBelowMin, pkg.MinGuests)
+	}
+	if pkg.MaxGuests != nil && guests > *pkg.MaxGuests {
+		return nil, errs.Invalid("guests", i18n.CodeQuoteGuestsAboveMax, *pkg.MaxGuests)
+	}
+
+	loc, err := uc.schedule.location(ctx, event.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	day := localDay(date, loc)
+	if day.Before(localDay(time.Now().In(loc), loc)) {
+		return nil, errs.Invalid("date", i18n.CodeQuoteDateInPast)
+	}
+
+	pricing, err := uc.pricingRepo.GetByRestaurant(ctx, event.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &models.EventQuote{
+		EventID:       eventID,
+		PackageID:     pkg.ID,
+		Tier:          pkg.Tier,
+		Date:          day.Format(time.DateOnly),
+		Guests:        guests,
+		PricePerGuest: pkg.PricePerGuest,
+		Subtotal:      roundMoney(pkg.PricePerGuest * float64(guests)),
+	}
+
+	if pricing != nil && slices.Contains(pricing.WeekendDays, day.Weekday()) {
+		quote.Weekend = true
+		quote.SurchargePercent = pricing.WeekendPercent
+		quote.Surcharge = roundMoney(quote.Subtotal * pricing.WeekendPercent / 100)
+	}
+
+	quote.Total = roundMoney(quote.Subtotal + quote.Surcharge)
+	return quote, nil
+}
+
+// GetPricing возвращает надбавки ресторана к банкетам. Если они не заданы,
+// выходные — суббота и воскресенье без надбавки.
+func (uc *EventPackageUC) GetPricing(ctx context.Context, restaurantID int64) (*models.EventPricing, error) {
+	if err := uc.access.RequireRestaurantAccess(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.restaurantRepo.GetByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	pricing, err := uc.pricingRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pricing == nil {
+		pricing = &models.EventPricing{
+			RestaurantID: restaurantID,
+			WeekendDays:  slices.Clone(defaultWeekendDays),
+		}
+	}
+	return pricing, nil
+}
+
+// SetPricing заменяет надбавки ресторана. Они действуют на расчеты,
+// сделанные после изменения.
+func (uc *EventPackageUC) SetPricing(ctx context.Context, pricing *models.EventPricing) error {
+	if err := uc.access.RequireRestaurantManager(ctx, pricing.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := validateEventPricing(pricing); err != nil {
+		return err
+	}
+
+	before, err := uc.pricingRepo.GetByRestaurant(ctx, pricing.RestaurantID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.pricingRepo.Save(ctx, pricing); err != nil {
+		return err
+	}
+
+	action := models.AuditActionUpdate
+	if before == nil {
+		action = models.AuditActionCreate
+	}
+	uc.audit.record(ctx, action, models.AuditEntityEventPricing, pricing.RestaurantID, before, pricing)
+	return nil
+}
+
+// eventPackage возвращает пакет вместе с его событием. Пакет другого
+// события считается ненайденным.
+func (uc *EventPackageUC) eventPackage(ctx context.Context, eventID, id int64) (*models.EventPackage,
+	*models.RestaurantEvent, error) {
+	pkg, err := uc.packageRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pkg.EventID != eventID {
+		return nil, nil, errs.NotFound(i18n.CodePackageNotFound, id)
+	}
+
+	event, err := uc.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pkg, event, nil
+}
+
+func validateEventPackage(pkg *models.EventPackage) error {
+	var fields errs.Fields
+
+	pkg.Name = strings.TrimSpace(pkg.Name)
+	if pkg.Name == "" {
+		fields.Add("name", i18n.CodePackageNameRequired)
+	}
+
+	switch pkg.Tier {
+	case "":
+		pkg.Tier = models.PackageTierStandard
+	case models.PackageTierStandard, models.PackageTierPremium:
+	default:
+		fields.Add("tier", i18n.CodePackageTierUnknown, pkg.Tier)
+	}
+
+	if pkg.PricePerGuest < 0 {
+		fields.Add("price_per_guest", i18n.CodePackagePriceNegative)
+	}
+	pkg.PricePerGuest = roundMoney(pkg.PricePerGuest)
+
+	if pkg.MinGuests == 0 {
+		pkg.MinGuests = 1
+	}
+	if pkg.MinGuests < 1 || pkg.MinGuests > maxEventGuests {
+		fields.Add("min_guests", i18n.CodePackageMinGuests, maxEventGuests)
+	}
+	if pkg.MaxGuests != nil && (*pkg.MaxGuests < pkg.MinGuests || *pkg.MaxGuests > maxEventGuests) {
+		fields.Add("max_guests", i18n.CodePackageMaxGuests, maxEventGuests)
+	}
+
+	services := make([]string, 0, len(pkg.IncludedServices))
+	for _, service := range pkg.IncludedServices {
+		if service = strings.TrimSpace(service); service != "" {
+			services = append(services, service)
+		}
+	}
+	pkg.IncludedServices = services
+
+	return fields.Err()
+}
+
+func validateEventPricing(pricing *models.EventPricing) error {
+	var fields errs.Fields
+
+	days := make([]time.Weekday, 0, len(pricing.WeekendDays))
+	for _, day := range pricing.WeekendDays {
+		if day < time.Sunday || day > time.Saturday {
+			fields.Add("weekend_days", i18n.CodeWeekdayInvalid)
+			break
+		}
+		if !slices.Contains(days, day) {
+			days = append(days, day)
+		}
+	}
+	slices.Sort(days)
+	pricing.WeekendDays = days
+
+	if pricing.WeekendPercent < 0 || pricing.WeekendPercent > 100 {
+		fields.Add("weekend_percent", i18n.CodeWeekendPercentInvalid)
+	}
+	pricing.WeekendPercent = roundMoney(pricing.WeekendPercent)
+
+	return fields.Err()
+}
+
+// roundMoney округляет сумму до копеек (тиынов).
+func roundMoney(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+)
+
+const maxEventTypeCodeLength = 50
+
+var eventTypeCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+type EventTypeUC struct {
+	eventTypeRepo repository.EventTypeRepository
+	access        *AccessControl
+	audit         *AuditUC
+}
+
+func NewEventTypeUseCase(eventTypeRepo repository.EventTypeRepository, access *AccessControl, audit *AuditUC) *EventTypeUC {
+	return &EventTypeUC{
+		eventTypeRepo: eventTypeRepo,
+		access:        access,
+		audit:         audit,
+	}
+}
+
+func (uc *EventTypeUC) Create(ctx context.Context, eventType *models.EventType) (int64, error) {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return 0, err
+	}
+
+	eventType.Code = strings.ToLower(strings.TrimSpace(eventType.Code))
+	if len(eventType.Code) > maxEventTypeCodeLength || !eventTypeCodePattern.MatchString(eventType.Code) {
+		return 0, errs.Invalid("code", i18n.CodeEventTypeCodeInvalid, maxEventTypeCodeLength)
+	}
+
+	if err := validateEventType(eventType); err != nil {
+		return 0, err
+	}
+
+	id, err := uc.eventTypeRepo.Create(ctx, eventType)
+	if err != nil {
+		return 0, err
+	}
+
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityEventType, id, nil, eventType)
+	return id, nil
+}
+
+func (uc *EventTypeUC) GetByID(ctx context.Context, id int64) (*models.EventType, error) {
+	eventType, err := uc.eventTypeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить тип события: %w", err)
+	}
+	return eventType, nil
+}
+
+// Update меняет названия, иконку и активность типа. Код не меняется: по
+// нему тип хранится в событиях и передается клиентам.
+func (uc *EventTypeUC) Update(ctx context.Context, eventType *models.EventType) error {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	if err := validateEventType(eventType); err != nil {
+		return err
+	}
+
+	existing, err := uc.eventTypeRepo.GetByID(ctx, eventType.ID)
+	if err != nil {
+		return fmt.Errorf("не удалось найти тип события для обновления: %w", err)
+	}
+
+	if err := uc.eventTypeRepo.Update(ctx, eventType); err != nil {
+		return err
+	}
+
+	eventType.Code = existing.Code
+	eventType.CreatedAt = existing.CreatedAt
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityEventType, eventType.ID, existing, eventType)
+	return nil
+}
+
+// Delete удаляет тип, которым не отмечено ни одно событие. Используемый тип
+// можно только отключить.
+func (uc *EventTypeUC) Delete(ctx context.Context, id int64) error {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	eventType, err := uc.eventTypeRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("не удалось найти тип события для удаления: %w", err)
+	}
+
+	if err := uc.eventTypeRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityEventType, id, eventType, nil)
+	return nil
+}
+
+// List возвращает активные типы; отключенные видит только администратор.
+func (uc *EventTypeUC) List(ctx context.Context, includeInactive bool) ([]*models.EventType, error) {
+	if includeInactive {
+		if err := uc.access.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.eventTypeRepo.List(ctx, includeInactive)
+}
+
+func validateEventType(eventType *models.EventType) error {
+	var fields errs.Fields
+
+	eventType.NameRU = strings.TrimSpace(eventType.NameRU)
+	if eventType.NameRU == "" {
+		fields.Add("name_ru", i18n.CodeEventTypeNameReq)
+	}
+
+	eventType.NameKZ = strings.TrimSpace(eventType.NameKZ)
+	if eventType.NameKZ == "" {
+		fields.Add("name_kz", i18n.CodeEventTypeNameReq)
+	}
+
+	eventType.Icon = strings.TrimSpace(eventType.Icon)
+
+	return fields.Err()
+}
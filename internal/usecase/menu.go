@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/repository"
 )
@@ -12,12 +14,17 @@ import (
 type MenuUC struct {
 	menuRepo       repository.MenuRepository
 	restaurantRepo repository.RestaurantRepository
+	access         *AccessControl
+	audit          *AuditUC
 }
 
-func NewMenuUseCase(menuRepo repository.MenuRepository, restaurantRepo repository.RestaurantRepository) *MenuUC {
+func NewMenuUseCase(menuRepo repository.MenuRepository, restaurantRepo repository.RestaurantRepository,
+	access *AccessControl, audit *AuditUC) *MenuUC {
 	return &MenuUC{
 		menuRepo:       menuRepo,
 		restaurantRepo: restaurantRepo,
+		access:         access,
+		audit:          audit,
 	}
 }
 
@@ -28,10 +35,21 @@ func (uc *MenuUC) Create(ctx context.Context, menu *models.Menu) (int64, error)
 
 	_, err := uc.restaurantRepo.GetByID(ctx, menu.RestaurantID)
 	if err != nil {
-		return 0, fmt.Errorf("указанный ресторан не существует: %w", err)
+		return 0, referenceError(err, "restaurant_id", i18n.CodeRestaurantNotExist)
 	}
 
-	return uc.menuRepo.Create(ctx, menu)
+	if err := uc.access.RequireRestaurantManager(ctx, menu.RestaurantID); err != nil {
+		return 0, err
+	}
+
+	id, err := uc.menuRepo.Create(ctx, menu)
+	if err != nil {
+		return 0, err
+	}
+
+	menu.ID = id
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityMenu, id, nil, menu)
+	return id, nil
 }
 
 func (uc *MenuUC) GetByID(ctx context.Context, id int64) (*models.Menu, error) {
@@ -39,13 +57,22 @@ func (uc *MenuUC) GetByID(ctx context.Context, id int64) (*models.Menu, error) {
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить меню: %w", err)
 	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, menu.RestaurantID); err != nil {
+		return nil, err
+	}
+
 	return menu, nil
 }
 
 func (uc *MenuUC) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.Menu, error) {
+	if err := uc.access.RequireRestaurantAccess(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
 	_, err := uc.restaurantRepo.GetByID(ctx, restaurantID)
 	if err != nil {
-		return nil, fmt.Errorf("указанный ресторан не существует: %w", err)
+		return nil, err
 	}
 
 	return uc.menuRepo.GetByRestaurant(ctx, restaurantID)
@@ -56,39 +83,64 @@ func (uc *MenuUC) Update(ctx context.Context, menu *models.Menu) error {
 		return err
 	}
 
-	_, err := uc.menuRepo.GetByID(ctx, menu.ID)
+	existingMenu, err := uc.menuRepo.GetByID(ctx, menu.ID)
 	if err != nil {
 		return fmt.Errorf("не удалось найти меню для обновления: %w", err)
 	}
 
 	_, err = uc.restaurantRepo.GetByID(ctx, menu.RestaurantID)
 	if err != nil {
-		return fmt.Errorf("указанный ресторан не существует: %w", err)
+		return referenceError(err, "restaurant_id", i18n.CodeRestaurantNotExist)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, existingMenu.RestaurantID); err != nil {
+		return err
+	}
+	if existingMenu.RestaurantID != menu.RestaurantID {
+		if err := uc.access.RequireRestaurantManager(ctx, menu.RestaurantID); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.menuRepo.Update(ctx, menu); err != nil {
+		return err
 	}
 
-	return uc.menuRepo.Update(ctx, menu)
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityMenu, menu.ID, existingMenu, menu)
+	return nil
 }
 
 func (uc *MenuUC) Delete(ctx context.Context, id int64) error {
-	_, err := uc.menuRepo.GetByID(ctx, id)
+	menu, err := uc.menuRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("не удалось найти меню для удаления: %w", err)
 	}
 
-	return uc.menuRepo.Delete(ctx, id)
+	if err := uc.access.RequireRestaurantManager(ctx, menu.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.menuRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityMenu, id, menu, nil)
+	return nil
 }
 
 func validateMenu(menu *models.Menu) error {
+	var fields errs.Fields
+
 	menu.NameRU = strings.TrimSpace(menu.NameRU)
 	if menu.NameRU == "" {
-		return fmt.Errorf("название меню на русском не может быть пустым")
+		fields.Add("name_ru", i18n.CodeMenuNameRURequired)
 	}
 
 	menu.NameKZ = strings.TrimSpace(menu.NameKZ)
 
 	if menu.RestaurantID <= 0 {
-		return fmt.Errorf("необходимо указать корректный ID ресторана")
+		fields.Add("restaurant_id", i18n.CodeRestaurantIDReq)
 	}
 
-	return nil
+	return fields.Err()
 }
@@ -5,21 +5,31 @@ import (
 	"fmt"
 	"strings"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/repository"
 )
 
 type MenuTypeUC struct {
 	menuTypeRepo repository.MenuTypeRepository
+	access       *AccessControl
+	audit        *AuditUC
 }
 
-func NewMenuTypeUseCase(menuTypeRepo repository.MenuTypeRepository) *MenuTypeUC {
+func NewMenuTypeUseCase(menuTypeRepo repository.MenuTypeRepository, access *AccessControl, audit *AuditUC) *MenuTypeUC {
 	return &MenuTypeUC{
 		menuTypeRepo: menuTypeRepo,
+		access:       access,
+		audit:        audit,
 	}
 }
 
 func (uc *MenuTypeUC) Create(ctx context.Context, menuType *models.MenuType) (int64, error) {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return 0, err
+	}
+
 	if err := validateMenuType(menuType); err != nil {
 		return 0, err
 	}
@@ -28,12 +38,19 @@ func (uc *MenuTypeUC) Create(ctx context.Context, menuType *models.MenuType) (in
 	if err == nil {
 		for _, mt := range menuTypes {
 			if strings.EqualFold(mt.Name, menuType.Name) {
-				return 0, fmt.Errorf("тип меню с названием '%s' уже существует", menuType.Name)
+				return 0, errs.Conflict(i18n.CodeMenuTypeExists, menuType.Name)
 			}
 		}
 	}
 
-	return uc.menuTypeRepo.Create(ctx, menuType)
+	id, err := uc.menuTypeRepo.Create(ctx, menuType)
+	if err != nil {
+		return 0, err
+	}
+
+	menuType.ID = id
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityMenuType, id, nil, menuType)
+	return id, nil
 }
 
 func (uc *MenuTypeUC) GetByID(ctx context.Context, id int64) (*models.MenuType, error) {
@@ -45,6 +62,10 @@ func (uc *MenuTypeUC) GetByID(ctx context.Context, id int64) (*models.MenuType,
 }
 
 func (uc *MenuTypeUC) Update(ctx context.Context, menuType *models.MenuType) error {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
 	if err := validateMenuType(menuType); err != nil {
 		return err
 	}
@@ -59,22 +80,36 @@ func (uc *MenuTypeUC) Update(ctx context.Context, menuType *models.MenuType) err
 		if err == nil {
 			for _, mt := range menuTypes {
 				if strings.EqualFold(mt.Name, menuType.Name) && mt.ID != menuType.ID {
-					return fmt.Errorf("тип меню с названием '%s' уже существует", menuType.Name)
+					return errs.Conflict(i18n.CodeMenuTypeExists, menuType.Name)
 				}
 			}
 		}
 	}
 
-	return uc.menuTypeRepo.Update(ctx, menuType)
+	if err := uc.menuTypeRepo.Update(ctx, menuType); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityMenuType, menuType.ID, existingMenuType, menuType)
+	return nil
 }
 
 func (uc *MenuTypeUC) Delete(ctx context.Context, id int64) error {
-	_, err := uc.menuTypeRepo.GetByID(ctx, id)
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	menuType, err := uc.menuTypeRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("не удалось найти тип меню для удаления: %w", err)
 	}
 
-	return uc.menuTypeRepo.Delete(ctx, id)
+	if err := uc.menuTypeRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityMenuType, id, menuType, nil)
+	return nil
 }
 
 func (uc *MenuTypeUC) List(ctx context.Context) ([]*models.MenuType, error) {
@@ -84,11 +119,11 @@ func (uc *MenuTypeUC) List(ctx context.Context) ([]*models.MenuType, error) {
 func validateMenuType(menuType *models.MenuType) error {
 	menuType.Name = strings.TrimSpace(menuType.Name)
 	if menuType.Name == "" {
-		return fmt.Errorf("название типа меню не может быть пустым")
+		return errs.Invalid("name", i18n.CodeMenuTypeNameReq)
 	}
 
 	if len(menuType.Name) < 2 {
-		return fmt.Errorf("название типа меню должно быть не менее 2 символов")
+		return errs.Invalid("name", i18n.CodeMenuTypeNameShort)
 	}
 
 	return nil
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+)
+
+// GetNoShowPolicy возвращает правила ресторана для гостей с неявками. Если
+// правила не заданы, оба порога пустые.
+func (uc *ReservationUC) GetNoShowPolicy(ctx context.Context, restaurantID int64) (*models.NoShowPolicy, error) {
+	if err := uc.access.RequireRestaurantStaff(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	policy, err := uc.policyRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		policy = &models.NoShowPolicy{RestaurantID: restaurantID}
+	}
+	return policy, nil
+}
+
+// SetNoShowPolicy заменяет правила ресторана. Новые правила действуют на
+// бронирования, созданные после изменения.
+func (uc *ReservationUC) SetNoShowPolicy(ctx context.Context, policy *models.NoShowPolicy) error {
+	if err := uc.access.RequireRestaurantManager(ctx, policy.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := validateNoShowPolicy(policy); err != nil {
+		return err
+	}
+
+	before, err := uc.policyRepo.GetByRestaurant(ctx, policy.RestaurantID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.policyRepo.Save(ctx, policy); err != nil {
+		return err
+	}
+
+	action := models.AuditActionUpdate
+	if before == nil {
+		action = models.AuditActionCreate
+	}
+	uc.audit.record(ctx, action, models.AuditEntityNoShowPolicy, policy.RestaurantID, before, policy)
+	return nil
+}
+
+// ForgiveNoShow снимает неявку со счетчика гостя, например если гость
+// предупредил ресторан, но его отмену не отметили. Статус бронирования не
+// меняется.
+func (uc *ReservationUC) ForgiveNoShow(ctx context.Context, id int64) error {
+	reservation, err := uc.reservationRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("не удалось найти бронирование: %w", err)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, reservation.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.reservationRepo.ForgiveNoShow(ctx, id); err != nil {
+		return err
+	}
+
+	forgiven := *reservation
+	now := time.Now()
+	forgiven.NoShowForgivenAt = &now
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityReservation, id, reservation, &forgiven)
+	return nil
+}
+
+// applyNoShowPolicy проверяет гостя по правилам ресторана. Гостю, который
+// набрал block_after неявок, бронировать самому нельзя, но сотрудник
+// ресторана может забронировать для него. С deposit_after неявок
+// бронирование ждет оплаты депозита.
+func (uc *ReservationUC) applyNoShowPolicy(ctx context.Context, reservation *models.Reservation, online bool) error {
+	policy, err := uc.policyRepo.GetByRestaurant(ctx, reservation.RestaurantID)
+	if err != nil || policy == nil {
+		return err
+	}
+
+	guest, err := uc.userRepo.GetByID(ctx, reservation.UserID)
+	if err != nil {
+		return referenceError(err, "user_id", i18n.CodeUserNotExists)
+	}
+
+	if online && policy.BlockAfter != nil && guest.NoShowCount >= *policy.BlockAfter {
+		return errs.Forbidden(i18n.CodeGuestBookingBlocked, guest.NoShowCount)
+	}
+
+	if policy.DepositAfter == nil || guest.NoShowCount < *policy.DepositAfter {
+		return nil
+	}
+
+	expiresAt := time.Now().Add(uc.paymentCfg.DepositTTL)
+	if reservation.StartTime.Before(expiresAt) {
+		expiresAt = reservation.StartTime
+	}
+
+	reservation.Deposit = &models.EventDeposit{
+		BookingDate: reservation.StartTime,
+		Guests:      reservation.PartySize,
+		Amount:      math.Round(policy.DepositAmount*100) / 100,
+		Status:      models.DepositPending,
+		ExpiresAt:   expiresAt,
+	}
+	return nil
+}
+
+func validateNoShowPolicy(policy *models.NoShowPolicy) error {
+	var fields errs.Fields
+
+	if policy.DepositAfter != nil && *policy.DepositAfter < 1 {
+		fields.Add("deposit_after", i18n.CodeNoShowThresholdInvalid)
+	}
+
+	if policy.BlockAfter != nil && *policy.BlockAfter < 1 {
+		fields.Add("block_after", i18n.CodeNoShowThresholdInvalid)
+	}
+
+	if policy.DepositAmount < 0 || policy.DepositAfter != nil && math.Round(policy.DepositAmount*100) <= 0 {
+		fields.Add("deposit_amount", i18n.CodeNoShowDepositAmount)
+	}
+
+	return fields.Err()
+}
@@ -0,0 +1,530 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/config"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/payment"
+	"restaurant-management/internal/repository"
+)
+
+const (
+	defaultReservationDuration = 120
+	minReservationDuration     = 15
+	maxReservationDuration     = 12 * 60
+	maxPartySize               = 100
+	maxSpecialRequestsLength   = 1000
+)
+
+// reservationTransitions перечисляет допустимые переходы между статусами.
+// Завершенное, отмененное бронирование и неявка — конечные статусы.
+var reservationTransitions = map[models.ReservationStatus][]models.ReservationStatus{
+	models.ReservationPending:   {models.ReservationConfirmed, models.ReservationCancelled},
+	models.ReservationConfirmed: {models.ReservationSeated, models.ReservationCancelled, models.ReservationNoShow},
+	models.ReservationSeated:    {models.ReservationCompleted},
+}
+
+type ReservationUC struct {
+	reservationRepo repository.ReservationRepository
+	tableRepo       repository.TableRepository
+	combinationRepo repository.TableCombinationRepository
+	sectionRepo     repository.SectionRepository
+	userRepo        repository.UserRepository
+	occupancyRepo   repository.OccupancyRepository
+	policyRepo      repository.NoShowPolicyRepository
+	depositRepo     repository.EventDepositRepository
+	payments        payment.Provider
+	schedule        *ScheduleUC
+	access          *AccessControl
+	audit           *AuditUC
+	cfg             config.ReservationConfig
+	paymentCfg      config.PaymentConfig
+}
+
+func NewReservationUseCase(reservationRepo repository.ReservationRepository, tableRepo repository.TableRepository,
+	combinationRepo repository.TableCombinationRepository, sectionRepo repository.SectionRepository,
+	userRepo repository.UserRepository,
+	occupancyRepo repository.OccupancyRepository, policyRepo repository.NoShowPolicyRepository,
+	depositRepo repository.EventDepositRepository, payments payment.Provider, schedule *ScheduleUC,
+	access *AccessControl, audit *AuditUC, cfg config.ReservationConfig, paymentCfg config.PaymentConfig) *ReservationUC {
+	return &ReservationUC{
+		reservationRepo: reservationRepo,
+		tableRepo:       tableRepo,
+		combinationRepo: combinationRepo,
+		sectionRepo:     sectionRepo,
+		userRepo:        userRepo,
+		occupancyRepo:   occupancyRepo,
+		policyRepo:      policyRepo,
+		depositRepo:     depositRepo,
+		payments:        payments,
+		schedule:        schedule,
+		access:          access,
+		audit:           audit,
+		cfg:             cfg,
+		paymentCfg:      paymentCfg,
+	}
+}
+
+// Create бронирует стол или сочетание столов. Гость бронирует для себя;
+// сотрудник ресторана или API-ключ могут указать user_id другого гостя.
+// Если не указаны ни стол, ни сочетание, стол подбирается автоматически
+// среди свободных столов ресторана. Если по правилам неявок ресторана гостю
+// нужен депозит, он возвращается в reservation.Deposit.
+func (uc *ReservationUC) Create(ctx context.Context, reservation *models.Reservation) (int64, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return 0, errs.Unauthorized(i18n.CodeNotAuthenticated)
+	}
+
+	if reservation.UserID == 0 && !principal.IsAPIKey() {
+		reservation.UserID = principal.UserID
+	}
+
+	if err := validateReservation(reservation); err != nil {
+		return 0, err
+	}
+
+	restaurantID := reservation.RestaurantID
+	var sectionID int64
+	if reservation.TableID != 0 || reservation.CombinationID != nil {
+		var err error
+		sectionID, err = uc.seating(ctx, reservation, restaurantID)
+		if err != nil {
+			return 0, err
+		}
+		restaurantID = reservation.RestaurantID
+	}
+	reservation.RestaurantID = restaurantID
+
+	end := reservationEnd(reservation)
+	if err := uc.schedule.requireOpen(ctx, restaurantID, reservation.StartTime, end); err != nil {
+		return 0, err
+	}
+	if sectionID != 0 {
+		err := uc.schedule.requireSectionAvailable(ctx, restaurantID, sectionID, reservation.StartTime, end)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if principal.IsAPIKey() || reservation.UserID != principal.UserID {
+		if err := uc.access.RequireRestaurantStaff(ctx, restaurantID); err != nil {
+			return 0, err
+		}
+
+		guest, err := uc.userRepo.GetByID(ctx, reservation.UserID)
+		if err != nil {
+			return 0, referenceError(err, "user_id", i18n.CodeUserNotExists)
+		}
+		if guest.DeletedAt != nil {
+			return 0, errs.Invalid("user_id", i18n.CodeUserNotExists)
+		}
+	}
+
+	online := !principal.IsAPIKey() && reservation.UserID == principal.UserID
+	if err := uc.applyNoShowPolicy(ctx, reservation, online); err != nil {
+		return 0, err
+	}
+
+	reservation.Status = models.ReservationPending
+	var id int64
+	var err error
+	if reservation.TableID == 0 {
+		id, err = uc.createAssigned(ctx, reservation)
+	} else {
+		id, err = uc.reservationRepo.Create(ctx, reservation)
+	}
+	if err != nil {
+		return 0, uc.schedule.localizeConflict(ctx, restaurantID, err)
+	}
+
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityReservation, id, nil, reservation)
+
+	if reservation.Deposit != nil {
+		description := fmt.Sprintf("Бронирование %d", id)
+		if err := requestPayment(ctx, uc.payments, uc.depositRepo, uc.audit, reservation.Deposit, description); err != nil {
+			return 0, err
+		}
+	}
+	return id, nil
+}
+
+func (uc *ReservationUC) GetByID(ctx context.Context, id int64) (*models.Reservation, error) {
+	reservation, err := uc.reservationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить бронирование: %w", err)
+	}
+
+	if err := uc.requireReservationAccess(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	loc, err := uc.schedule.location(ctx, reservation.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+	localizeReservation(reservation, loc)
+
+	return reservation, nil
+}
+
+func (uc *ReservationUC) GetByRestaurant(ctx context.Context, restaurantID int64, filter models.ReservationFilter) ([]*models.Reservation, error) {
+	if err := uc.access.RequireRestaurantStaff(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	if filter.Status != "" && !validReservationStatus(filter.Status) {
+		return nil, errs.Invalid("status", i18n.CodeReservationStatusUnknown, filter.Status)
+	}
+
+	reservations, err := uc.reservationRepo.GetByRestaurant(ctx, restaurantID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := uc.schedule.location(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	for _, reservation := range reservations {
+		localizeReservation(reservation, loc)
+	}
+
+	return reservations, nil
+}
+
+func (uc *ReservationUC) GetByUser(ctx context.Context, userID int64) ([]*models.Reservation, error) {
+	if err := uc.access.RequireSelfOrAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	reservations, err := uc.reservationRepo.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Бронирования гостя могут быть в ресторанах разных городов.
+	locations := make(map[int64]*time.Location)
+	for _, reservation := range reservations {
+		loc, ok := locations[reservation.RestaurantID]
+		if !ok {
+			loc, err = uc.schedule.location(ctx, reservation.RestaurantID)
+			if err != nil {
+				return nil, err
+			}
+			locations[reservation.RestaurantID] = loc
+		}
+		localizeReservation(reservation, loc)
+	}
+
+	return reservations, nil
+}
+
+// Update меняет стол или сочетание, время, продолжительность, количество
+// гостей и пожелания. Изменять можно только ожидающие и подтвержденные
+// бронирования.
+func (uc *ReservationUC) Update(ctx context.Context, reservation *models.Reservation) error {
+	existing, err := uc.reservationRepo.GetByID(ctx, reservation.ID)
+	if err != nil {
+		return fmt.Errorf("не удалось найти бронирование для обновления: %w", err)
+	}
+
+	if err := uc.requireReservationAccess(ctx, existing); err != nil {
+		return err
+	}
+
+	if existing.Status != models.ReservationPending && existing.Status != models.ReservationConfirmed {
+		return errs.Conflict(i18n.CodeReservationNotEditable, existing.Status)
+	}
+
+	// Новый стол без сочетания пересаживает гостей за один стол; без стола и
+	// сочетания гости остаются там, где были.
+	if reservation.TableID == 0 && reservation.CombinationID == nil {
+		reservation.TableID = existing.TableID
+		reservation.CombinationID = existing.CombinationID
+	}
+	reservation.UserID = existing.UserID
+	reservation.RestaurantID = existing.RestaurantID
+	reservation.Status = existing.Status
+	reservation.CreatedAt = existing.CreatedAt
+
+	if err := validateReservation(reservation); err != nil {
+		return err
+	}
+
+	sectionID, err := uc.seating(ctx, reservation, existing.RestaurantID)
+	if err != nil {
+		return err
+	}
+	restaurantID := reservation.RestaurantID
+
+	end := reservationEnd(reservation)
+	if err := uc.schedule.requireOpen(ctx, restaurantID, reservation.StartTime, end); err != nil {
+		return err
+	}
+	err = uc.schedule.requireSectionAvailable(ctx, restaurantID, sectionID, reservation.StartTime, end)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.reservationRepo.Update(ctx, reservation); err != nil {
+		return uc.schedule.localizeConflict(ctx, restaurantID, err)
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityReservation, reservation.ID, existing, reservation)
+	return nil
+}
+
+// Cancel отменяет бронирование по просьбе гостя или сотрудника.
+func (uc *ReservationUC) Cancel(ctx context.Context, id int64) error {
+	reservation, err := uc.reservationRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("не удалось найти бронирование для отмены: %w", err)
+	}
+
+	if err := uc.requireReservationAccess(ctx, reservation); err != nil {
+		return err
+	}
+
+	return uc.changeStatus(ctx, reservation, models.ReservationCancelled)
+}
+
+// UpdateStatus переводит бронирование по жизненному циклу: подтверждение,
+// посадка гостей, завершение, неявка. Доступно только сотрудникам ресторана.
+func (uc *ReservationUC) UpdateStatus(ctx context.Context, id int64, status models.ReservationStatus) error {
+	if !validReservationStatus(status) {
+		return errs.Invalid("status", i18n.CodeReservationStatusUnknown, status)
+	}
+
+	reservation, err := uc.reservationRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("не удалось найти бронирование: %w", err)
+	}
+
+	if err := uc.access.RequireRestaurantStaff(ctx, reservation.RestaurantID); err != nil {
+		return err
+	}
+
+	if status == models.ReservationNoShow && time.Now().Before(reservation.StartTime) {
+		return errs.Conflict(i18n.CodeNoShowTooEarly)
+	}
+
+	return uc.changeStatus(ctx, reservation, status)
+}
+
+func (uc *ReservationUC) changeStatus(ctx context.Context, reservation *models.Reservation, status models.ReservationStatus) error {
+	if !canTransition(reservation.Status, status) {
+		return errs.Conflict(i18n.CodeReservationTransition, reservation.Status, status)
+	}
+
+	if err := uc.reservationRepo.UpdateStatus(ctx, reservation.ID, reservation.Status, status); err != nil {
+		return err
+	}
+
+	updated := *reservation
+	updated.Status = status
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityReservation, reservation.ID, reservation, &updated)
+	return nil
+}
+
+// createAssigned перебирает подходящие столы от лучшего к худшему. Между
+// подбором и вставкой стол может занять кто-то другой, тогда пробуется
+// следующий.
+func (uc *ReservationUC) createAssigned(ctx context.Context, reservation *models.Reservation) (int64, error) {
+	options, err := uc.assignTables(ctx, &models.AssignmentRequest{
+		RestaurantID:    reservation.RestaurantID,
+		PartySize:       reservation.PartySize,
+		StartTime:       reservation.StartTime,
+		DurationMinutes: reservation.DurationMinutes,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, option := range options {
+		// Произвольный набор столов сотрудники сдвигают вручную, бронировать
+		// можно только настроенные сочетания.
+		if option.Combined && option.CombinationID == nil {
+			continue
+		}
+
+		reservation.TableID = option.Tables[0].ID
+		reservation.CombinationID = option.CombinationID
+		reservation.TableIDs = nil
+		if option.CombinationID != nil {
+			for _, table := range option.Tables {
+				reservation.TableIDs = append(reservation.TableIDs, table.ID)
+			}
+		}
+
+		id, err := uc.reservationRepo.Create(ctx, reservation)
+		if errs.KindOf(err) == errs.KindConflict {
+			continue
+		}
+		return id, err
+	}
+
+	reservation.TableID = 0
+	reservation.CombinationID = nil
+	reservation.TableIDs = nil
+	return 0, errs.Conflict(i18n.CodeNoTableAvailable, reservation.PartySize)
+}
+
+// seating проверяет стол или сочетание, за которое садятся гости: что оно
+// относится к ресторану restaurantID (если он известен) и вмещает гостей.
+// Для сочетания основным столом становится первый стол, а TableIDs
+// заполняются его столами. Возвращает секцию и заполняет ресторан
+// бронирования.
+func (uc *ReservationUC) seating(ctx context.Context, reservation *models.Reservation, restaurantID int64) (int64, error) {
+	if reservation.CombinationID == nil {
+		table, tableRestaurantID, err := uc.tableRestaurant(ctx, reservation.TableID)
+		if err != nil {
+			return 0, err
+		}
+
+		if restaurantID != 0 && restaurantID != tableRestaurantID {
+			return 0, errs.Invalid("table_id", i18n.CodeTableNotInRestaurant, reservation.TableID, restaurantID)
+		}
+		if err := checkTableCapacity(table, reservation.PartySize); err != nil {
+			return 0, err
+		}
+
+		reservation.TableIDs = nil
+		reservation.RestaurantID = tableRestaurantID
+		return table.SectionID, nil
+	}
+
+	combination, err := uc.combinationRepo.GetByID(ctx, *reservation.CombinationID)
+	if err != nil {
+		return 0, referenceError(err, "combination_id", i18n.CodeCombinationNotExists)
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, combination.SectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	if restaurantID != 0 && restaurantID != section.RestaurantID {
+		return 0, errs.Invalid("combination_id", i18n.CodeCombinationOutside, combination.ID, restaurantID)
+	}
+	if reservation.PartySize > combination.MaxSeats {
+		return 0, errs.Invalid("party_size", i18n.CodePartyExceedsCombination, combination.ID, combination.MaxSeats)
+	}
+	if len(combination.TableIDs) == 0 {
+		return 0, errs.Invalid("combination_id", i18n.CodeCombinationTables)
+	}
+
+	reservation.TableID = combination.TableIDs[0]
+	reservation.TableIDs = combination.TableIDs
+	reservation.RestaurantID = section.RestaurantID
+	return section.ID, nil
+}
+
+// tableRestaurant находит стол и ресторан, к которому он относится.
+func (uc *ReservationUC) tableRestaurant(ctx context.Context, tableID int64) (*models.Table, int64, error) {
+	table, err := uc.tableRepo.GetByID(ctx, tableID)
+	if err != nil {
+		return nil, 0, referenceError(err, "table_id", i18n.CodeTableNotExists)
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, table.SectionID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return table, section.RestaurantID, nil
+}
+
+// localizeReservation переводит время бронирования на часы ресторана, чтобы
+// клиент получил его с местным смещением.
+func localizeReservation(reservation *models.Reservation, loc *time.Location) {
+	reservation.StartTime = reservation.StartTime.In(loc)
+	reservation.CreatedAt = reservation.CreatedAt.In(loc)
+	reservation.UpdatedAt = reservation.UpdatedAt.In(loc)
+}
+
+func reservationEnd(reservation *models.Reservation) time.Time {
+	return reservation.StartTime.Add(time.Duration(reservation.DurationMinutes) * time.Minute)
+}
+
+// checkTableCapacity не дает посадить за стол больше гостей, чем он
+// вмещает. Меньшую компанию сотрудник вправе посадить за большой стол.
+func checkTableCapacity(table *models.Table, partySize int) error {
+	if partySize > table.MaxSeats {
+		return errs.Invalid("party_size", i18n.CodePartyExceedsTable, table.NumberOfTable, table.MaxSeats)
+	}
+	return nil
+}
+
+// requireReservationAccess пропускает гостя, которому принадлежит
+// бронирование, и сотрудников ресторана.
+func (uc *ReservationUC) requireReservationAccess(ctx context.Context, reservation *models.Reservation) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if ok && !principal.IsAPIKey() && principal.UserID == reservation.UserID {
+		return nil
+	}
+
+	return uc.access.RequireRestaurantStaff(ctx, reservation.RestaurantID)
+}
+
+func validateReservation(reservation *models.Reservation) error {
+	var fields errs.Fields
+
+	if reservation.UserID <= 0 {
+		fields.Add("user_id", i18n.CodeUserIDRequired)
+	}
+
+	if reservation.TableID < 0 || reservation.TableID == 0 && reservation.CombinationID == nil && reservation.RestaurantID <= 0 {
+		fields.Add("table_id", i18n.CodeTableNotExists)
+	}
+
+	if reservation.PartySize < 1 || reservation.PartySize > maxPartySize {
+		fields.Add("party_size", i18n.CodePartySizeInvalid, maxPartySize)
+	}
+
+	if reservation.StartTime.IsZero() {
+		fields.Add("start_time", i18n.CodeReservationStartRequired)
+	} else if !reservation.StartTime.After(time.Now()) {
+		fields.Add("start_time", i18n.CodeReservationStartInPast)
+	}
+
+	if reservation.DurationMinutes == 0 {
+		reservation.DurationMinutes = defaultReservationDuration
+	}
+	if reservation.DurationMinutes < minReservationDuration || reservation.DurationMinutes > maxReservationDuration {
+		fields.Add("duration_minutes", i18n.CodeReservationDurationBounds, minReservationDuration, maxReservationDuration)
+	}
+
+	reservation.SpecialRequests = strings.TrimSpace(reservation.SpecialRequests)
+	if utf8.RuneCountInString(reservation.SpecialRequests) > maxSpecialRequestsLength {
+		fields.Add("special_requests", i18n.CodeSpecialRequestsTooLong, maxSpecialRequestsLength)
+	}
+
+	return fields.Err()
+}
+
+func validReservationStatus(status models.ReservationStatus) bool {
+	switch status {
+	case models.ReservationPending, models.ReservationConfirmed, models.ReservationSeated,
+		models.ReservationCompleted, models.ReservationCancelled, models.ReservationNoShow:
+		return true
+	}
+	return false
+}
+
+func canTransition(from, to models.ReservationStatus) bool {
+	for _, allowed := range reservationTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
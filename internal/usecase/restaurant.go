@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"strings"
 
+	"restaurant-management/internal/auth"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/repository"
 )
@@ -12,26 +15,60 @@ import (
 type RestaurantUC struct {
 	restaurantRepo repository.RestaurantRepository
 	cityRepo       repository.CityRepository
+	staffRepo      repository.StaffRepository
+	schedule       *ScheduleUC
+	access         *AccessControl
+	audit          *AuditUC
 }
 
-func NewRestaurantUseCase(restaurantRepo repository.RestaurantRepository, cityRepo repository.CityRepository) *RestaurantUC {
+func NewRestaurantUseCase(restaurantRepo repository.RestaurantRepository, cityRepo repository.CityRepository,
+	staffRepo repository.StaffRepository, schedule *ScheduleUC, access *AccessControl, audit *AuditUC) *RestaurantUC {
 	return &RestaurantUC{
 		restaurantRepo: restaurantRepo,
 		cityRepo:       cityRepo,
+		staffRepo:      staffRepo,
+		schedule:       schedule,
+		access:         access,
+		audit:          audit,
 	}
 }
 
 func (uc *RestaurantUC) Create(ctx context.Context, restaurant *models.Restaurant) (int64, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || !principal.HasRole(models.RoleAdmin, models.RoleManager) {
+		return 0, uc.access.deny(ctx, "создание ресторана доступно администратору или менеджеру")
+	}
+
 	if err := validateRestaurant(restaurant); err != nil {
 		return 0, err
 	}
 
 	_, err := uc.cityRepo.GetByID(ctx, restaurant.CityID)
 	if err != nil {
-		return 0, fmt.Errorf("указанный город не существует: %w", err)
+		return 0, referenceError(err, "city_id", i18n.CodeCityNotExists)
 	}
 
-	return uc.restaurantRepo.Create(ctx, restaurant)
+	id, err := uc.restaurantRepo.Create(ctx, restaurant)
+	if err != nil {
+		return 0, err
+	}
+
+	restaurant.ID = id
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityRestaurant, id, nil, restaurant)
+
+	if !principal.IsAdmin() {
+		assignment := &models.StaffAssignment{
+			UserID:       principal.UserID,
+			RestaurantID: id,
+			Role:         models.RoleManager,
+		}
+		if err := uc.staffRepo.Assign(ctx, assignment); err != nil {
+			return id, i18n.Wrap(err, i18n.CodeManagerAssignFailed)
+		}
+		uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityStaff, principal.UserID, nil, assignment)
+	}
+
+	return id, nil
 }
 
 func (uc *RestaurantUC) GetByID(ctx context.Context, id int64) (*models.Restaurant, error) {
@@ -39,13 +76,24 @@ func (uc *RestaurantUC) GetByID(ctx context.Context, id int64) (*models.Restaura
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить ресторан: %w", err)
 	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, restaurant.ID); err != nil {
+		return nil, err
+	}
+
+	openNow, err := uc.schedule.isOpenNow(ctx, restaurant.ID)
+	if err != nil {
+		return nil, err
+	}
+	restaurant.OpenNow = &openNow
+
 	return restaurant, nil
 }
 
 func (uc *RestaurantUC) GetByCity(ctx context.Context, cityID int64) ([]*models.Restaurant, error) {
 	_, err := uc.cityRepo.GetByID(ctx, cityID)
 	if err != nil {
-		return nil, fmt.Errorf("указанный город не существует: %w", err)
+		return nil, err
 	}
 
 	return uc.restaurantRepo.GetByCity(ctx, cityID)
@@ -56,26 +104,44 @@ func (uc *RestaurantUC) Update(ctx context.Context, restaurant *models.Restauran
 		return err
 	}
 
-	_, err := uc.restaurantRepo.GetByID(ctx, restaurant.ID)
+	existingRestaurant, err := uc.restaurantRepo.GetByID(ctx, restaurant.ID)
 	if err != nil {
 		return fmt.Errorf("не удалось найти ресторан для обновления: %w", err)
 	}
 
+	if err := uc.access.RequireRestaurantManager(ctx, restaurant.ID); err != nil {
+		return err
+	}
+
 	_, err = uc.cityRepo.GetByID(ctx, restaurant.CityID)
 	if err != nil {
-		return fmt.Errorf("указанный город не существует: %w", err)
+		return referenceError(err, "city_id", i18n.CodeCityNotExists)
 	}
 
-	return uc.restaurantRepo.Update(ctx, restaurant)
+	if err := uc.restaurantRepo.Update(ctx, restaurant); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityRestaurant, restaurant.ID, existingRestaurant, restaurant)
+	return nil
 }
 
 func (uc *RestaurantUC) Delete(ctx context.Context, id int64) error {
-	_, err := uc.restaurantRepo.GetByID(ctx, id)
+	restaurant, err := uc.restaurantRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("не удалось найти ресторан для удаления: %w", err)
 	}
 
-	return uc.restaurantRepo.Delete(ctx, id)
+	if err := uc.access.RequireRestaurantManager(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.restaurantRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityRestaurant, id, restaurant, nil)
+	return nil
 }
 
 func (uc *RestaurantUC) List(ctx context.Context, active bool) ([]*models.Restaurant, error) {
@@ -83,23 +149,29 @@ func (uc *RestaurantUC) List(ctx context.Context, active bool) ([]*models.Restau
 }
 
 func validateRestaurant(restaurant *models.Restaurant) error {
-	restaurant.Name = strings.TrimSpace(restaurant.Name)
-	if restaurant.Name == "" {
-		return fmt.Errorf("название ресторана не может быть пустым")
-	}
+	var fields errs.Fields
 
-	if len(restaurant.Name) < 3 {
-		return fmt.Errorf("название ресторана должно быть не менее 3 символов")
+	restaurant.Name = strings.TrimSpace(restaurant.Name)
+	switch {
+	case restaurant.Name == "":
+		fields.Add("name", i18n.CodeRestaurantNameReq)
+	case len(restaurant.Name) < 3:
+		fields.Add("name", i18n.CodeRestaurantNameLen)
 	}
 
 	restaurant.AddressRU = strings.TrimSpace(restaurant.AddressRU)
 	if restaurant.AddressRU == "" {
-		return fmt.Errorf("адрес ресторана (RU) не может быть пустым")
+		fields.Add("address_ru", i18n.CodeRestaurantAddress)
 	}
 
 	if restaurant.CityID <= 0 {
-		return fmt.Errorf("необходимо указать корректный ID города")
+		fields.Add("city_id", i18n.CodeCityIDRequired)
 	}
 
-	return nil
+	restaurant.TimeZone = strings.TrimSpace(restaurant.TimeZone)
+	if restaurant.TimeZone != "" && validateTimeZone(restaurant.TimeZone) != nil {
+		fields.Add("time_zone", i18n.CodeTimeZoneUnknown, restaurant.TimeZone)
+	}
+
+	return fields.Err()
 }
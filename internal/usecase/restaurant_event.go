@@ -5,17 +5,29 @@ import (
 	"fmt"
 	"strings"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/repository"
 )
 
 type RestaurantEventUC struct {
-	eventRepo repository.RestaurantEventRepository
+	eventRepo      repository.RestaurantEventRepository
+	eventTypeRepo  repository.EventTypeRepository
+	restaurantRepo repository.RestaurantRepository
+	access         *AccessControl
+	audit          *AuditUC
 }
 
-func NewRestaurantEventUseCase(eventRepo repository.RestaurantEventRepository) *RestaurantEventUC {
+func NewRestaurantEventUseCase(eventRepo repository.RestaurantEventRepository,
+	eventTypeRepo repository.EventTypeRepository, restaurantRepo repository.RestaurantRepository,
+	access *AccessControl, audit *AuditUC) *RestaurantEventUC {
 	return &RestaurantEventUC{
-		eventRepo: eventRepo,
+		eventRepo:      eventRepo,
+		eventTypeRepo:  eventTypeRepo,
+		restaurantRepo: restaurantRepo,
+		access:         access,
+		audit:          audit,
 	}
 }
 
@@ -24,7 +36,27 @@ func (uc *RestaurantEventUC) Create(ctx context.Context, event *models.Restauran
 		return 0, err
 	}
 
-	return uc.eventRepo.Create(ctx, event)
+	if err := uc.requireEventType(ctx, event.EventType, ""); err != nil {
+		return 0, err
+	}
+
+	_, err := uc.restaurantRepo.GetByID(ctx, event.RestaurantID)
+	if err != nil {
+		return 0, referenceError(err, "restaurant_id", i18n.CodeRestaurantNotExist)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, event.RestaurantID); err != nil {
+		return 0, err
+	}
+
+	id, err := uc.eventRepo.Create(ctx, event)
+	if err != nil {
+		return 0, err
+	}
+
+	event.ID = id
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityEvent, id, nil, event)
+	return id, nil
 }
 
 func (uc *RestaurantEventUC) GetByID(ctx context.Context, id int64) (*models.RestaurantEvent, error) {
@@ -32,60 +64,149 @@ func (uc *RestaurantEventUC) GetByID(ctx context.Context, id int64) (*models.Res
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить событие ресторана: %w", err)
 	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, event.RestaurantID); err != nil {
+		return nil, err
+	}
+
 	return event, nil
 }
 
-func (uc *RestaurantEventUC) GetByType(ctx context.Context, eventType models.EventType) ([]*models.RestaurantEvent, error) {
-	switch eventType {
-	case models.EventTypeWedding, models.EventTypeBirthday, models.EventTypeCorporate:
-	default:
-		return nil, fmt.Errorf("неизвестный тип события: %s", eventType)
+func (uc *RestaurantEventUC) GetByType(ctx context.Context, eventType string) ([]*models.RestaurantEvent, error) {
+	if _, err := uc.eventTypeRepo.GetByCode(ctx, eventType); err != nil {
+		if errs.IsNotFound(err) {
+			return nil, errs.Validation(i18n.CodeEventTypeUnknown, eventType)
+		}
+		return nil, err
 	}
 
 	return uc.eventRepo.GetByType(ctx, eventType)
 }
 
+func (uc *RestaurantEventUC) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.RestaurantEvent, error) {
+	if err := uc.access.RequireRestaurantAccess(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	_, err := uc.restaurantRepo.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.eventRepo.GetByRestaurant(ctx, restaurantID)
+}
+
 func (uc *RestaurantEventUC) Update(ctx context.Context, event *models.RestaurantEvent) error {
 	if err := validateRestaurantEvent(event); err != nil {
 		return err
 	}
 
-	_, err := uc.eventRepo.GetByID(ctx, event.ID)
+	existingEvent, err := uc.eventRepo.GetByID(ctx, event.ID)
 	if err != nil {
 		return fmt.Errorf("не удалось найти событие ресторана для обновления: %w", err)
 	}
 
-	return uc.eventRepo.Update(ctx, event)
+	if err := uc.requireEventType(ctx, event.EventType, existingEvent.EventType); err != nil {
+		return err
+	}
+
+	_, err = uc.restaurantRepo.GetByID(ctx, event.RestaurantID)
+	if err != nil {
+		return referenceError(err, "restaurant_id", i18n.CodeRestaurantNotExist)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, existingEvent.RestaurantID); err != nil {
+		return err
+	}
+	if existingEvent.RestaurantID != event.RestaurantID {
+		if err := uc.access.RequireRestaurantManager(ctx, event.RestaurantID); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.eventRepo.Update(ctx, event); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityEvent, event.ID, existingEvent, event)
+	return nil
 }
 
 func (uc *RestaurantEventUC) Delete(ctx context.Context, id int64) error {
-	_, err := uc.eventRepo.GetByID(ctx, id)
+	event, err := uc.eventRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("не удалось найти событие ресторана для удаления: %w", err)
 	}
 
-	return uc.eventRepo.Delete(ctx, id)
+	if err := uc.access.RequireRestaurantManager(ctx, event.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.eventRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityEvent, id, event, nil)
+	return nil
 }
 
 func (uc *RestaurantEventUC) List(ctx context.Context) ([]*models.RestaurantEvent, error) {
 	return uc.eventRepo.List(ctx)
 }
 
+// requireEventType проверяет тип события по справочнику. Отключенный тип
+// нельзя выбрать, но событие, у которого он уже стоит, можно обновлять.
+func (uc *RestaurantEventUC) requireEventType(ctx context.Context, code, current string) error {
+	eventType, err := uc.eventTypeRepo.GetByCode(ctx, code)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return errs.Invalid("event_type", i18n.CodeEventTypeUnknown, code)
+		}
+		return err
+	}
+
+	if !eventType.IsActive && code != current {
+		return errs.Invalid("event_type", i18n.CodeEventTypeInactive, code)
+	}
+	return nil
+}
+
 func validateRestaurantEvent(event *models.RestaurantEvent) error {
+	var fields errs.Fields
+
+	if event.RestaurantID <= 0 {
+		fields.Add("restaurant_id", i18n.CodeRestaurantIDReq)
+	}
+
 	event.Name = strings.TrimSpace(event.Name)
 	if event.Name == "" {
-		return fmt.Errorf("название события не может быть пустым")
+		fields.Add("name", i18n.CodeEventNameRequired)
 	}
 
-	switch event.EventType {
-	case models.EventTypeWedding, models.EventTypeBirthday, models.EventTypeCorporate:
-	default:
-		return fmt.Errorf("неизвестный тип события: %s", event.EventType)
+	event.EventType = strings.TrimSpace(event.EventType)
+	if event.EventType == "" {
+		fields.Add("event_type", i18n.CodeEventTypeUnknown, event.EventType)
 	}
 
 	if event.Price < 0 {
-		return fmt.Errorf("цена не может быть отрицательной")
+		fields.Add("price", i18n.CodeEventPriceNegative)
 	}
 
-	return nil
+	switch event.DepositType {
+	case "", models.DepositNone:
+		event.DepositType = models.DepositNone
+		event.DepositValue = 0
+	case models.DepositFixed:
+		if event.DepositValue <= 0 {
+			fields.Add("deposit_value", i18n.CodeDepositValueInvalid)
+		}
+	case models.DepositPercent:
+		if event.DepositValue <= 0 || event.DepositValue > 100 {
+			fields.Add("deposit_value", i18n.CodeDepositValueInvalid)
+		}
+	default:
+		fields.Add("deposit_type", i18n.CodeDepositTypeUnknown, event.DepositType)
+	}
+
+	return fields.Err()
 }
@@ -0,0 +1,396 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"restaurant-management/internal/config"
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/payment"
+	"restaurant-management/internal/repository"
+)
+
+// RestaurantEventTableUC бронирует столы под события ресторана. Стол
+// занимается событием на весь день по часам ресторана; пересечения с
+// другими событиями и бронированиями отклоняет база данных. Если событие
+// требует депозит, бронирование ждет оплаты и подтверждается уведомлением
+// платежного провайдера.
+type RestaurantEventTableUC struct {
+	bookingRepo     repository.RestaurantEventTableRepository
+	depositRepo     repository.EventDepositRepository
+	eventRepo       repository.RestaurantEventRepository
+	tableRepo       repository.TableRepository
+	combinationRepo repository.TableCombinationRepository
+	sectionRepo     repository.SectionRepository
+	payments        payment.Provider
+	schedule        *ScheduleUC
+	access          *AccessControl
+	audit           *AuditUC
+	cfg             config.PaymentConfig
+}
+
+func NewRestaurantEventTableUseCase(bookingRepo repository.RestaurantEventTableRepository,
+	depositRepo repository.EventDepositRepository, eventRepo repository.RestaurantEventRepository,
+	tableRepo repository.TableRepository, combinationRepo repository.TableCombinationRepository,
+	sectionRepo repository.SectionRepository, payments payment.Provider, schedule *ScheduleUC,
+	access *AccessControl, audit *AuditUC, cfg config.PaymentConfig) *RestaurantEventTableUC {
+	return &RestaurantEventTableUC{
+		bookingRepo:     bookingRepo,
+		depositRepo:     depositRepo,
+		eventRepo:       eventRepo,
+		tableRepo:       tableRepo,
+		combinationRepo: combinationRepo,
+		sectionRepo:     sectionRepo,
+		payments:        payments,
+		schedule:        schedule,
+		access:          access,
+		audit:           audit,
+		cfg:             cfg,
+	}
+}
+
+// Book бронирует под событие стол или, если передан combination_id, все
+// столы сочетания. Возвращает депозит, который нужно оплатить, или nil,
+// если событие его не требует.
+func (uc *RestaurantEventTableUC) Book(ctx context.Context, eventID int64,
+	req *models.EventBookingRequest) (*models.EventDeposit, error) {
+	if req.CombinationID != nil {
+		return uc.bookCombination(ctx, eventID, *req.CombinationID, req.BookingDate, req.Guests)
+	}
+	return uc.bookTable(ctx, eventID, req.TableID, req.BookingDate, req.Guests)
+}
+
+func (uc *RestaurantEventTableUC) bookTable(ctx context.Context, eventID, tableID int64, date time.Time,
+	guests int) (*models.EventDeposit, error) {
+	if err := validateEventBooking(date, guests); err != nil {
+		return nil, err
+	}
+
+	event, err := uc.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, referenceError(err, "event_id", i18n.CodeEventNotExists)
+	}
+
+	table, err := uc.tableRepo.GetByID(ctx, tableID)
+	if err != nil {
+		return nil, referenceError(err, "table_id", i18n.CodeTableNotExists)
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, table.SectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if section.RestaurantID != event.RestaurantID {
+		return nil, errs.Invalid("table_id", i18n.CodeTableNotInRestaurant, tableID, event.RestaurantID)
+	}
+
+	return uc.book(ctx, event, section, date, guests, []int64{tableID}, nil)
+}
+
+// bookCombination бронирует под событие все столы сочетания на день. Если
+// хотя бы один стол занят, не бронируется ни один.
+func (uc *RestaurantEventTableUC) bookCombination(ctx context.Context, eventID, combinationID int64, date time.Time,
+	guests int) (*models.EventDeposit, error) {
+	if err := validateEventBooking(date, guests); err != nil {
+		return nil, err
+	}
+
+	event, err := uc.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, referenceError(err, "event_id", i18n.CodeEventNotExists)
+	}
+
+	combination, err := uc.combinationRepo.GetByID(ctx, combinationID)
+	if err != nil {
+		return nil, referenceError(err, "combination_id", i18n.CodeCombinationNotExists)
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, combination.SectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if section.RestaurantID != event.RestaurantID {
+		return nil, errs.Invalid("combination_id", i18n.CodeCombinationOutside, combinationID, event.RestaurantID)
+	}
+	if len(combination.TableIDs) == 0 {
+		return nil, errs.Invalid("combination_id", i18n.CodeCombinationTables)
+	}
+
+	return uc.book(ctx, event, section, date, guests, combination.TableIDs, &combination.ID)
+}
+
+// book занимает столы секции под событие на день date по часам ресторана.
+// Бронирование с депозитом создается в статусе pending_payment и держит
+// столы до оплаты или истечения срока депозита.
+func (uc *RestaurantEventTableUC) book(ctx context.Context, event *models.RestaurantEvent, section *models.Section,
+	date time.Time, guests int, tableIDs []int64, combinationID *int64) (*models.EventDeposit, error) {
+	if err := uc.access.RequireRestaurantStaff(ctx, section.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	day, err := uc.restaurantDay(ctx, section.RestaurantID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.schedule.requireOpenOn(ctx, section.RestaurantID, day); err != nil {
+		return nil, err
+	}
+
+	err = uc.schedule.requireSectionAvailable(ctx, section.RestaurantID, section.ID, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	deposit, err := uc.newDeposit(event, day, guests)
+	if err != nil {
+		return nil, err
+	}
+
+	status := models.EventBookingConfirmed
+	if deposit != nil {
+		status = models.EventBookingPendingPayment
+	}
+
+	bookings := make([]*models.RestaurantEventTable, 0, len(tableIDs))
+	for _, tableID := range tableIDs {
+		bookings = append(bookings, &models.RestaurantEventTable{
+			EventID:       event.ID,
+			TableID:       tableID,
+			BookingDate:   day,
+			CombinationID: combinationID,
+			Status:        status,
+		})
+	}
+	if err := uc.bookingRepo.Create(ctx, bookings, deposit); err != nil {
+		return nil, uc.schedule.localizeConflict(ctx, section.RestaurantID, err)
+	}
+
+	var after interface{} = bookings[0]
+	if combinationID != nil {
+		after = bookings
+	}
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityBooking, event.ID, nil, after)
+
+	if deposit == nil {
+		return nil, nil
+	}
+
+	if err := requestPayment(ctx, uc.payments, uc.depositRepo, uc.audit, deposit, event.Name); err != nil {
+		return nil, err
+	}
+	return deposit, nil
+}
+
+// tableBookings готовит бронирования столов tableIDs под событие на день
+// day по часам ресторана. Столы могут быть из разных секций ресторана
+// события; каждая секция должна быть открыта для бронирования в этот день.
+// Как и в book, бронирование с депозитом ждет оплаты в статусе
+// pending_payment; депозит сохраняет вызывающий вместе с бронированиями.
+func (uc *RestaurantEventTableUC) tableBookings(ctx context.Context, event *models.RestaurantEvent,
+	day time.Time, guests int, tableIDs []int64) ([]*models.RestaurantEventTable, *models.EventDeposit, error) {
+	if err := uc.schedule.requireOpenOn(ctx, event.RestaurantID, day); err != nil {
+		return nil, nil, err
+	}
+
+	deposit, err := uc.newDeposit(event, day, guests)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := models.EventBookingConfirmed
+	if deposit != nil {
+		status = models.EventBookingPendingPayment
+	}
+
+	checked := make(map[int64]bool)
+	bookings := make([]*models.RestaurantEventTable, 0, len(tableIDs))
+	for _, tableID := range tableIDs {
+		table, err := uc.tableRepo.GetByID(ctx, tableID)
+		if err != nil {
+			return nil, nil, referenceError(err, "table_ids", i18n.CodeTableNotExists)
+		}
+
+		if !checked[table.SectionID] {
+			section, err := uc.sectionRepo.GetByID(ctx, table.SectionID)
+			if err != nil {
+				return nil, nil, err
+			}
+			if section.RestaurantID != event.RestaurantID {
+				return nil, nil, errs.Invalid("table_ids", i18n.CodeTableNotInRestaurant, tableID, event.RestaurantID)
+			}
+
+			err = uc.schedule.requireSectionAvailable(ctx, event.RestaurantID, section.ID, day, day.AddDate(0, 0, 1))
+			if err != nil {
+				return nil, nil, err
+			}
+			checked[table.SectionID] = true
+		}
+
+		bookings = append(bookings, &models.RestaurantEventTable{
+			EventID:     event.ID,
+			TableID:     tableID,
+			BookingDate: day,
+			Status:      status,
+		})
+	}
+
+	return bookings, deposit, nil
+}
+
+func (uc *RestaurantEventTableUC) GetTableBookings(ctx context.Context, tableID int64) ([]*models.RestaurantEventTable, error) {
+	table, err := uc.tableRepo.GetByID(ctx, tableID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить столик: %w", err)
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, table.SectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, section.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	bookings, err := uc.bookingRepo.GetByTable(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.localizeBookings(ctx, section.RestaurantID, bookings); err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+func (uc *RestaurantEventTableUC) GetEventBookings(ctx context.Context, eventID int64) ([]*models.RestaurantEventTable, error) {
+	event, err := uc.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить событие ресторана: %w", err)
+	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, event.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	bookings, err := uc.bookingRepo.GetByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.localizeBookings(ctx, event.RestaurantID, bookings); err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// CancelBooking снимает бронирование стола, а если стол забронирован в
+// составе сочетания — всех столов сочетания.
+func (uc *RestaurantEventTableUC) CancelBooking(ctx context.Context, eventID, tableID int64, date time.Time) error {
+	table, err := uc.tableRepo.GetByID(ctx, tableID)
+	if err != nil {
+		return fmt.Errorf("не удалось найти столик для отмены бронирования: %w", err)
+	}
+
+	restaurantID, err := uc.tableRestaurantID(ctx, table)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.access.RequireRestaurantStaff(ctx, restaurantID); err != nil {
+		return err
+	}
+
+	day, err := uc.restaurantDay(ctx, restaurantID, date)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.bookingRepo.Delete(ctx, eventID, tableID, day); err != nil {
+		return err
+	}
+
+	booking := &models.RestaurantEventTable{
+		EventID:     eventID,
+		TableID:     tableID,
+		BookingDate: day,
+	}
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityBooking, eventID, booking, nil)
+	return nil
+}
+
+func (uc *RestaurantEventTableUC) CheckAvailability(ctx context.Context, tableID int64, date time.Time) (bool, error) {
+	table, err := uc.tableRepo.GetByID(ctx, tableID)
+	if err != nil {
+		return false, fmt.Errorf("не удалось получить столик: %w", err)
+	}
+
+	restaurantID, err := uc.tableRestaurantID(ctx, table)
+	if err != nil {
+		return false, err
+	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, restaurantID); err != nil {
+		return false, err
+	}
+
+	day, err := uc.restaurantDay(ctx, restaurantID, date)
+	if err != nil {
+		return false, err
+	}
+
+	return uc.bookingRepo.CheckAvailability(ctx, tableID, day)
+}
+
+func validateEventBooking(date time.Time, guests int) error {
+	var fields errs.Fields
+	if date.IsZero() {
+		fields.Add("booking_date", i18n.CodeBookingDateRequired)
+	} else if !date.After(time.Now()) {
+		fields.Add("booking_date", i18n.CodeBookingDateInPast)
+	}
+	if guests < 0 || guests > maxEventGuests {
+		fields.Add("guests", i18n.CodeEventGuestsInvalid, maxEventGuests)
+	}
+	return fields.Err()
+}
+
+func (uc *RestaurantEventTableUC) tableRestaurantID(ctx context.Context, table *models.Table) (int64, error) {
+	section, err := uc.sectionRepo.GetByID(ctx, table.SectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	return section.RestaurantID, nil
+}
+
+// restaurantDay возвращает полночь дня, на который приходится date по часам
+// ресторана: событие занимает местные сутки, в каком бы поясе клиент ни
+// прислал дату.
+func (uc *RestaurantEventTableUC) restaurantDay(ctx context.Context, restaurantID int64, date time.Time) (time.Time, error) {
+	loc, err := uc.schedule.location(ctx, restaurantID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return localDay(date.In(loc), loc), nil
+}
+
+func (uc *RestaurantEventTableUC) localizeBookings(ctx context.Context, restaurantID int64,
+	bookings []*models.RestaurantEventTable) error {
+	loc, err := uc.schedule.location(ctx, restaurantID)
+	if err != nil {
+		return err
+	}
+
+	for _, booking := range bookings {
+		booking.BookingDate = booking.BookingDate.In(loc)
+	}
+	return nil
+}
@@ -0,0 +1,548 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+)
+
+const (
+	timeOfDayLayout = "15:04"
+	minutesPerDay   = 24 * 60
+	minutesPerWeek  = 7 * minutesPerDay
+)
+
+// ScheduleUC управляет расписанием ресторанов, особыми днями и закрытиями
+// секций и проверяет их для бронирований. Ресторан без недельного
+// расписания считается работающим круглосуточно, особые дни действуют и
+// для него.
+type ScheduleUC struct {
+	hoursRepo      repository.OpeningHoursRepository
+	blackoutRepo   repository.SectionBlackoutRepository
+	restaurantRepo repository.RestaurantRepository
+	sectionRepo    repository.SectionRepository
+	access         *AccessControl
+	audit          *AuditUC
+}
+
+func NewScheduleUseCase(hoursRepo repository.OpeningHoursRepository, blackoutRepo repository.SectionBlackoutRepository,
+	restaurantRepo repository.RestaurantRepository, sectionRepo repository.SectionRepository,
+	access *AccessControl, audit *AuditUC) *ScheduleUC {
+	return &ScheduleUC{
+		hoursRepo:      hoursRepo,
+		blackoutRepo:   blackoutRepo,
+		restaurantRepo: restaurantRepo,
+		sectionRepo:    sectionRepo,
+		access:         access,
+		audit:          audit,
+	}
+}
+
+func (uc *ScheduleUC) GetOpeningHours(ctx context.Context, restaurantID int64) ([]*models.OpeningInterval, error) {
+	if _, err := uc.restaurantRepo.GetByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return uc.hoursRepo.GetWeekly(ctx, restaurantID)
+}
+
+// SetOpeningHours заменяет недельное расписание целиком. Пустой список
+// снимает ограничения по времени.
+func (uc *ScheduleUC) SetOpeningHours(ctx context.Context, restaurantID int64, hours []*models.OpeningInterval) error {
+	if _, err := uc.restaurantRepo.GetByID(ctx, restaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, restaurantID); err != nil {
+		return err
+	}
+
+	if err := validateOpeningHours(hours); err != nil {
+		return err
+	}
+
+	existing, err := uc.hoursRepo.GetWeekly(ctx, restaurantID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.hoursRepo.SetWeekly(ctx, restaurantID, hours); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityOpeningHours, restaurantID, existing, hours)
+	return nil
+}
+
+// GetSpecialDays возвращает особые дни в промежутке дат. По умолчанию — на
+// год вперед от сегодняшнего дня.
+func (uc *ScheduleUC) GetSpecialDays(ctx context.Context, restaurantID int64, from, to *time.Time) ([]*models.SpecialDay, error) {
+	if _, err := uc.restaurantRepo.GetByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	loc, err := uc.location(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now().In(loc)
+	if from != nil {
+		start = *from
+	}
+	end := start.AddDate(1, 0, 0)
+	if to != nil {
+		end = *to
+	}
+
+	return uc.hoursRepo.GetSpecialDays(ctx, restaurantID, start, end)
+}
+
+func (uc *ScheduleUC) SetSpecialDay(ctx context.Context, day *models.SpecialDay) error {
+	if _, err := uc.restaurantRepo.GetByID(ctx, day.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, day.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := validateSpecialDay(day); err != nil {
+		return err
+	}
+
+	if err := uc.hoursRepo.SetSpecialDay(ctx, day); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntitySpecialDay, day.RestaurantID, nil, day)
+	return nil
+}
+
+func (uc *ScheduleUC) DeleteSpecialDay(ctx context.Context, restaurantID int64, day string) error {
+	if err := uc.access.RequireRestaurantManager(ctx, restaurantID); err != nil {
+		return err
+	}
+
+	if _, err := time.Parse(time.DateOnly, day); err != nil {
+		return errs.Invalid("day", i18n.CodeInvalidDay, day)
+	}
+
+	if err := uc.hoursRepo.DeleteSpecialDay(ctx, restaurantID, day); err != nil {
+		return err
+	}
+
+	deleted := &models.SpecialDay{RestaurantID: restaurantID, Day: day}
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntitySpecialDay, restaurantID, deleted, nil)
+	return nil
+}
+
+func (uc *ScheduleUC) CreateBlackout(ctx context.Context, blackout *models.SectionBlackout) (int64, error) {
+	section, err := uc.sectionRepo.GetByID(ctx, blackout.SectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+		return 0, err
+	}
+
+	blackout.Reason = strings.TrimSpace(blackout.Reason)
+	if !blackout.EndsAt.After(blackout.StartsAt) {
+		return 0, errs.Invalid("ends_at", i18n.CodeBlackoutPeriod)
+	}
+
+	id, err := uc.blackoutRepo.Create(ctx, blackout)
+	if err != nil {
+		return 0, err
+	}
+
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityBlackout, id, nil, blackout)
+	return id, nil
+}
+
+func (uc *ScheduleUC) GetBlackouts(ctx context.Context, sectionID int64) ([]*models.SectionBlackout, error) {
+	section, err := uc.sectionRepo.GetByID(ctx, sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, section.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	blackouts, err := uc.blackoutRepo.GetBySection(ctx, sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := uc.location(ctx, section.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+	for _, blackout := range blackouts {
+		localizeBlackout(blackout, loc)
+	}
+	return blackouts, nil
+}
+
+func (uc *ScheduleUC) DeleteBlackout(ctx context.Context, id int64) error {
+	blackout, err := uc.blackoutRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, blackout.SectionID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.blackoutRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityBlackout, id, blackout, nil)
+	return nil
+}
+
+// isOpenNow сообщает, работает ли ресторан в текущий момент.
+func (uc *ScheduleUC) isOpenNow(ctx context.Context, restaurantID int64) (bool, error) {
+	now := time.Now()
+	schedule, err := uc.load(ctx, restaurantID, now, now)
+	if err != nil {
+		return false, err
+	}
+
+	return schedule.covers(now, now.Add(time.Minute)), nil
+}
+
+// requireOpen проверяет, что ресторан работает весь промежуток [start, end).
+func (uc *ScheduleUC) requireOpen(ctx context.Context, restaurantID int64, start, end time.Time) error {
+	schedule, err := uc.load(ctx, restaurantID, start, end)
+	if err != nil {
+		return err
+	}
+
+	if !schedule.covers(start, end) {
+		return errs.Invalid("start_time", i18n.CodeRestaurantClosed)
+	}
+	return nil
+}
+
+// requireOpenOn проверяет, что ресторан работает хотя бы часть дня day.
+func (uc *ScheduleUC) requireOpenOn(ctx context.Context, restaurantID int64, day time.Time) error {
+	schedule, err := uc.load(ctx, restaurantID, day, day)
+	if err != nil {
+		return err
+	}
+
+	if !schedule.openOn(day) {
+		return errs.Invalid("booking_date", i18n.CodeRestaurantClosedOn, day.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// requireSectionAvailable проверяет, что секция не закрыта в [start, end),
+// и возвращает мешающее закрытие в конфликте.
+func (uc *ScheduleUC) requireSectionAvailable(ctx context.Context, restaurantID, sectionID int64, start, end time.Time) error {
+	blackouts, err := uc.blackoutRepo.GetByRestaurant(ctx, restaurantID, start, end)
+	if err != nil {
+		return err
+	}
+
+	for _, blackout := range blackouts {
+		if blackout.SectionID == sectionID {
+			loc, err := uc.location(ctx, restaurantID)
+			if err != nil {
+				return err
+			}
+			localizeBlackout(blackout, loc)
+			return errs.ConflictWith(blackout, i18n.CodeSectionBlackedOut, sectionID)
+		}
+	}
+	return nil
+}
+
+// blackedOutSections возвращает закрытия секций ресторана в [start, end),
+// сгруппированные по секциям.
+func (uc *ScheduleUC) blackedOutSections(ctx context.Context, restaurantID int64, start, end time.Time) (map[int64][]*models.SectionBlackout, error) {
+	blackouts, err := uc.blackoutRepo.GetByRestaurant(ctx, restaurantID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	bySection := make(map[int64][]*models.SectionBlackout)
+	for _, blackout := range blackouts {
+		bySection[blackout.SectionID] = append(bySection[blackout.SectionID], blackout)
+	}
+	return bySection, nil
+}
+
+// load читает расписание, нужное для проверки промежутка [start, end):
+// ночной интервал предыдущего дня тоже может его покрывать.
+func (uc *ScheduleUC) load(ctx context.Context, restaurantID int64, start, end time.Time) (*openingSchedule, error) {
+	weekly, err := uc.hoursRepo.GetWeekly(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := uc.location(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	special, err := uc.hoursRepo.GetSpecialDays(ctx, restaurantID, start.In(loc).AddDate(0, 0, -1), end.In(loc))
+	if err != nil {
+		return nil, err
+	}
+
+	return newOpeningSchedule(weekly, special, loc), nil
+}
+
+// location возвращает часовой пояс ресторана: собственный или, если он не
+// задан, пояс города.
+func (uc *ScheduleUC) location(ctx context.Context, restaurantID int64) (*time.Location, error) {
+	zone, err := uc.restaurantRepo.GetTimeZone(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, fmt.Errorf("неизвестный часовой пояс %q ресторана %d: %w", zone, restaurantID, err)
+	}
+	return loc, nil
+}
+
+// localDay возвращает полночь календарного дня date по часам loc. Год,
+// месяц и число берутся из date как есть, поэтому дату без времени из
+// запроса нужно передавать без перевода в другой пояс.
+func localDay(date time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+}
+
+func localizeBlackout(blackout *models.SectionBlackout, loc *time.Location) {
+	blackout.StartsAt = blackout.StartsAt.In(loc)
+	blackout.EndsAt = blackout.EndsAt.In(loc)
+	blackout.CreatedAt = blackout.CreatedAt.In(loc)
+}
+
+// localizeConflict переводит на часы ресторана время записи журнала
+// занятости, из-за которой стол не удалось занять. Остальные ошибки
+// возвращаются как есть.
+func (uc *ScheduleUC) localizeConflict(ctx context.Context, restaurantID int64, err error) error {
+	occupancy, ok := errs.ConflictingOf(err).(*models.TableOccupancy)
+	if !ok {
+		return err
+	}
+
+	loc, locErr := uc.location(ctx, restaurantID)
+	if locErr != nil {
+		return err
+	}
+	occupancy.StartsAt = occupancy.StartsAt.In(loc)
+	occupancy.EndsAt = occupancy.EndsAt.In(loc)
+	return err
+}
+
+// period — промежуток времени [start, end).
+type period struct {
+	start, end time.Time
+}
+
+// openingSchedule раскладывает недельное расписание и особые дни в
+// конкретные промежутки работы по датам.
+type openingSchedule struct {
+	weekly  map[time.Weekday][]models.TimeInterval
+	special map[string][]models.TimeInterval
+	loc     *time.Location
+}
+
+func newOpeningSchedule(weekly []*models.OpeningInterval, special []*models.SpecialDay, loc *time.Location) *openingSchedule {
+	schedule := &openingSchedule{
+		special: make(map[string][]models.TimeInterval, len(special)),
+		loc:     loc,
+	}
+
+	if len(weekly) > 0 {
+		schedule.weekly = make(map[time.Weekday][]models.TimeInterval)
+		for _, interval := range weekly {
+			schedule.weekly[interval.Weekday] = append(schedule.weekly[interval.Weekday], interval.TimeInterval)
+		}
+	}
+
+	for _, day := range special {
+		schedule.special[day.Day] = day.Intervals
+	}
+
+	return schedule
+}
+
+// periods возвращает промежутки работы, начинающиеся в календарный день day.
+func (s *openingSchedule) periods(day time.Time) []period {
+	day = day.In(s.loc)
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, s.loc)
+
+	intervals, ok := s.special[midnight.Format(time.DateOnly)]
+	if !ok {
+		if s.weekly == nil {
+			return []period{{start: midnight, end: midnight.AddDate(0, 0, 1)}}
+		}
+		intervals = s.weekly[midnight.Weekday()]
+	}
+
+	periods := make([]period, 0, len(intervals))
+	for _, interval := range intervals {
+		opens, _ := parseTimeOfDay(interval.OpensAt)
+		closes, _ := parseTimeOfDay(interval.ClosesAt)
+		if closes <= opens {
+			closes += minutesPerDay
+		}
+		periods = append(periods, period{
+			start: atMinute(midnight, opens),
+			end:   atMinute(midnight, closes),
+		})
+	}
+	return periods
+}
+
+// covers сообщает, что ресторан работает весь промежуток [start, end).
+// Смежные интервалы, в том числе ночной и утренний, считаются одним.
+func (s *openingSchedule) covers(start, end time.Time) bool {
+	var periods []period
+	for day := start.In(s.loc).AddDate(0, 0, -1); !day.After(end.In(s.loc)); day = day.AddDate(0, 0, 1) {
+		periods = append(periods, s.periods(day)...)
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].start.Before(periods[j].start) })
+
+	var merged []period
+	for _, p := range periods {
+		if n := len(merged); n > 0 && !p.start.After(merged[n-1].end) {
+			if p.end.After(merged[n-1].end) {
+				merged[n-1].end = p.end
+			}
+			continue
+		}
+		merged = append(merged, p)
+	}
+
+	for _, p := range merged {
+		if !p.start.After(start) && !p.end.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// openOn сообщает, работает ли ресторан в календарный день day.
+func (s *openingSchedule) openOn(day time.Time) bool {
+	return len(s.periods(day)) > 0
+}
+
+// atMinute возвращает момент через minutes минут после полуночи по часам
+// ресторана. Дни перехода на летнее время учитываются календарно.
+func atMinute(midnight time.Time, minutes int) time.Time {
+	return time.Date(midnight.Year(), midnight.Month(), midnight.Day(), 0, minutes, 0, 0, midnight.Location())
+}
+
+func parseTimeOfDay(value string) (int, bool) {
+	t, err := time.Parse(timeOfDayLayout, value)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// validateIntervals проверяет формат времени и то, что интервалы не
+// пересекаются. offsets задает сдвиг каждого интервала в минутах от начала
+// недели; span — длину цикла, через которую ночной интервал переходит на
+// первый.
+func validateIntervals(intervals []models.TimeInterval, offsets []int, span int, fields *errs.Fields) {
+	type minuteRange struct{ start, end int }
+
+	ranges := make([]minuteRange, 0, len(intervals))
+	for i, interval := range intervals {
+		opens, okOpens := parseTimeOfDay(interval.OpensAt)
+		if !okOpens {
+			fields.Add("opens_at", i18n.CodeTimeOfDayInvalid, interval.OpensAt)
+		}
+		closes, okCloses := parseTimeOfDay(interval.ClosesAt)
+		if !okCloses {
+			fields.Add("closes_at", i18n.CodeTimeOfDayInvalid, interval.ClosesAt)
+		}
+		if !okOpens || !okCloses {
+			continue
+		}
+
+		if closes <= opens {
+			closes += minutesPerDay
+		}
+		ranges = append(ranges, minuteRange{start: offsets[i] + opens, end: offsets[i] + closes})
+	}
+
+	if len(ranges) == 0 {
+		return
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i].start < ranges[i-1].end {
+			fields.Add("intervals", i18n.CodeOpeningHoursOverlap)
+			return
+		}
+	}
+
+	if last := ranges[len(ranges)-1]; span > 0 && last.end-span > ranges[0].start {
+		fields.Add("intervals", i18n.CodeOpeningHoursOverlap)
+	}
+}
+
+func validateOpeningHours(hours []*models.OpeningInterval) error {
+	var fields errs.Fields
+
+	intervals := make([]models.TimeInterval, 0, len(hours))
+	offsets := make([]int, 0, len(hours))
+	for _, interval := range hours {
+		if interval.Weekday < time.Sunday || interval.Weekday > time.Saturday {
+			fields.Add("weekday", i18n.CodeWeekdayInvalid)
+			continue
+		}
+		intervals = append(intervals, interval.TimeInterval)
+		offsets = append(offsets, int(interval.Weekday)*minutesPerDay)
+	}
+
+	validateIntervals(intervals, offsets, minutesPerWeek, &fields)
+	return fields.Err()
+}
+
+func validateSpecialDay(day *models.SpecialDay) error {
+	var fields errs.Fields
+
+	if _, err := time.Parse(time.DateOnly, day.Day); err != nil {
+		fields.Add("day", i18n.CodeInvalidDay, day.Day)
+	}
+
+	day.Note = strings.TrimSpace(day.Note)
+	validateIntervals(day.Intervals, make([]int, len(day.Intervals)), 0, &fields)
+
+	return fields.Err()
+}
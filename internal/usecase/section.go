@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/repository"
 )
@@ -12,12 +14,17 @@ import (
 type SectionUC struct {
 	sectionRepo    repository.SectionRepository
 	restaurantRepo repository.RestaurantRepository
+	access         *AccessControl
+	audit          *AuditUC
 }
 
-func NewSectionUseCase(sectionRepo repository.SectionRepository, restaurantRepo repository.RestaurantRepository) *SectionUC {
+func NewSectionUseCase(sectionRepo repository.SectionRepository, restaurantRepo repository.RestaurantRepository,
+	access *AccessControl, audit *AuditUC) *SectionUC {
 	return &SectionUC{
 		sectionRepo:    sectionRepo,
 		restaurantRepo: restaurantRepo,
+		access:         access,
+		audit:          audit,
 	}
 }
 
@@ -28,19 +35,30 @@ func (uc *SectionUC) Create(ctx context.Context, section *models.Section) (int64
 
 	_, err := uc.restaurantRepo.GetByID(ctx, section.RestaurantID)
 	if err != nil {
-		return 0, fmt.Errorf("ресторан не найден: %w", err)
+		return 0, referenceError(err, "restaurant_id", i18n.CodeRestaurantNotExist)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+		return 0, err
 	}
 
 	sections, err := uc.sectionRepo.GetByRestaurant(ctx, section.RestaurantID)
 	if err == nil {
 		for _, s := range sections {
 			if strings.EqualFold(s.Name, section.Name) {
-				return 0, fmt.Errorf("секция с названием '%s' уже существует в этом ресторане", section.Name)
+				return 0, errs.Conflict(i18n.CodeSectionNameTaken, section.Name)
 			}
 		}
 	}
 
-	return uc.sectionRepo.Create(ctx, section)
+	id, err := uc.sectionRepo.Create(ctx, section)
+	if err != nil {
+		return 0, err
+	}
+
+	section.ID = id
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntitySection, id, nil, section)
+	return id, nil
 }
 
 func (uc *SectionUC) GetByID(ctx context.Context, id int64) (*models.Section, error) {
@@ -48,13 +66,22 @@ func (uc *SectionUC) GetByID(ctx context.Context, id int64) (*models.Section, er
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить секцию: %w", err)
 	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, section.RestaurantID); err != nil {
+		return nil, err
+	}
+
 	return section, nil
 }
 
 func (uc *SectionUC) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.Section, error) {
+	if err := uc.access.RequireRestaurantAccess(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
 	_, err := uc.restaurantRepo.GetByID(ctx, restaurantID)
 	if err != nil {
-		return nil, fmt.Errorf("указанный ресторан не существует: %w", err)
+		return nil, err
 	}
 
 	return uc.sectionRepo.GetByRestaurant(ctx, restaurantID)
@@ -70,9 +97,22 @@ func (uc *SectionUC) Update(ctx context.Context, section *models.Section) error
 		return fmt.Errorf("не удалось найти секцию для обновления: %w", err)
 	}
 
+	if section.Tags == nil {
+		section.Tags = existingSection.Tags
+	}
+
 	_, err = uc.restaurantRepo.GetByID(ctx, section.RestaurantID)
 	if err != nil {
-		return fmt.Errorf("указанный ресторан не существует: %w", err)
+		return referenceError(err, "restaurant_id", i18n.CodeRestaurantNotExist)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, existingSection.RestaurantID); err != nil {
+		return err
+	}
+	if existingSection.RestaurantID != section.RestaurantID {
+		if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+			return err
+		}
 	}
 
 	if existingSection.RestaurantID == section.RestaurantID && existingSection.Name != section.Name ||
@@ -81,34 +121,51 @@ func (uc *SectionUC) Update(ctx context.Context, section *models.Section) error
 		if err == nil {
 			for _, s := range sections {
 				if strings.EqualFold(s.Name, section.Name) && s.ID != section.ID {
-					return fmt.Errorf("секция с названием '%s' уже существует в этом ресторане", section.Name)
+					return errs.Conflict(i18n.CodeSectionNameTaken, section.Name)
 				}
 			}
 		}
 	}
 
-	return uc.sectionRepo.Update(ctx, section)
+	if err := uc.sectionRepo.Update(ctx, section); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntitySection, section.ID, existingSection, section)
+	return nil
 }
 
 func (uc *SectionUC) Delete(ctx context.Context, id int64) error {
-	_, err := uc.sectionRepo.GetByID(ctx, id)
+	section, err := uc.sectionRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("не удалось найти секцию для удаления: %w", err)
 	}
 
-	return uc.sectionRepo.Delete(ctx, id)
+	if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.sectionRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntitySection, id, section, nil)
+	return nil
 }
 
 func validateSection(section *models.Section) error {
-	section.Name = strings.TrimSpace(section.Name)
+	var fields errs.Fields
 
+	section.Name = strings.TrimSpace(section.Name)
 	if section.Name == "" {
-		return fmt.Errorf("название секции не может быть пустым")
+		fields.Add("name", i18n.CodeSectionNameReq)
 	}
 
 	if section.RestaurantID <= 0 {
-		return fmt.Errorf("некорректный ID ресторана")
+		fields.Add("restaurant_id", i18n.CodeInvalidRestaurantID)
 	}
 
-	return nil
+	section.Tags = validateTags(section.Tags, &fields)
+
+	return fields.Err()
 }
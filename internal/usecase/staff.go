@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+)
+
+var roleRank = map[models.UserRole]int{
+	models.RoleGuest:   0,
+	models.RoleWaiter:  1,
+	models.RoleManager: 2,
+	models.RoleAdmin:   3,
+}
+
+type StaffUC struct {
+	staffRepo      repository.StaffRepository
+	userRepo       repository.UserRepository
+	restaurantRepo repository.RestaurantRepository
+	access         *AccessControl
+	audit          *AuditUC
+}
+
+func NewStaffUseCase(staffRepo repository.StaffRepository, userRepo repository.UserRepository,
+	restaurantRepo repository.RestaurantRepository, access *AccessControl, audit *AuditUC) *StaffUC {
+	return &StaffUC{
+		staffRepo:      staffRepo,
+		userRepo:       userRepo,
+		restaurantRepo: restaurantRepo,
+		access:         access,
+		audit:          audit,
+	}
+}
+
+func (uc *StaffUC) Assign(ctx context.Context, assignment *models.StaffAssignment) error {
+	if err := validateStaffAssignment(assignment); err != nil {
+		return err
+	}
+
+	if err := uc.requireAssignRights(ctx, assignment.RestaurantID, assignment.Role); err != nil {
+		return err
+	}
+
+	_, err := uc.restaurantRepo.GetByID(ctx, assignment.RestaurantID)
+	if err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, assignment.UserID)
+	if err != nil {
+		return referenceError(err, "user_id", i18n.CodeUserNotExists)
+	}
+
+	previous, err := uc.staffRepo.Get(ctx, assignment.UserID, assignment.RestaurantID)
+	if err != nil && !errs.IsNotFound(err) {
+		return err
+	}
+
+	if err := uc.staffRepo.Assign(ctx, assignment); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityStaff, assignment.UserID, previous, assignment)
+	} else {
+		uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityStaff, assignment.UserID, nil, assignment)
+	}
+
+	if roleRank[user.Role] < roleRank[assignment.Role] {
+		user.Role = assignment.Role
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("не удалось обновить роль пользователя: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (uc *StaffUC) Remove(ctx context.Context, userID, restaurantID int64) error {
+	assignment, err := uc.staffRepo.Get(ctx, userID, restaurantID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.requireAssignRights(ctx, restaurantID, assignment.Role); err != nil {
+		return err
+	}
+
+	if err := uc.staffRepo.Remove(ctx, userID, restaurantID); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityStaff, userID, assignment, nil)
+
+	return uc.recalculateRole(ctx, userID)
+}
+
+func (uc *StaffUC) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.StaffAssignment, error) {
+	_, err := uc.restaurantRepo.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantStaff(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return uc.staffRepo.GetByRestaurant(ctx, restaurantID)
+}
+
+func (uc *StaffUC) GetByUser(ctx context.Context, userID int64) ([]*models.StaffAssignment, error) {
+	if err := uc.access.RequireSelfOrAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.staffRepo.GetByUser(ctx, userID)
+}
+
+// requireAssignRights: менеджеров назначает только администратор,
+// официантов — администратор или менеджер этого ресторана.
+func (uc *StaffUC) requireAssignRights(ctx context.Context, restaurantID int64, role models.UserRole) error {
+	if role == models.RoleManager {
+		return uc.access.RequireAdmin(ctx)
+	}
+	return uc.access.RequireRestaurantManager(ctx, restaurantID)
+}
+
+func (uc *StaffUC) recalculateRole(ctx context.Context, userID int64) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.Role == models.RoleAdmin {
+		return nil
+	}
+
+	assignments, err := uc.staffRepo.GetByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	role := models.RoleGuest
+	for _, a := range assignments {
+		if roleRank[a.Role] > roleRank[role] {
+			role = a.Role
+		}
+	}
+
+	if role == user.Role {
+		return nil
+	}
+
+	user.Role = role
+	return uc.userRepo.Update(ctx, user)
+}
+
+func validateStaffAssignment(assignment *models.StaffAssignment) error {
+	var fields errs.Fields
+
+	if assignment.UserID <= 0 {
+		fields.Add("user_id", i18n.CodeUserIDRequired)
+	}
+
+	if assignment.RestaurantID <= 0 {
+		fields.Add("restaurant_id", i18n.CodeRestaurantIDReq)
+	}
+
+	switch assignment.Role {
+	case models.RoleManager, models.RoleWaiter:
+	default:
+		fields.Add("role", i18n.CodeStaffRoleInvalid)
+	}
+
+	return fields.Err()
+}
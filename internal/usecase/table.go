@@ -3,27 +3,44 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/repository"
 )
 
+const (
+	defaultTableMinSeats = 1
+	defaultTableMaxSeats = 4
+)
+
 type TableUC struct {
 	tableRepo   repository.TableRepository
 	sectionRepo repository.SectionRepository
+	access      *AccessControl
+	audit       *AuditUC
 }
 
-func NewTableUseCase(tableRepo repository.TableRepository, sectionRepo repository.SectionRepository) *TableUC {
+func NewTableUseCase(tableRepo repository.TableRepository, sectionRepo repository.SectionRepository,
+	access *AccessControl, audit *AuditUC) *TableUC {
 	return &TableUC{
 		tableRepo:   tableRepo,
 		sectionRepo: sectionRepo,
+		access:      access,
+		audit:       audit,
 	}
 }
 
 func (uc *TableUC) Create(ctx context.Context, table *models.Table) (int64, error) {
-	_, err := uc.sectionRepo.GetByID(ctx, table.SectionID)
+	section, err := uc.sectionRepo.GetByID(ctx, table.SectionID)
 	if err != nil {
-		return 0, fmt.Errorf("указанная секция не существует: %w", err)
+		return 0, referenceError(err, "section_id", i18n.CodeSectionNotExists)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+		return 0, err
 	}
 
 	if err := validateTable(table); err != nil {
@@ -34,7 +51,7 @@ func (uc *TableUC) Create(ctx context.Context, table *models.Table) (int64, erro
 	if err == nil {
 		for _, t := range tables {
 			if t.NumberOfTable == table.NumberOfTable {
-				return 0, fmt.Errorf("столик с номером %d уже существует в этой секции", table.NumberOfTable)
+				return 0, errs.Conflict(i18n.CodeTableNumberTaken, table.NumberOfTable)
 			}
 		}
 	}
@@ -44,17 +61,16 @@ func (uc *TableUC) Create(ctx context.Context, table *models.Table) (int64, erro
 		return 0, err
 	}
 
+	table.ID = id
 	if table.QR == "" {
 		qr, err := uc.tableRepo.GenerateQR(ctx, id)
-		if err != nil {
-			return id, nil
+		if err == nil {
+			table.QR = qr
+			_ = uc.tableRepo.Update(ctx, table)
 		}
-		table.QR = qr
-
-		table.ID = id
-		_ = uc.tableRepo.Update(ctx, table)
 	}
 
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityTable, id, nil, table)
 	return id, nil
 }
 
@@ -69,7 +85,7 @@ func (uc *TableUC) GetByID(ctx context.Context, id int64) (*models.Table, error)
 func (uc *TableUC) GetBySection(ctx context.Context, sectionID int64) ([]*models.Table, error) {
 	_, err := uc.sectionRepo.GetByID(ctx, sectionID)
 	if err != nil {
-		return nil, fmt.Errorf("указанная секция не существует: %w", err)
+		return nil, err
 	}
 
 	return uc.tableRepo.GetBySection(ctx, sectionID)
@@ -81,9 +97,29 @@ func (uc *TableUC) Update(ctx context.Context, table *models.Table) error {
 		return fmt.Errorf("не удалось найти столик для обновления: %w", err)
 	}
 
-	_, err = uc.sectionRepo.GetByID(ctx, table.SectionID)
+	// Клиенты, которые еще не знают о вместимости и тегах, не должны их
+	// сбрасывать при обновлении номера или секции.
+	if table.MinSeats == 0 && table.MaxSeats == 0 {
+		table.MinSeats, table.MaxSeats = existingTable.MinSeats, existingTable.MaxSeats
+	}
+	if table.Shape == "" {
+		table.Shape = existingTable.Shape
+	}
+	if table.Tags == nil {
+		table.Tags = existingTable.Tags
+	}
+
+	if err := uc.requireTableManager(ctx, existingTable.SectionID); err != nil {
+		return err
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, table.SectionID)
 	if err != nil {
-		return fmt.Errorf("указанная секция не существует: %w", err)
+		return referenceError(err, "section_id", i18n.CodeSectionNotExists)
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+		return err
 	}
 
 	if err := validateTable(table); err != nil {
@@ -96,41 +132,132 @@ func (uc *TableUC) Update(ctx context.Context, table *models.Table) error {
 		if err == nil {
 			for _, t := range tables {
 				if t.NumberOfTable == table.NumberOfTable && t.ID != table.ID {
-					return fmt.Errorf("столик с номером %d уже существует в этой секции", table.NumberOfTable)
+					return errs.Conflict(i18n.CodeTableNumberTaken, table.NumberOfTable)
 				}
 			}
 		}
 	}
 
-	return uc.tableRepo.Update(ctx, table)
+	if err := uc.tableRepo.Update(ctx, table); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityTable, table.ID, existingTable, table)
+	return nil
 }
 
 func (uc *TableUC) Delete(ctx context.Context, id int64) error {
-	_, err := uc.tableRepo.GetByID(ctx, id)
+	table, err := uc.tableRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("не удалось найти столик для удаления: %w", err)
 	}
 
-	return uc.tableRepo.Delete(ctx, id)
+	if err := uc.requireTableManager(ctx, table.SectionID); err != nil {
+		return err
+	}
+
+	if err := uc.tableRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityTable, id, table, nil)
+	return nil
 }
 
 func (uc *TableUC) GenerateQR(ctx context.Context, tableID int64) (string, error) {
-	_, err := uc.tableRepo.GetByID(ctx, tableID)
+	table, err := uc.tableRepo.GetByID(ctx, tableID)
 	if err != nil {
 		return "", fmt.Errorf("не удалось найти столик для генерации QR-кода: %w", err)
 	}
 
-	return uc.tableRepo.GenerateQR(ctx, tableID)
+	if err := uc.requireTableManager(ctx, table.SectionID); err != nil {
+		return "", err
+	}
+
+	qr, err := uc.tableRepo.GenerateQR(ctx, tableID)
+	if err != nil {
+		return "", err
+	}
+
+	updated := *table
+	updated.QR = qr
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityTable, tableID, table, &updated)
+	return qr, nil
+}
+
+func (uc *TableUC) requireTableManager(ctx context.Context, sectionID int64) error {
+	section, err := uc.sectionRepo.GetByID(ctx, sectionID)
+	if err != nil {
+		return err
+	}
+
+	return uc.access.RequireRestaurantManager(ctx, section.RestaurantID)
 }
 
 func validateTable(table *models.Table) error {
+	var fields errs.Fields
+
 	if table.NumberOfTable <= 0 {
-		return fmt.Errorf("номер столика должен быть положительным числом")
+		fields.Add("number_of_table", i18n.CodeTableNumberInvalid)
 	}
 
 	if table.SectionID <= 0 {
-		return fmt.Errorf("необходимо указать корректный ID секции")
+		fields.Add("section_id", i18n.CodeSectionIDRequired)
 	}
 
-	return nil
+	if table.MinSeats == 0 {
+		table.MinSeats = defaultTableMinSeats
+	}
+	if table.MaxSeats == 0 {
+		table.MaxSeats = max(table.MinSeats, defaultTableMaxSeats)
+	}
+	if table.MinSeats < 1 || table.MaxSeats < table.MinSeats || table.MaxSeats > maxPartySize {
+		fields.Add("max_seats", i18n.CodeTableSeatsInvalid, maxPartySize)
+	}
+
+	if table.Shape == "" {
+		table.Shape = models.TableShapeSquare
+	}
+	if !validTableShape(table.Shape) {
+		fields.Add("shape", i18n.CodeTableShapeUnknown, table.Shape)
+	}
+
+	table.Tags = validateTags(table.Tags, &fields)
+
+	return fields.Err()
+}
+
+func validTableShape(shape models.TableShape) bool {
+	switch shape {
+	case models.TableShapeRound, models.TableShapeSquare, models.TableShapeRectangle,
+		models.TableShapeBooth, models.TableShapeBar:
+		return true
+	}
+	return false
+}
+
+// validateTags приводит теги к нижнему регистру, убирает повторы и
+// проверяет, что все они известны.
+func validateTags(tags []string, fields *errs.Fields) []string {
+	if tags == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		switch tag {
+		case models.TableTagWindow, models.TableTagVIP, models.TableTagSmoking,
+			models.TableTagKidsFriendly, models.TableTagWheelchair:
+		default:
+			fields.Add("tags", i18n.CodeTableTagUnknown, tag)
+			continue
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
 }
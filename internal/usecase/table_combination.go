@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+)
+
+// TableCombinationUC настраивает, какие столы секции можно сдвинуть для
+// большой компании. Бронирование сочетания занимает все его столы.
+type TableCombinationUC struct {
+	combinationRepo repository.TableCombinationRepository
+	tableRepo       repository.TableRepository
+	sectionRepo     repository.SectionRepository
+	access          *AccessControl
+	audit           *AuditUC
+}
+
+func NewTableCombinationUseCase(combinationRepo repository.TableCombinationRepository,
+	tableRepo repository.TableRepository, sectionRepo repository.SectionRepository,
+	access *AccessControl, audit *AuditUC) *TableCombinationUC {
+	return &TableCombinationUC{
+		combinationRepo: combinationRepo,
+		tableRepo:       tableRepo,
+		sectionRepo:     sectionRepo,
+		access:          access,
+		audit:           audit,
+	}
+}
+
+// Create сохраняет сочетание. Без max_seats вместимость — сумма мест его
+// столов.
+func (uc *TableCombinationUC) Create(ctx context.Context, combination *models.TableCombination) (int64, error) {
+	section, err := uc.sectionRepo.GetByID(ctx, combination.SectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+		return 0, err
+	}
+
+	if err := uc.validate(ctx, combination); err != nil {
+		return 0, err
+	}
+
+	id, err := uc.combinationRepo.Create(ctx, combination)
+	if err != nil {
+		return 0, err
+	}
+
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityCombination, id, nil, combination)
+	return id, nil
+}
+
+func (uc *TableCombinationUC) GetBySection(ctx context.Context, sectionID int64) ([]*models.TableCombination, error) {
+	section, err := uc.sectionRepo.GetByID(ctx, sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantAccess(ctx, section.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	return uc.combinationRepo.GetBySection(ctx, sectionID)
+}
+
+// Update меняет название, вместимость и столы сочетания. Столы нельзя
+// менять, пока сочетание держат предстоящие бронирования: они уже заняли
+// прежний набор столов.
+func (uc *TableCombinationUC) Update(ctx context.Context, combination *models.TableCombination) error {
+	existing, err := uc.combinationRepo.GetByID(ctx, combination.ID)
+	if err != nil {
+		return err
+	}
+
+	if combination.SectionID != existing.SectionID {
+		return errs.NotFound(i18n.CodeCombinationNotFound, combination.ID)
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, existing.SectionID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+		return err
+	}
+
+	// Как и у столов, не переданные столы и вместимость остаются прежними.
+	if combination.TableIDs == nil {
+		combination.TableIDs = existing.TableIDs
+	}
+	if combination.MinSeats == 0 && combination.MaxSeats == 0 {
+		combination.MinSeats, combination.MaxSeats = existing.MinSeats, existing.MaxSeats
+	}
+
+	if err := uc.validate(ctx, combination); err != nil {
+		return err
+	}
+
+	if !sameTables(existing.TableIDs, combination.TableIDs) {
+		if err := uc.requireUnused(ctx, combination.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.combinationRepo.Update(ctx, combination); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityCombination, combination.ID, existing, combination)
+	return nil
+}
+
+// Delete удаляет сочетание, если его не держат предстоящие бронирования.
+// Прошедшие бронирования остаются за основным столом.
+func (uc *TableCombinationUC) Delete(ctx context.Context, sectionID, id int64) error {
+	combination, err := uc.combinationRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if combination.SectionID != sectionID {
+		return errs.NotFound(i18n.CodeCombinationNotFound, id)
+	}
+
+	section, err := uc.sectionRepo.GetByID(ctx, sectionID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.access.RequireRestaurantManager(ctx, section.RestaurantID); err != nil {
+		return err
+	}
+
+	if err := uc.requireUnused(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.combinationRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityCombination, id, combination, nil)
+	return nil
+}
+
+func (uc *TableCombinationUC) requireUnused(ctx context.Context, id int64) error {
+	inUse, err := uc.combinationRepo.InUse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if inUse {
+		return errs.Conflict(i18n.CodeCombinationInUse, id)
+	}
+	return nil
+}
+
+// validate проверяет, что в сочетании не меньше двух разных столов его
+// секции, и заполняет вместимость по умолчанию.
+func (uc *TableCombinationUC) validate(ctx context.Context, combination *models.TableCombination) error {
+	var fields errs.Fields
+
+	combination.Name = strings.TrimSpace(combination.Name)
+
+	seen := make(map[int64]bool, len(combination.TableIDs))
+	var tableIDs []int64
+	for _, id := range combination.TableIDs {
+		if !seen[id] {
+			seen[id] = true
+			tableIDs = append(tableIDs, id)
+		}
+	}
+	combination.TableIDs = tableIDs
+
+	if len(tableIDs) < 2 {
+		fields.Add("table_ids", i18n.CodeCombinationTables)
+		return fields.Err()
+	}
+
+	tables, err := uc.tableRepo.GetBySection(ctx, combination.SectionID)
+	if err != nil {
+		return err
+	}
+	bySection := make(map[int64]*models.Table, len(tables))
+	for _, table := range tables {
+		bySection[table.ID] = table
+	}
+
+	seats := 0
+	for _, id := range tableIDs {
+		table, ok := bySection[id]
+		if !ok {
+			fields.Add("table_ids", i18n.CodeTableNotInSection, id, combination.SectionID)
+			continue
+		}
+		seats += table.MaxSeats
+	}
+
+	if combination.MinSeats == 0 {
+		combination.MinSeats = 1
+	}
+	if combination.MaxSeats == 0 {
+		combination.MaxSeats = min(seats, maxPartySize)
+	}
+	if combination.MinSeats < 1 || combination.MaxSeats < combination.MinSeats || combination.MaxSeats > maxPartySize {
+		fields.Add("max_seats", i18n.CodeCombinationSeats, maxPartySize)
+	}
+
+	return fields.Err()
+}
+
+func sameTables(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	set := make(map[int64]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+	for _, id := range b {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"restaurant-management/internal/auth"
 	"restaurant-management/internal/models"
 )
 
@@ -13,6 +14,9 @@ type UserUseCase interface {
 	GetByPhone(ctx context.Context, phone string) (*models.User, error)
 	Update(ctx context.Context, user *models.User) error
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) error
+	Anonymize(ctx context.Context, id int64) error
+	Export(ctx context.Context, id int64) (*models.UserExport, error)
 	List(ctx context.Context, limit, offset int) ([]*models.User, error)
 }
 
@@ -50,6 +54,13 @@ type TableUseCase interface {
 	GenerateQR(ctx context.Context, tableID int64) (string, error)
 }
 
+type TableCombinationUseCase interface {
+	Create(ctx context.Context, combination *models.TableCombination) (int64, error)
+	GetBySection(ctx context.Context, sectionID int64) ([]*models.TableCombination, error)
+	Update(ctx context.Context, combination *models.TableCombination) error
+	Delete(ctx context.Context, sectionID, id int64) error
+}
+
 type MenuTypeUseCase interface {
 	Create(ctx context.Context, menuType *models.MenuType) (int64, error)
 	GetByID(ctx context.Context, id int64) (*models.MenuType, error)
@@ -58,6 +69,14 @@ type MenuTypeUseCase interface {
 	List(ctx context.Context) ([]*models.MenuType, error)
 }
 
+type EventTypeUseCase interface {
+	Create(ctx context.Context, eventType *models.EventType) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.EventType, error)
+	Update(ctx context.Context, eventType *models.EventType) error
+	Delete(ctx context.Context, id int64) error
+	List(ctx context.Context, includeInactive bool) ([]*models.EventType, error)
+}
+
 type MenuUseCase interface {
 	Create(ctx context.Context, menu *models.Menu) (int64, error)
 	GetByID(ctx context.Context, id int64) (*models.Menu, error)
@@ -69,18 +88,115 @@ type MenuUseCase interface {
 type RestaurantEventUseCase interface {
 	Create(ctx context.Context, event *models.RestaurantEvent) (int64, error)
 	GetByID(ctx context.Context, id int64) (*models.RestaurantEvent, error)
-	GetByType(ctx context.Context, eventType models.EventType) ([]*models.RestaurantEvent, error)
+	GetByType(ctx context.Context, eventType string) ([]*models.RestaurantEvent, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.RestaurantEvent, error)
 	Update(ctx context.Context, event *models.RestaurantEvent) error
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context) ([]*models.RestaurantEvent, error)
 }
 
+type EventPackageUseCase interface {
+	Create(ctx context.Context, pkg *models.EventPackage) (int64, error)
+	GetByID(ctx context.Context, eventID, id int64) (*models.EventPackage, error)
+	GetByEvent(ctx context.Context, eventID int64, includeInactive bool) ([]*models.EventPackage, error)
+	Update(ctx context.Context, pkg *models.EventPackage) error
+	Delete(ctx context.Context, eventID, id int64) error
+	Quote(ctx context.Context, eventID, packageID int64, guests int, date time.Time) (*models.EventQuote, error)
+	GetPricing(ctx context.Context, restaurantID int64) (*models.EventPricing, error)
+	SetPricing(ctx context.Context, pricing *models.EventPricing) error
+}
+
+type EventInquiryUseCase interface {
+	Create(ctx context.Context, inquiry *models.EventInquiry) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.EventInquiry, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64, filter models.InquiryFilter) ([]*models.EventInquiry, error)
+	Update(ctx context.Context, inquiry *models.EventInquiry) error
+	UpdateStatus(ctx context.Context, id int64, req *models.InquiryStatusRequest) error
+	Assign(ctx context.Context, id int64, userID *int64) error
+	AddNote(ctx context.Context, id int64, note string) (*models.InquiryActivity, error)
+	GetActivities(ctx context.Context, id int64) ([]*models.InquiryActivity, error)
+	Convert(ctx context.Context, id int64, req *models.InquiryConversion) (*models.InquiryConversionResult, error)
+}
+
 type RestaurantEventTableUseCase interface {
-	BookTable(ctx context.Context, eventID, tableID int64, date time.Time) error
+	Book(ctx context.Context, eventID int64, req *models.EventBookingRequest) (*models.EventDeposit, error)
 	GetTableBookings(ctx context.Context, tableID int64) ([]*models.RestaurantEventTable, error)
 	GetEventBookings(ctx context.Context, eventID int64) ([]*models.RestaurantEventTable, error)
 	CancelBooking(ctx context.Context, eventID, tableID int64, date time.Time) error
 	CheckAvailability(ctx context.Context, tableID int64, date time.Time) (bool, error)
+	ConfirmPayment(ctx context.Context, body []byte, signature string) error
+	ExpireDeposits(ctx context.Context) (int64, error)
+}
+
+type ReservationUseCase interface {
+	Create(ctx context.Context, reservation *models.Reservation) (int64, error)
+	GetByID(ctx context.Context, id int64) (*models.Reservation, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64, filter models.ReservationFilter) ([]*models.Reservation, error)
+	GetByUser(ctx context.Context, userID int64) ([]*models.Reservation, error)
+	Update(ctx context.Context, reservation *models.Reservation) error
+	Cancel(ctx context.Context, id int64) error
+	UpdateStatus(ctx context.Context, id int64, status models.ReservationStatus) error
+	PreviewAssignment(ctx context.Context, req *models.AssignmentRequest) (*models.TableAssignment, error)
+	Availability(ctx context.Context, req *models.AvailabilityRequest) ([]*models.AvailabilitySlot, error)
+	ForgiveNoShow(ctx context.Context, id int64) error
+	GetNoShowPolicy(ctx context.Context, restaurantID int64) (*models.NoShowPolicy, error)
+	SetNoShowPolicy(ctx context.Context, policy *models.NoShowPolicy) error
+}
+
+type WaitlistUseCase interface {
+	Add(ctx context.Context, req *models.WaitlistRequest) (*models.WaitlistEntry, error)
+	GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.WaitlistEntry, error)
+	Estimate(ctx context.Context, restaurantID int64, partySize int) (*models.WaitEstimate, error)
+	Notify(ctx context.Context, id int64) error
+	Seat(ctx context.Context, id int64, req *models.SeatRequest) (int64, error)
+	Cancel(ctx context.Context, id int64) error
+}
+
+type ScheduleUseCase interface {
+	GetOpeningHours(ctx context.Context, restaurantID int64) ([]*models.OpeningInterval, error)
+	SetOpeningHours(ctx context.Context, restaurantID int64, hours []*models.OpeningInterval) error
+	GetSpecialDays(ctx context.Context, restaurantID int64, from, to *time.Time) ([]*models.SpecialDay, error)
+	SetSpecialDay(ctx context.Context, day *models.SpecialDay) error
+	DeleteSpecialDay(ctx context.Context, restaurantID int64, day string) error
+	CreateBlackout(ctx context.Context, blackout *models.SectionBlackout) (int64, error)
+	GetBlackouts(ctx context.Context, sectionID int64) ([]*models.SectionBlackout, error)
+	DeleteBlackout(ctx context.Context, id int64) error
+}
+
+type CalendarUseCase interface {
+	IssueFeed(ctx context.Context, restaurantID int64) (*models.CalendarFeedCreated, error)
+	RevokeFeed(ctx context.Context, restaurantID int64) error
+	Feed(ctx context.Context, restaurantID int64, token string) (*models.Calendar, error)
+	ReservationCalendar(ctx context.Context, id int64) (*models.Calendar, error)
+}
+
+type AuthUseCase interface {
+	RequestOTP(ctx context.Context, phone string) error
+	VerifyOTP(ctx context.Context, req *models.OTPVerifyRequest, device models.DeviceInfo) (*models.AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*models.AuthTokens, error)
+	Logout(ctx context.Context) error
+	LogoutAll(ctx context.Context) error
+	ListSessions(ctx context.Context, userID int64) ([]*models.Session, error)
+	Authenticate(ctx context.Context, accessToken string) (*auth.Principal, error)
+	AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error)
+}
+
+type APIKeyUseCase interface {
+	Create(ctx context.Context, req *models.APIKeyCreateRequest) (*models.APIKeyCreated, error)
+	List(ctx context.Context) ([]*models.APIKey, error)
+	Revoke(ctx context.Context, id int64) error
+}
+
+type StaffUseCase interface {
+	Assign(ctx context.Context, assignment *models.StaffAssignment) error
+	Remove(ctx context.Context, userID, restaurantID int64) error
+	GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.StaffAssignment, error)
+	GetByUser(ctx context.Context, userID int64) ([]*models.StaffAssignment, error)
+}
+
+type AuditUseCase interface {
+	List(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error)
+	PurgeExpired(ctx context.Context) (int64, error)
 }
 
 type UseCase struct {
@@ -89,8 +205,20 @@ type UseCase struct {
 	Restaurant           RestaurantUseCase
 	Section              SectionUseCase
 	Table                TableUseCase
+	TableCombination     TableCombinationUseCase
 	MenuType             MenuTypeUseCase
+	EventType            EventTypeUseCase
 	Menu                 MenuUseCase
 	RestaurantEvent      RestaurantEventUseCase
+	EventPackage         EventPackageUseCase
+	EventInquiry         EventInquiryUseCase
 	RestaurantEventTable RestaurantEventTableUseCase
+	Reservation          ReservationUseCase
+	Waitlist             WaitlistUseCase
+	Schedule             ScheduleUseCase
+	Calendar             CalendarUseCase
+	Auth                 AuthUseCase
+	Staff                StaffUseCase
+	APIKey               APIKeyUseCase
+	Audit                AuditUseCase
 }
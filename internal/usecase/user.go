@@ -2,32 +2,68 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
 	"restaurant-management/internal/models"
 	"restaurant-management/internal/repository"
+	"restaurant-management/pkg/phone"
 )
 
+// defaultGuestName — имя гостя, который зарегистрирован без имени, например
+// по одному номеру телефона.
+const defaultGuestName = "Гость"
+
 type UserUC struct {
-	userRepo repository.UserRepository
+	userRepo        repository.UserRepository
+	sessionRepo     repository.SessionRepository
+	staffRepo       repository.StaffRepository
+	reservationRepo repository.ReservationRepository
+	waitlistRepo    repository.WaitlistRepository
+	depositRepo     repository.EventDepositRepository
+	access          *AccessControl
+	audit           *AuditUC
 }
 
-func NewUserUseCase(userRepo repository.UserRepository) *UserUC {
+func NewUserUseCase(userRepo repository.UserRepository, sessionRepo repository.SessionRepository,
+	staffRepo repository.StaffRepository, reservationRepo repository.ReservationRepository,
+	waitlistRepo repository.WaitlistRepository, depositRepo repository.EventDepositRepository,
+	access *AccessControl, audit *AuditUC) *UserUC {
 	return &UserUC{
-		userRepo: userRepo,
+		userRepo:        userRepo,
+		sessionRepo:     sessionRepo,
+		staffRepo:       staffRepo,
+		reservationRepo: reservationRepo,
+		waitlistRepo:    waitlistRepo,
+		depositRepo:     depositRepo,
+		access:          access,
+		audit:           audit,
 	}
 }
 
 func (uc *UserUC) Create(ctx context.Context, user *models.User) (int64, error) {
-	existingUser, err := uc.userRepo.GetByPhone(ctx, user.PhoneNumber)
-	if err == nil && existingUser != nil {
-		return 0, fmt.Errorf("пользователь с номером телефона %s уже существует", user.PhoneNumber)
+	if user.Role == "" {
+		user.Role = models.RoleGuest
+	}
+
+	if user.Role != models.RoleGuest {
+		if err := uc.access.RequireAdmin(ctx); err != nil {
+			return 0, err
+		}
 	}
 
 	if err := validateUser(user); err != nil {
 		return 0, err
 	}
 
+	existingUser, err := uc.userRepo.GetByPhone(ctx, user.PhoneNumber)
+	if err == nil && existingUser != nil {
+		return 0, errs.Conflict(i18n.CodeUserPhoneExists, user.PhoneNumber)
+	}
+
 	if user.Language == "" {
 		user.Language = "ru"
 	}
@@ -36,7 +72,14 @@ func (uc *UserUC) Create(ctx context.Context, user *models.User) (int64, error)
 		user.IsActive = true
 	}
 
-	return uc.userRepo.Create(ctx, user)
+	id, err := uc.userRepo.Create(ctx, user)
+	if err != nil {
+		return 0, err
+	}
+
+	user.ID = id
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityUser, id, nil, user)
+	return id, nil
 }
 
 func (uc *UserUC) GetByID(ctx context.Context, id int64) (*models.User, error) {
@@ -47,8 +90,13 @@ func (uc *UserUC) GetByID(ctx context.Context, id int64) (*models.User, error) {
 	return user, nil
 }
 
-func (uc *UserUC) GetByPhone(ctx context.Context, phone string) (*models.User, error) {
-	user, err := uc.userRepo.GetByPhone(ctx, phone)
+func (uc *UserUC) GetByPhone(ctx context.Context, phoneNumber string) (*models.User, error) {
+	number, err := normalizePhone(phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByPhone(ctx, number)
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить пользователя: %w", err)
 	}
@@ -56,35 +104,159 @@ func (uc *UserUC) GetByPhone(ctx context.Context, phone string) (*models.User, e
 }
 
 func (uc *UserUC) Update(ctx context.Context, user *models.User) error {
+	if err := uc.access.RequireSelfOrAdmin(ctx, user.ID); err != nil {
+		return err
+	}
+
 	existingUser, err := uc.userRepo.GetByID(ctx, user.ID)
 	if err != nil {
 		return fmt.Errorf("не удалось найти пользователя для обновления: %w", err)
 	}
 
+	if existingUser.DeletedAt != nil {
+		return errs.NotFound(i18n.CodeUserDeleted, user.ID)
+	}
+
+	if user.Role == "" {
+		user.Role = existingUser.Role
+	}
+
+	if user.Role != existingUser.Role {
+		if err := uc.access.RequireAdmin(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := validateUser(user); err != nil {
+		return err
+	}
+
 	if existingUser.PhoneNumber != user.PhoneNumber {
 		dupUser, err := uc.userRepo.GetByPhone(ctx, user.PhoneNumber)
 		if err == nil && dupUser != nil && dupUser.ID != user.ID {
-			return fmt.Errorf("пользователь с номером телефона %s уже существует", user.PhoneNumber)
+			return errs.Conflict(i18n.CodeUserPhoneExists, user.PhoneNumber)
 		}
 	}
 
-	if err := validateUser(user); err != nil {
+	if err := uc.userRepo.Update(ctx, user); err != nil {
 		return err
 	}
 
-	return uc.userRepo.Update(ctx, user)
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityUser, user.ID, existingUser, user)
+
+	if !user.IsActive {
+		if err := uc.sessionRepo.DeleteByUser(ctx, user.ID); err != nil {
+			return i18n.Wrap(err, i18n.CodeSessionsRevokeFailed)
+		}
+	}
+
+	return nil
 }
 
 func (uc *UserUC) Delete(ctx context.Context, id int64) error {
-	_, err := uc.userRepo.GetByID(ctx, id)
+	if err := uc.access.RequireSelfOrAdmin(ctx, id); err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("не удалось найти пользователя для удаления: %w", err)
 	}
 
-	return uc.userRepo.Delete(ctx, id)
+	if err := uc.userRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionDelete, models.AuditEntityUser, id, user, nil)
+
+	return uc.sessionRepo.DeleteByUser(ctx, id)
+}
+
+func (uc *UserUC) Restore(ctx context.Context, id int64) error {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	if err := uc.userRepo.Restore(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit.record(ctx, models.AuditActionRestore, models.AuditEntityUser, id, nil, nil)
+	return nil
+}
+
+// Anonymize необратимо стирает телефон, имя и фамилию пользователя по его
+// запросу на удаление данных. Запись остается, чтобы не ломать историю.
+func (uc *UserUC) Anonymize(ctx context.Context, id int64) error {
+	if err := uc.access.RequireSelfOrAdmin(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.userRepo.Anonymize(ctx, id); err != nil {
+		return err
+	}
+
+	// Снимки в журнале аудита тоже содержат персональные данные, в том числе
+	// снимки бронирований и записей в очереди гостя.
+	if err := uc.audit.scrubUser(ctx, id); err != nil {
+		return err
+	}
+	uc.audit.record(ctx, models.AuditActionAnonymize, models.AuditEntityUser, id, nil, nil)
+
+	return uc.sessionRepo.DeleteByUser(ctx, id)
+}
+
+func (uc *UserUC) Export(ctx context.Context, id int64) (*models.UserExport, error) {
+	if err := uc.access.RequireSelfOrAdmin(ctx, id); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+
+	assignments, err := uc.staffRepo.GetByUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := uc.sessionRepo.ListByUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reservations, err := uc.reservationRepo.GetByUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := uc.waitlistRepo.GetByUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits, err := uc.depositRepo.GetByUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UserExport{
+		User:             user,
+		StaffAssignments: assignments,
+		Sessions:         sessions,
+		Reservations:     reservations,
+		WaitlistEntries:  entries,
+		Deposits:         deposits,
+		ExportedAt:       time.Now(),
+	}, nil
 }
 
 func (uc *UserUC) List(ctx context.Context, limit, offset int) ([]*models.User, error) {
+	if err := uc.access.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
 	if limit <= 0 {
 		limit = 10
 	}
@@ -96,17 +268,36 @@ func (uc *UserUC) List(ctx context.Context, limit, offset int) ([]*models.User,
 }
 
 func validateUser(user *models.User) error {
-	if user.PhoneNumber == "" {
-		return fmt.Errorf("номер телефона не может быть пустым")
-	}
+	var fields errs.Fields
 
-	if len(user.PhoneNumber) < 10 {
-		return fmt.Errorf("некорректный номер телефона")
+	if normalized, err := normalizePhone(user.PhoneNumber); err != nil {
+		fields.Add("phone_number", i18n.CodeOf(err))
+	} else {
+		user.PhoneNumber = normalized
 	}
 
 	if user.Name == "" {
-		return fmt.Errorf("имя не может быть пустым")
+		fields.Add("name", i18n.CodeUserNameRequired)
 	}
 
-	return nil
+	switch user.Role {
+	case models.RoleAdmin, models.RoleManager, models.RoleWaiter, models.RoleGuest:
+	default:
+		fields.Add("role", i18n.CodeUserRoleUnknown, user.Role)
+	}
+
+	return fields.Err()
+}
+
+// normalizePhone приводит номер к формату E.164, чтобы «8 701 ...» и
+// «+7 701 ...» не превращались в разных пользователей.
+func normalizePhone(raw string) (string, error) {
+	normalized, err := phone.Normalize(raw)
+	switch {
+	case errors.Is(err, phone.ErrEmpty):
+		return "", errs.Invalid("phone_number", i18n.CodePhoneRequired)
+	case err != nil:
+		return "", errs.Invalid("phone_number", i18n.CodePhoneInvalid)
+	}
+	return normalized, nil
 }
@@ -0,0 +1,479 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"restaurant-management/internal/errs"
+	"restaurant-management/internal/i18n"
+	"restaurant-management/internal/models"
+	"restaurant-management/internal/repository"
+	"restaurant-management/internal/sms"
+)
+
+const (
+	// turnTimeWindow — за сколько дней завершенные бронирования учитываются
+	// в среднем времени посадки.
+	turnTimeWindow = 30 * 24 * time.Hour
+	// waitHorizon — насколько вперед читается занятость столов для оценки
+	// ожидания. Позже стол считается свободным.
+	waitHorizon = maxReservationDuration * time.Minute
+)
+
+// WaitlistUC ведет очередь гостей без брони: записывает гостей по номеру
+// телефона, оценивает ожидание, сообщает, что стол готов, и сажает гостей,
+// превращая запись в бронирование со статусом seated.
+type WaitlistUC struct {
+	waitlistRepo   repository.WaitlistRepository
+	restaurantRepo repository.RestaurantRepository
+	reservations   *ReservationUC
+	userUC         UserUseCase
+	smsSender      sms.Sender
+	access         *AccessControl
+	audit          *AuditUC
+}
+
+func NewWaitlistUseCase(waitlistRepo repository.WaitlistRepository, restaurantRepo repository.RestaurantRepository,
+	reservations *ReservationUC, userUC UserUseCase, smsSender sms.Sender, access *AccessControl,
+	audit *AuditUC) *WaitlistUC {
+	return &WaitlistUC{
+		waitlistRepo:   waitlistRepo,
+		restaurantRepo: restaurantRepo,
+		reservations:   reservations,
+		userUC:         userUC,
+		smsSender:      smsSender,
+		access:         access,
+		audit:          audit,
+	}
+}
+
+// Add ставит гостей в очередь. Гость находится по номеру телефона, а если
+// его нет, создается. Названное гостям ожидание сохраняется в записи.
+func (uc *WaitlistUC) Add(ctx context.Context, req *models.WaitlistRequest) (*models.WaitlistEntry, error) {
+	if _, err := uc.restaurantRepo.GetByID(ctx, req.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantStaff(ctx, req.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	if err := validateWaitlistRequest(req); err != nil {
+		return nil, err
+	}
+
+	guest, err := uc.guest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := uc.queue(ctx, req.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	waits, _, err := uc.reservations.estimateWaits(ctx, req.RestaurantID, append(partySizes(queue), req.PartySize))
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &models.WaitlistEntry{
+		RestaurantID:      req.RestaurantID,
+		UserID:            guest.ID,
+		PartySize:         req.PartySize,
+		Note:              req.Note,
+		Status:            models.WaitlistWaiting,
+		QuotedWaitMinutes: waits[len(waits)-1],
+	}
+	id, err := uc.waitlistRepo.Create(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.EstimatedWaitMinutes = entry.QuotedWaitMinutes
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityWaitlist, id, nil, entry)
+
+	if err := uc.localize(ctx, req.RestaurantID, []*models.WaitlistEntry{entry}); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetByRestaurant возвращает гостей, которые еще ждут стол, в порядке
+// очереди с текущей оценкой ожидания.
+func (uc *WaitlistUC) GetByRestaurant(ctx context.Context, restaurantID int64) ([]*models.WaitlistEntry, error) {
+	if err := uc.access.RequireRestaurantStaff(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	queue, err := uc.queue(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	waits, _, err := uc.reservations.estimateWaits(ctx, restaurantID, partySizes(queue))
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range queue {
+		entry.EstimatedWaitMinutes = waits[i]
+	}
+
+	if err := uc.localize(ctx, restaurantID, queue); err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// Estimate оценивает ожидание для гостей, которые только подошли, не ставя
+// их в очередь.
+func (uc *WaitlistUC) Estimate(ctx context.Context, restaurantID int64, partySize int) (*models.WaitEstimate, error) {
+	if err := uc.access.RequireRestaurantStaff(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	if partySize < 1 || partySize > maxPartySize {
+		return nil, errs.Invalid("party_size", i18n.CodePartySizeInvalid, maxPartySize)
+	}
+
+	queue, err := uc.queue(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	waits, turn, err := uc.reservations.estimateWaits(ctx, restaurantID, append(partySizes(queue), partySize))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.WaitEstimate{
+		PartySize:            partySize,
+		EstimatedWaitMinutes: waits[len(waits)-1],
+		PartiesAhead:         len(queue),
+		TurnMinutes:          int(turn / time.Minute),
+	}, nil
+}
+
+// Notify отправляет гостям SMS, что стол готов. Повторное оповещение
+// разрешено: время последнего сообщения сохраняется в записи.
+func (uc *WaitlistUC) Notify(ctx context.Context, id int64) error {
+	entry, err := uc.activeEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	guest, err := uc.userUC.GetByID(ctx, entry.UserID)
+	if err != nil {
+		return err
+	}
+
+	restaurant, err := uc.restaurantRepo.GetByID(ctx, entry.RestaurantID)
+	if err != nil {
+		return err
+	}
+
+	lang, ok := i18n.ParseLang(guest.Language)
+	if !ok {
+		lang = i18n.DefaultLang
+	}
+	message := i18n.Translate(lang, i18n.MsgTableReadySMS, restaurant.Name)
+	if err := uc.smsSender.Send(ctx, guest.PhoneNumber, message); err != nil {
+		return errs.Wrap(err, errs.KindUnavailable, i18n.CodeSMSSendFailed)
+	}
+
+	if err := uc.waitlistRepo.UpdateStatus(ctx, id, models.WaitlistNotified); err != nil {
+		return err
+	}
+
+	updated := *entry
+	updated.Status = models.WaitlistNotified
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityWaitlist, id, entry, &updated)
+	return nil
+}
+
+// Seat сажает гостей за стол: создает бронирование со статусом seated от
+// текущего момента и закрывает запись очереди.
+func (uc *WaitlistUC) Seat(ctx context.Context, id int64, req *models.SeatRequest) (int64, error) {
+	entry, err := uc.activeEntry(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	reservation, err := uc.reservations.walkIn(ctx, entry, req)
+	if err != nil {
+		return 0, err
+	}
+
+	reservationID, err := uc.waitlistRepo.Seat(ctx, id, reservation)
+	if err != nil {
+		return 0, uc.reservations.schedule.localizeConflict(ctx, entry.RestaurantID, err)
+	}
+
+	uc.audit.record(ctx, models.AuditActionCreate, models.AuditEntityReservation, reservationID, nil, reservation)
+
+	updated := *entry
+	updated.Status = models.WaitlistSeated
+	updated.ReservationID = &reservationID
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityWaitlist, id, entry, &updated)
+	return reservationID, nil
+}
+
+// Cancel убирает гостей из очереди: они ушли или передумали ждать.
+func (uc *WaitlistUC) Cancel(ctx context.Context, id int64) error {
+	entry, err := uc.activeEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.waitlistRepo.UpdateStatus(ctx, id, models.WaitlistCancelled); err != nil {
+		return err
+	}
+
+	updated := *entry
+	updated.Status = models.WaitlistCancelled
+	uc.audit.record(ctx, models.AuditActionUpdate, models.AuditEntityWaitlist, id, entry, &updated)
+	return nil
+}
+
+// activeEntry находит запись, проверяет, что пользователь работает в
+// ресторане и что гости еще в очереди.
+func (uc *WaitlistUC) activeEntry(ctx context.Context, id int64) (*models.WaitlistEntry, error) {
+	entry, err := uc.waitlistRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.RequireRestaurantStaff(ctx, entry.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	if entry.Status != models.WaitlistWaiting && entry.Status != models.WaitlistNotified {
+		return nil, errs.Conflict(i18n.CodeWaitlistEntryClosed, id)
+	}
+	return entry, nil
+}
+
+// guest находит гостя по номеру телефона или создает нового. Гость без
+// имени записывается как «Гость», как при входе по коду.
+func (uc *WaitlistUC) guest(ctx context.Context, req *models.WaitlistRequest) (*models.User, error) {
+	user, err := uc.userUC.GetByPhone(ctx, req.PhoneNumber)
+	if err == nil {
+		return user, nil
+	}
+	if !errs.IsNotFound(err) {
+		return nil, err
+	}
+
+	user = &models.User{
+		PhoneNumber: req.PhoneNumber,
+		Name:        req.Name,
+		Role:        models.RoleGuest,
+	}
+	if user.Name == "" {
+		user.Name = defaultGuestName
+	}
+	if _, err := uc.userUC.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// queue возвращает очередь ресторана: сначала оповещенные гости, для
+// которых стол уже готов, затем ожидающие в порядке записи.
+func (uc *WaitlistUC) queue(ctx context.Context, restaurantID int64) ([]*models.WaitlistEntry, error) {
+	entries, err := uc.waitlistRepo.GetActive(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Status == models.WaitlistNotified && entries[j].Status != models.WaitlistNotified
+	})
+	return entries, nil
+}
+
+func (uc *WaitlistUC) localize(ctx context.Context, restaurantID int64, entries []*models.WaitlistEntry) error {
+	loc, err := uc.reservations.schedule.location(ctx, restaurantID)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entry.CreatedAt = entry.CreatedAt.In(loc)
+		entry.UpdatedAt = entry.UpdatedAt.In(loc)
+		if entry.NotifiedAt != nil {
+			notifiedAt := entry.NotifiedAt.In(loc)
+			entry.NotifiedAt = &notifiedAt
+		}
+	}
+	return nil
+}
+
+// estimateWaits оценивает ожидание в минутах для компаний гостей в порядке
+// очереди. Каждая компания получает стол, который освободится раньше всех,
+// и занимает его на среднее время посадки, поэтому следующие в очереди ждут
+// дольше. Для компании, которую не вмещает ни один стол, оценка пуста.
+func (uc *ReservationUC) estimateWaits(ctx context.Context, restaurantID int64, partySizes []int) ([]*int, time.Duration, error) {
+	turn, err := uc.turnTime(ctx, restaurantID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tables, err := uc.tableRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, 0, err
+	}
+	sortBySeatFit(tables)
+
+	now := time.Now()
+	periods, err := uc.occupancyRepo.GetByRestaurant(ctx, restaurantID, now.Add(-uc.cfg.Buffer), now.Add(waitHorizon))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	busy := make(map[int64][]*models.TableOccupancy)
+	for _, period := range periods {
+		busy[period.TableID] = append(busy[period.TableID], period)
+	}
+
+	blackouts, err := uc.schedule.blackedOutSections(ctx, restaurantID, now, now.Add(turn))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	waits := make([]*int, len(partySizes))
+	for i, partySize := range partySizes {
+		var best *models.RestaurantTable
+		var bestAt time.Time
+		for _, table := range tables {
+			if !seatsParty(&table.Table, partySize) || len(blackouts[table.SectionID]) > 0 {
+				continue
+			}
+			if at := uc.freeFrom(busy[table.ID], now, turn); best == nil || at.Before(bestAt) {
+				best, bestAt = table, at
+			}
+		}
+
+		if best == nil {
+			continue
+		}
+
+		busy[best.ID] = append(busy[best.ID], &models.TableOccupancy{
+			TableID:  best.ID,
+			StartsAt: bestAt,
+			EndsAt:   bestAt.Add(turn),
+		})
+		minutes := int(math.Ceil(bestAt.Sub(now).Minutes()))
+		waits[i] = &minutes
+	}
+
+	return waits, turn, nil
+}
+
+// freeFrom возвращает самый ранний момент не раньше from, начиная с которого
+// стол свободен duration с учетом перерыва между гостями.
+func (uc *ReservationUC) freeFrom(periods []*models.TableOccupancy, from time.Time, duration time.Duration) time.Time {
+	at := from
+	for moved := true; moved; {
+		moved = false
+		for _, period := range periods {
+			if period.StartsAt.Before(at.Add(duration+uc.cfg.Buffer)) && at.Add(-uc.cfg.Buffer).Before(period.EndsAt) {
+				at = period.EndsAt.Add(uc.cfg.Buffer)
+				moved = true
+			}
+		}
+	}
+	return at
+}
+
+// turnTime возвращает среднее время посадки в ресторане по завершенным
+// бронированиям последних 30 дней, а без них — значение из конфигурации.
+func (uc *ReservationUC) turnTime(ctx context.Context, restaurantID int64) (time.Duration, error) {
+	turn, err := uc.reservationRepo.AverageTurnTime(ctx, restaurantID, time.Now().Add(-turnTimeWindow))
+	if err != nil {
+		return 0, err
+	}
+
+	if turn <= 0 {
+		turn = uc.cfg.TurnTime
+	}
+
+	turn = turn.Round(time.Minute)
+	turn = max(turn, minReservationDuration*time.Minute)
+	turn = min(turn, maxReservationDuration*time.Minute)
+	return turn, nil
+}
+
+// walkIn готовит бронирование для гостей из очереди за стол req.TableID от
+// текущего момента. Гости уже в зале, поэтому расписание ресторана не
+// проверяется, а закрытие секции — проверяется.
+func (uc *ReservationUC) walkIn(ctx context.Context, entry *models.WaitlistEntry, req *models.SeatRequest) (*models.Reservation, error) {
+	table, restaurantID, err := uc.tableRestaurant(ctx, req.TableID)
+	if err != nil {
+		return nil, err
+	}
+
+	if restaurantID != entry.RestaurantID {
+		return nil, errs.Invalid("table_id", i18n.CodeTableNotInRestaurant, req.TableID, entry.RestaurantID)
+	}
+	if err := checkTableCapacity(table, entry.PartySize); err != nil {
+		return nil, err
+	}
+
+	if req.DurationMinutes == 0 {
+		turn, err := uc.turnTime(ctx, restaurantID)
+		if err != nil {
+			return nil, err
+		}
+		req.DurationMinutes = int(turn / time.Minute)
+	}
+	if req.DurationMinutes < minReservationDuration || req.DurationMinutes > maxReservationDuration {
+		return nil, errs.Invalid("duration_minutes", i18n.CodeReservationDurationBounds, minReservationDuration, maxReservationDuration)
+	}
+
+	reservation := &models.Reservation{
+		RestaurantID:    restaurantID,
+		TableID:         table.ID,
+		UserID:          entry.UserID,
+		PartySize:       entry.PartySize,
+		StartTime:       time.Now(),
+		DurationMinutes: req.DurationMinutes,
+		Status:          models.ReservationSeated,
+		SpecialRequests: entry.Note,
+	}
+
+	err = uc.schedule.requireSectionAvailable(ctx, restaurantID, table.SectionID, reservation.StartTime, reservationEnd(reservation))
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func partySizes(entries []*models.WaitlistEntry) []int {
+	sizes := make([]int, 0, len(entries)+1)
+	for _, entry := range entries {
+		sizes = append(sizes, entry.PartySize)
+	}
+	return sizes
+}
+
+func validateWaitlistRequest(req *models.WaitlistRequest) error {
+	var fields errs.Fields
+
+	if req.PartySize < 1 || req.PartySize > maxPartySize {
+		fields.Add("party_size", i18n.CodePartySizeInvalid, maxPartySize)
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	req.Note = strings.TrimSpace(req.Note)
+	if utf8.RuneCountInString(req.Note) > maxSpecialRequestsLength {
+		fields.Add("note", i18n.CodeWaitlistNoteTooLong, maxSpecialRequestsLength)
+	}
+
+	return fields.Err()
+}
@@ -0,0 +1,8 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMPTZ;
+
+-- Номер телефона уникален только среди неудаленных пользователей: после
+-- мягкого удаления человек может зарегистрироваться заново.
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_phone_number_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_phone_number_active
+    ON users(phone_number) WHERE deleted_at IS NULL;
@@ -0,0 +1,83 @@
+-- Приводит номера телефонов к формату E.164 (+77011234567) по тем же
+-- правилам, что и pkg/phone: ведущая 8 или 7 в 11-значном номере и
+-- 10-значный номер без кода страны считаются номерами Казахстана/России.
+CREATE OR REPLACE FUNCTION pg_temp.normalize_phone(raw TEXT) RETURNS TEXT AS $$
+DECLARE
+    digits TEXT := regexp_replace(raw, '\D', '', 'g');
+BEGIN
+    IF raw LIKE 'anon-%' THEN
+        RETURN NULL;
+    ELSIF btrim(raw) LIKE '+%' THEN
+        digits := '+' || digits;
+    ELSIF digits LIKE '00%' THEN
+        digits := '+' || substr(digits, 3);
+    ELSIF length(digits) = 11 AND left(digits, 1) IN ('7', '8') THEN
+        digits := '+7' || substr(digits, 2);
+    ELSIF length(digits) = 10 THEN
+        digits := '+7' || digits;
+    ELSE
+        RETURN NULL;
+    END IF;
+
+    IF digits !~ '^\+[1-9][0-9]{7,14}$' OR (digits LIKE '+7%' AND digits !~ '^\+7[2-9][0-9]{9}$') THEN
+        RETURN NULL;
+    END IF;
+    RETURN digits;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- Номера, которые после нормализации совпали у нескольких активных
+-- пользователей. Такие записи не изменяются: их нужно объединить вручную.
+CREATE TABLE IF NOT EXISTS phone_normalization_conflicts (
+    phone_number VARCHAR(20) PRIMARY KEY,
+    user_ids INTEGER[] NOT NULL,
+    original_numbers TEXT[] NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+DO $$
+DECLARE
+    conflict RECORD;
+    normalized_count INTEGER;
+BEGIN
+    INSERT INTO phone_normalization_conflicts (phone_number, user_ids, original_numbers)
+    SELECT pg_temp.normalize_phone(phone_number),
+           array_agg(id ORDER BY id),
+           array_agg(phone_number ORDER BY id)
+    FROM users
+    WHERE deleted_at IS NULL AND pg_temp.normalize_phone(phone_number) IS NOT NULL
+    GROUP BY pg_temp.normalize_phone(phone_number)
+    HAVING count(*) > 1
+    ON CONFLICT (phone_number) DO UPDATE
+        SET user_ids = EXCLUDED.user_ids,
+            original_numbers = EXCLUDED.original_numbers,
+            detected_at = EXCLUDED.detected_at;
+
+    FOR conflict IN SELECT * FROM phone_normalization_conflicts ORDER BY phone_number LOOP
+        RAISE WARNING 'Дубликаты номера %: пользователи % (исходные номера %)',
+            conflict.phone_number, conflict.user_ids, conflict.original_numbers;
+    END LOOP;
+
+    UPDATE users
+    SET phone_number = pg_temp.normalize_phone(phone_number)
+    WHERE pg_temp.normalize_phone(phone_number) IS NOT NULL
+      AND pg_temp.normalize_phone(phone_number) <> phone_number
+      AND NOT (
+          deleted_at IS NULL
+          AND pg_temp.normalize_phone(phone_number) IN (SELECT phone_number FROM phone_normalization_conflicts)
+      );
+    GET DIAGNOSTICS normalized_count = ROW_COUNT;
+
+    RAISE NOTICE 'Нормализовано номеров: %', normalized_count;
+
+    FOR conflict IN
+        SELECT id, phone_number FROM users
+        WHERE phone_number NOT LIKE 'anon-%' AND pg_temp.normalize_phone(phone_number) IS NULL
+    LOOP
+        RAISE WARNING 'Не удалось нормализовать номер % пользователя %', conflict.phone_number, conflict.id;
+    END LOOP;
+END;
+$$;
+
+-- Коды подтверждения живут несколько минут, ненормализованные проще удалить.
+DELETE FROM otp_codes WHERE phone_number NOT LIKE '+%';
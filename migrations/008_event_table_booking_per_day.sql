@@ -0,0 +1,4 @@
+-- Стол бронируется под событие на весь день: второе событие на тот же
+-- стол и ту же дату запрещено на уровне базы, а не только проверкой в коде.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_restaurant_event_tables_table_day
+    ON restaurant_event_tables (table_id, (booking_date::date));
@@ -0,0 +1,24 @@
+-- Событие (например, свадебный пакет) принадлежит конкретному ресторану.
+ALTER TABLE restaurant_events
+    ADD COLUMN IF NOT EXISTS restaurant_id INTEGER REFERENCES restaurants(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_restaurant_events_restaurant_id ON restaurant_events(restaurant_id);
+
+-- До этой миграции события нельзя было создать через API, поэтому
+-- записи без ресторана могли появиться только вручную. Угадывать ресторан
+-- за оператора не будем: такие записи нужно привязать и повторить миграцию.
+DO $$
+DECLARE
+    orphaned INTEGER[];
+BEGIN
+    SELECT array_agg(id ORDER BY id) INTO orphaned
+    FROM restaurant_events
+    WHERE restaurant_id IS NULL;
+
+    IF orphaned IS NOT NULL THEN
+        RAISE EXCEPTION 'События % не привязаны к ресторану, заполните restaurant_id', orphaned;
+    END IF;
+
+    ALTER TABLE restaurant_events ALTER COLUMN restaurant_id SET NOT NULL;
+END;
+$$;
@@ -0,0 +1,19 @@
+ALTER TABLE tables
+    ADD COLUMN IF NOT EXISTS min_seats INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS max_seats INTEGER NOT NULL DEFAULT 4,
+    ADD COLUMN IF NOT EXISTS shape VARCHAR(20) NOT NULL DEFAULT 'square',
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'tables_seats_check') THEN
+        ALTER TABLE tables ADD CONSTRAINT tables_seats_check CHECK (min_seats > 0 AND min_seats <= max_seats);
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'tables_shape_check') THEN
+        ALTER TABLE tables ADD CONSTRAINT tables_shape_check
+            CHECK (shape IN ('round', 'square', 'rectangle', 'booth', 'bar'));
+    END IF;
+END$$;
+
+ALTER TABLE sections
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,38 @@
+-- Недельное расписание: несколько интервалов в день. Если closes_at не
+-- позже opens_at, интервал заканчивается на следующий день (00:00–00:00 —
+-- круглые сутки). weekday: 0 — воскресенье, как в time.Weekday.
+CREATE TABLE IF NOT EXISTS restaurant_opening_hours (
+    id SERIAL PRIMARY KEY,
+    restaurant_id INTEGER NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_restaurant_opening_hours_restaurant ON restaurant_opening_hours(restaurant_id);
+
+-- Особые дни заменяют недельное расписание на дату: строка без времени
+-- означает, что ресторан закрыт весь день.
+CREATE TABLE IF NOT EXISTS restaurant_special_days (
+    id SERIAL PRIMARY KEY,
+    restaurant_id INTEGER NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    opens_at TIME,
+    closes_at TIME,
+    note TEXT NOT NULL DEFAULT '',
+    CHECK ((opens_at IS NULL) = (closes_at IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_restaurant_special_days_restaurant_day ON restaurant_special_days(restaurant_id, day);
+
+CREATE TABLE IF NOT EXISTS section_blackouts (
+    id SERIAL PRIMARY KEY,
+    section_id INTEGER NOT NULL REFERENCES sections(id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_section_blackouts_section_starts ON section_blackouts(section_id, starts_at);
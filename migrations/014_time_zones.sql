@@ -0,0 +1,63 @@
+-- Часовой пояс задается городу, ресторан может его переопределить.
+ALTER TABLE cities ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'Asia/Almaty';
+ALTER TABLE restaurants ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64);
+
+-- created_at заполнялся CURRENT_TIMESTAMP в поясе сессии, в нем же он и
+-- читается при переводе.
+ALTER TABLE restaurants ALTER COLUMN created_at TYPE TIMESTAMPTZ;
+
+CREATE OR REPLACE FUNCTION pg_temp.event_time_zone(p_event_id INTEGER) RETURNS TEXT
+LANGUAGE sql STABLE AS $$
+    SELECT COALESCE(r.time_zone, c.time_zone)
+    FROM restaurant_events e
+    JOIN restaurants r ON r.id = e.restaurant_id
+    JOIN cities c ON c.id = r.city_id
+    WHERE e.id = p_event_id
+$$;
+
+-- booking_date хранил местное время без пояса. Дата бронирования становится
+-- полуночью этого дня по времени ресторана, а сутки события в журнале
+-- занятости пересчитываются по тому же поясу.
+DO $$
+BEGIN
+    IF (SELECT data_type FROM information_schema.columns
+        WHERE table_name = 'restaurant_event_tables' AND column_name = 'booking_date') = 'timestamp without time zone' THEN
+        DELETE FROM table_occupancy WHERE event_id IS NOT NULL;
+        ALTER TABLE table_occupancy DROP CONSTRAINT IF EXISTS table_occupancy_event_id_table_id_booking_date_fkey;
+        ALTER TABLE table_occupancy ALTER COLUMN booking_date TYPE TIMESTAMPTZ USING NULL;
+
+        ALTER TABLE restaurant_event_tables ALTER COLUMN booking_date TYPE TIMESTAMPTZ
+            USING booking_date::date::timestamp AT TIME ZONE pg_temp.event_time_zone(event_id);
+
+        ALTER TABLE table_occupancy ADD CONSTRAINT table_occupancy_event_id_table_id_booking_date_fkey
+            FOREIGN KEY (event_id, table_id, booking_date)
+            REFERENCES restaurant_event_tables(event_id, table_id, booking_date) ON DELETE CASCADE;
+
+        INSERT INTO table_occupancy (table_id, period, event_id, booking_date)
+        SELECT b.table_id,
+               tstzrange(b.booking_date, ((b.booking_date AT TIME ZONE z.name) + INTERVAL '1 day') AT TIME ZONE z.name),
+               b.event_id,
+               b.booking_date
+        FROM restaurant_event_tables b
+        CROSS JOIN LATERAL (SELECT pg_temp.event_time_zone(b.event_id) AS name) z
+        ORDER BY b.booking_date, b.event_id
+        ON CONFLICT DO NOTHING;
+    END IF;
+END$$;
+
+DO $$
+DECLARE
+    booking RECORD;
+BEGIN
+    FOR booking IN
+        SELECT b.event_id, b.table_id, b.booking_date FROM restaurant_event_tables b
+        WHERE NOT EXISTS (
+            SELECT 1 FROM table_occupancy o
+            WHERE o.event_id = b.event_id AND o.table_id = b.table_id AND o.booking_date = b.booking_date
+        )
+    LOOP
+        RAISE WARNING 'Бронирование стола % под событие % на % после смены часового пояса пересекается с другим бронированием и не попало в журнал занятости',
+            booking.table_id, booking.event_id, booking.booking_date;
+    END LOOP;
+END;
+$$;
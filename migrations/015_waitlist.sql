@@ -0,0 +1,26 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'waitlist_status') THEN
+        CREATE TYPE waitlist_status AS ENUM ('waiting', 'notified', 'seated', 'cancelled');
+    END IF;
+END$$;
+
+-- Очередь гостей без брони. quoted_wait_minutes — ожидание, названное
+-- гостям при записи; NULL, если ни один стол ресторана их не вмещает.
+-- Посадка превращает запись в бронирование со статусом seated.
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id SERIAL PRIMARY KEY,
+    restaurant_id INTEGER NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    party_size INTEGER NOT NULL CHECK (party_size > 0),
+    note TEXT NOT NULL DEFAULT '',
+    status waitlist_status NOT NULL DEFAULT 'waiting',
+    quoted_wait_minutes INTEGER,
+    reservation_id INTEGER REFERENCES reservations(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    notified_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_restaurant_active ON waitlist_entries(restaurant_id, created_at)
+    WHERE status IN ('waiting', 'notified');
@@ -0,0 +1,40 @@
+-- Сочетания столов: какие столы одной секции можно сдвинуть для большой
+-- компании и сколько гостей за ними помещается.
+CREATE TABLE IF NOT EXISTS table_combinations (
+    id SERIAL PRIMARY KEY,
+    section_id INTEGER NOT NULL REFERENCES sections(id) ON DELETE CASCADE,
+    name TEXT NOT NULL DEFAULT '',
+    min_seats INTEGER NOT NULL,
+    max_seats INTEGER NOT NULL,
+    CHECK (min_seats > 0 AND min_seats <= max_seats)
+);
+
+CREATE INDEX IF NOT EXISTS idx_table_combinations_section ON table_combinations(section_id);
+
+CREATE TABLE IF NOT EXISTS table_combination_members (
+    combination_id INTEGER NOT NULL REFERENCES table_combinations(id) ON DELETE CASCADE,
+    table_id INTEGER NOT NULL REFERENCES tables(id) ON DELETE CASCADE,
+    PRIMARY KEY (combination_id, table_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_table_combination_members_table ON table_combination_members(table_id);
+
+-- Бронирование сочетания хранит основной стол в table_id и занимает в
+-- журнале все столы сочетания, по записи на стол.
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS combination_id INTEGER REFERENCES table_combinations(id) ON DELETE SET NULL;
+
+ALTER TABLE table_occupancy DROP CONSTRAINT IF EXISTS table_occupancy_reservation_id_key;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'table_occupancy_reservation_table_key') THEN
+        ALTER TABLE table_occupancy
+            ADD CONSTRAINT table_occupancy_reservation_table_key UNIQUE (reservation_id, table_id);
+    END IF;
+END$$;
+
+-- Бронирование сочетания под событие — по строке на каждый стол с общим
+-- combination_id; отмена снимает все столы сразу.
+ALTER TABLE restaurant_event_tables
+    ADD COLUMN IF NOT EXISTS combination_id INTEGER REFERENCES table_combinations(id) ON DELETE SET NULL;
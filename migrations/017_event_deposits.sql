@@ -0,0 +1,49 @@
+-- Депозит за бронирование под событие: фиксированная сумма или процент от
+-- цены события, умноженной на число гостей.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'restaurant_events_deposit_type_check') THEN
+        ALTER TABLE restaurant_events
+            ADD COLUMN IF NOT EXISTS deposit_type VARCHAR(20) NOT NULL DEFAULT 'none',
+            ADD COLUMN IF NOT EXISTS deposit_value NUMERIC(10,2) NOT NULL DEFAULT 0,
+            ADD CONSTRAINT restaurant_events_deposit_type_check
+                CHECK (deposit_type IN ('none', 'fixed', 'percent')),
+            ADD CONSTRAINT restaurant_events_deposit_value_check
+                CHECK (deposit_value >= 0 AND (deposit_type <> 'percent' OR deposit_value <= 100));
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'deposit_status') THEN
+        CREATE TYPE deposit_status AS ENUM ('pending', 'paid', 'expired', 'cancelled');
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'event_booking_status') THEN
+        CREATE TYPE event_booking_status AS ENUM ('pending_payment', 'confirmed');
+    END IF;
+END$$;
+
+-- Депозит выставляется на одно бронирование: стол или все столы сочетания.
+-- payment_id и payment_url заполняются, когда платеж создан у провайдера.
+CREATE TABLE IF NOT EXISTS event_deposits (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL REFERENCES restaurant_events(id) ON DELETE CASCADE,
+    booking_date TIMESTAMPTZ NOT NULL,
+    guests INTEGER NOT NULL DEFAULT 0 CHECK (guests >= 0),
+    amount NUMERIC(12,2) NOT NULL CHECK (amount > 0),
+    status deposit_status NOT NULL DEFAULT 'pending',
+    payment_id TEXT UNIQUE,
+    payment_url TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NOT NULL,
+    paid_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_deposits_pending ON event_deposits(expires_at)
+    WHERE status = 'pending';
+
+-- Неоплаченное бронирование держит столы, пока не истечет срок депозита.
+-- Существующие бронирования считаются подтвержденными.
+ALTER TABLE restaurant_event_tables
+    ADD COLUMN IF NOT EXISTS status event_booking_status NOT NULL DEFAULT 'confirmed',
+    ADD COLUMN IF NOT EXISTS deposit_id INTEGER REFERENCES event_deposits(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_restaurant_event_tables_deposit ON restaurant_event_tables(deposit_id);
@@ -0,0 +1,10 @@
+-- Ссылка на календарь бронирований ресторана для подписки в Google
+-- Calendar и Outlook. Календари не умеют передавать заголовок авторизации,
+-- поэтому доступ дает токен в адресе; храним только его хеш. У ресторана
+-- одна действующая ссылка: новый токен заменяет прежний.
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    restaurant_id INTEGER PRIMARY KEY REFERENCES restaurants(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,38 @@
+-- Счетчик неявок гостя по всем ресторанам. Прощенная неявка остается в
+-- истории бронирования, но из счетчика вычитается.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS no_show_count INTEGER NOT NULL DEFAULT 0 CHECK (no_show_count >= 0);
+
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS no_show_forgiven_at TIMESTAMPTZ;
+
+UPDATE users u
+SET no_show_count = (
+    SELECT count(*)
+    FROM reservations r
+    WHERE r.user_id = u.id AND r.status = 'no_show' AND r.no_show_forgiven_at IS NULL
+);
+
+-- Правила ресторана для гостей с неявками: с deposit_after неявок
+-- бронирование стола требует депозит deposit_amount, с block_after гость не
+-- может бронировать сам. NULL отключает правило.
+CREATE TABLE IF NOT EXISTS no_show_policies (
+    restaurant_id INTEGER PRIMARY KEY REFERENCES restaurants(id) ON DELETE CASCADE,
+    deposit_after INTEGER CHECK (deposit_after >= 1),
+    deposit_amount NUMERIC(12,2) NOT NULL DEFAULT 0 CHECK (deposit_amount >= 0),
+    block_after INTEGER CHECK (block_after >= 1),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (deposit_after IS NULL OR deposit_amount > 0)
+);
+
+-- Депозит теперь выставляется и на бронирование стола: ровно одно из полей
+-- event_id и reservation_id заполнено.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'event_deposits_target_check') THEN
+        ALTER TABLE event_deposits
+            ALTER COLUMN event_id DROP NOT NULL,
+            ADD COLUMN IF NOT EXISTS reservation_id INTEGER UNIQUE REFERENCES reservations(id) ON DELETE CASCADE,
+            ADD CONSTRAINT event_deposits_target_check CHECK ((event_id IS NULL) <> (reservation_id IS NULL));
+    END IF;
+END$$;
@@ -0,0 +1,35 @@
+-- Типы событий переезжают из перечисления event_type в справочник, чтобы
+-- добавлять их без изменения схемы. code — неизменяемый ключ, который
+-- хранится в restaurant_events.eventtype и передается в API. Неактивный тип
+-- нельзя выбрать для нового события, но существующие события его сохраняют.
+CREATE TABLE IF NOT EXISTS event_types (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE CHECK (code ~ '^[a-z][a-z0-9_]*$'),
+    name_ru VARCHAR(255) NOT NULL,
+    name_kz VARCHAR(255) NOT NULL,
+    icon TEXT NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO event_types (code, name_ru, name_kz) VALUES
+    ('wedding', 'Свадьба', 'Үйлену тойы'),
+    ('birthday', 'День рождения', 'Туған күн'),
+    ('corporate', 'Корпоратив', 'Корпоратив')
+ON CONFLICT (code) DO NOTHING;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_type WHERE typname = 'event_type') THEN
+        ALTER TABLE restaurant_events ALTER COLUMN eventtype TYPE VARCHAR(50) USING eventtype::text;
+        DROP TYPE event_type;
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'restaurant_events_eventtype_fkey') THEN
+        ALTER TABLE restaurant_events
+            ADD CONSTRAINT restaurant_events_eventtype_fkey
+                FOREIGN KEY (eventtype) REFERENCES event_types(code);
+    END IF;
+END$$;
+
+CREATE INDEX IF NOT EXISTS idx_restaurant_events_eventtype ON restaurant_events(eventtype);
@@ -0,0 +1,27 @@
+-- Пакеты банкета: цена за гостя по уровню (standard, premium), допустимое
+-- число гостей и включенные услуги. Отключенный пакет не предлагается и не
+-- считается, но остается в истории.
+CREATE TABLE IF NOT EXISTS event_packages (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL REFERENCES restaurant_events(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    tier VARCHAR(20) NOT NULL CHECK (tier IN ('standard', 'premium')),
+    price_per_guest NUMERIC(10,2) NOT NULL CHECK (price_per_guest >= 0),
+    min_guests INTEGER NOT NULL DEFAULT 1 CHECK (min_guests >= 1),
+    max_guests INTEGER CHECK (max_guests >= min_guests),
+    included_services TEXT[] NOT NULL DEFAULT '{}',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (event_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_packages_event ON event_packages(event_id);
+
+-- Надбавка ресторана к банкетам в выходные: weekend_percent процентов к
+-- стоимости пакета в дни weekend_days (0 — воскресенье).
+CREATE TABLE IF NOT EXISTS event_pricing (
+    restaurant_id INTEGER PRIMARY KEY REFERENCES restaurants(id) ON DELETE CASCADE,
+    weekend_days SMALLINT[] NOT NULL DEFAULT '{0,6}',
+    weekend_percent NUMERIC(5,2) NOT NULL DEFAULT 0 CHECK (weekend_percent >= 0 AND weekend_percent <= 100),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,40 @@
+-- Заявки на банкет, которые приходят по телефону до бронирования столов.
+-- Заявка проходит статусы new → contacted → quoted → deposit_paid →
+-- confirmed, на любом открытом этапе ее можно закрыть как lost. confirmed
+-- ставится только при переводе заявки в бронирование события event_id.
+CREATE TABLE IF NOT EXISTS event_inquiries (
+    id SERIAL PRIMARY KEY,
+    restaurant_id INTEGER NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    contact_name VARCHAR(100) NOT NULL DEFAULT '',
+    contact_phone VARCHAR(20) NOT NULL,
+    desired_date DATE NOT NULL,
+    guests INTEGER NOT NULL CHECK (guests > 0),
+    event_type VARCHAR(50) REFERENCES event_types(code),
+    notes TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'new'
+        CHECK (status IN ('new', 'contacted', 'quoted', 'deposit_paid', 'confirmed', 'lost')),
+    assigned_to INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    event_id INTEGER REFERENCES restaurant_events(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_inquiries_restaurant ON event_inquiries(restaurant_id, status);
+CREATE INDEX IF NOT EXISTS idx_event_inquiries_assigned ON event_inquiries(assigned_to);
+
+-- Журнал работы с заявкой: создание, правки, смена статуса, назначение
+-- менеджера и заметки. Пишется в той же транзакции, что и изменение.
+CREATE TABLE IF NOT EXISTS event_inquiry_activities (
+    id SERIAL PRIMARY KEY,
+    inquiry_id INTEGER NOT NULL REFERENCES event_inquiries(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL
+        CHECK (kind IN ('created', 'updated', 'status_changed', 'assigned', 'note', 'converted')),
+    status VARCHAR(20),
+    assigned_to INTEGER,
+    note TEXT NOT NULL DEFAULT '',
+    actor_user_id INTEGER,
+    actor_api_key_id INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_inquiry_activities_inquiry ON event_inquiry_activities(inquiry_id, created_at);
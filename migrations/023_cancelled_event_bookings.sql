@@ -0,0 +1,14 @@
+-- Отмененные бронирования столов под события без депозита. Строка
+-- бронирования удаляется вместе с занятостью стола, а здесь остается след,
+-- чтобы лента календаря отдала отмену подписанным календарям. Отмены
+-- бронирований с депозитом видны по статусу депозита.
+CREATE TABLE IF NOT EXISTS cancelled_event_bookings (
+    event_id INTEGER NOT NULL REFERENCES restaurant_events(id) ON DELETE CASCADE,
+    table_id INTEGER NOT NULL REFERENCES tables(id) ON DELETE CASCADE,
+    booking_date TIMESTAMPTZ NOT NULL,
+    combination_id INTEGER REFERENCES table_combinations(id) ON DELETE SET NULL,
+    cancelled_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (event_id, table_id, booking_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_cancelled_event_bookings_date ON cancelled_event_bookings(booking_date);
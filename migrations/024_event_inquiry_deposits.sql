@@ -0,0 +1,12 @@
+-- Депозит, который ждет оплаты по заявке, переведенной в бронирование
+-- события с депозитом. Оплата депозита подтверждает заявку.
+ALTER TABLE event_inquiries
+    ADD COLUMN IF NOT EXISTS deposit_id INTEGER REFERENCES event_deposits(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_event_inquiries_deposit ON event_inquiries(deposit_id);
+
+-- deposit_paid теперь ставит только оплата депозита. Заявки, переведенные в
+-- этот статус вручную, возвращаются в quoted и переводятся в бронирование
+-- через оплату.
+UPDATE event_inquiries SET status = 'quoted', updated_at = CURRENT_TIMESTAMP
+WHERE status = 'deposit_paid' AND deposit_id IS NULL;
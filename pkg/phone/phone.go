@@ -0,0 +1,86 @@
+// Package phone разбирает телефонные номера и приводит их к формату E.164.
+// Номера без кода страны считаются номерами Казахстана и России (+7).
+package phone
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// DefaultCountryCode — код страны для номеров, записанных без него.
+	DefaultCountryCode = "7"
+
+	// nationalLength — длина номера без кода страны в зоне +7.
+	nationalLength = 10
+	// maxE164Digits — максимальная длина номера E.164 без знака «+».
+	maxE164Digits = 15
+	minE164Digits = 8
+)
+
+var (
+	ErrEmpty   = errors.New("номер телефона не указан")
+	ErrInvalid = errors.New("некорректный номер телефона")
+)
+
+// Normalize приводит номер к виду +77011234567. Поддерживаются записи
+// «+7 (701) 123-45-67», «87011234567», «77011234567», «7011234567» и
+// международный префикс «00».
+func Normalize(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", ErrEmpty
+	}
+
+	international := strings.HasPrefix(raw, "+")
+	if international {
+		raw = raw[1:]
+	}
+
+	digits := make([]byte, 0, len(raw))
+	for i := 0; i < len(raw); i++ {
+		switch ch := raw[i]; {
+		case ch >= '0' && ch <= '9':
+			digits = append(digits, ch)
+		case ch == ' ' || ch == '-' || ch == '(' || ch == ')' || ch == '.':
+		default:
+			return "", ErrInvalid
+		}
+	}
+
+	number := string(digits)
+	switch {
+	case international:
+	case strings.HasPrefix(number, "00"):
+		number = number[2:]
+	case len(number) == nationalLength+1 && (number[0] == '8' || number[0] == '7'):
+		number = DefaultCountryCode + number[1:]
+	case len(number) == nationalLength:
+		number = DefaultCountryCode + number
+	default:
+		return "", ErrInvalid
+	}
+
+	if !valid(number) {
+		return "", ErrInvalid
+	}
+	return "+" + number, nil
+}
+
+// IsValid сообщает, можно ли привести номер к формату E.164.
+func IsValid(raw string) bool {
+	_, err := Normalize(raw)
+	return err == nil
+}
+
+func valid(number string) bool {
+	if len(number) < minE164Digits || len(number) > maxE164Digits || number[0] == '0' {
+		return false
+	}
+
+	if strings.HasPrefix(number, DefaultCountryCode) {
+		national := number[len(DefaultCountryCode):]
+		return len(national) == nationalLength && national[0] != '0' && national[0] != '1'
+	}
+	return true
+}